@@ -18,6 +18,7 @@ import (
 
 	"github.com/oapi-codegen/runtime"
 	strictnethttp "github.com/oapi-codegen/runtime/strictmiddleware/nethttp"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
 const (
@@ -53,6 +54,7 @@ const (
 
 // Defines values for ErrorCode.
 const (
+	ErrorCodeCONFLICT           ErrorCode = "CONFLICT"
 	ErrorCodeDISABLED           ErrorCode = "DISABLED"
 	ErrorCodeINVALIDCREDENTIALS ErrorCode = "INVALID_CREDENTIALS"
 	ErrorCodeINVALIDREQUEST     ErrorCode = "INVALID_REQUEST"
@@ -69,9 +71,35 @@ const (
 	EventTypeDEPLOYMENTSTARTED    EventType = "DEPLOYMENT_STARTED"
 	EventTypeDEPLOYMENTSUCCESS    EventType = "DEPLOYMENT_SUCCESS"
 	EventTypeERROR                EventType = "ERROR"
+	EventTypeLOG                  EventType = "LOG"
 	EventTypeMISC                 EventType = "MISC"
 	EventTypePASSWORDUPDATED      EventType = "PASSWORD_UPDATED"
 	EventTypeSESSIONREUSED        EventType = "SESSION_REUSED"
+	EventTypeSTACKRECOVERED       EventType = "STACK_RECOVERED"
+	EventTypeSTACKSTATUSCHANGED   EventType = "STACK_STATUS_CHANGED"
+	EventTypeSTACKUNHEALTHY       EventType = "STACK_UNHEALTHY"
+)
+
+// Defines values for LogStream.
+const (
+	LogStreamStderr LogStream = "stderr"
+	LogStreamStdout LogStream = "stdout"
+)
+
+// Defines values for ScheduleName.
+const (
+	ScheduleNameImageUpdate ScheduleName = "imageUpdate"
+	ScheduleNamePrune       ScheduleName = "prune"
+	ScheduleNameSync        ScheduleName = "sync"
+)
+
+// Defines values for StackAction.
+const (
+	StackActionDown            StackAction = "down"
+	StackActionPullAndRecreate StackAction = "pull-and-recreate"
+	StackActionRestart         StackAction = "restart"
+	StackActionStart           StackAction = "start"
+	StackActionStop            StackAction = "stop"
 )
 
 // Defines values for Versions.
@@ -79,6 +107,12 @@ const (
 	VersionsN10 Versions = "1.0"
 )
 
+// Defines values for WriteBackMode.
+const (
+	WriteBackModeBranch WriteBackMode = "branch"
+	WriteBackModeCommit WriteBackMode = "commit"
+)
+
 // BooleanResponse defines model for BooleanResponse.
 type BooleanResponse struct {
 	Success bool `json:"success"`
@@ -86,8 +120,28 @@ type BooleanResponse struct {
 
 // Config defines model for Config.
 type Config struct {
-	GlobalVariables map[string]string            `json:"globalVariables"`
-	Services        map[string]map[string]string `json:"services"`
+	GlobalVariables map[string]string `json:"globalVariables"`
+
+	// Origins File each value comes from, by path (ex : services.db.PORT), only set on resolved configurations
+	Origins  *map[string]string           `json:"origins,omitempty"`
+	Services map[string]map[string]string `json:"services"`
+}
+
+// ConfigRevision defines model for ConfigRevision.
+type ConfigRevision struct {
+	Author  string    `json:"author"`
+	Id      string    `json:"id"`
+	Message string    `json:"message"`
+	Time    time.Time `json:"time"`
+}
+
+// ConfigRevisionWithContent defines model for ConfigRevisionWithContent.
+type ConfigRevisionWithContent struct {
+	Author  string    `json:"author"`
+	Content string    `json:"content"`
+	Id      string    `json:"id"`
+	Message string    `json:"message"`
+	Time    time.Time `json:"time"`
 }
 
 // ContainerHealth defines model for ContainerHealth.
@@ -100,6 +154,9 @@ type ContainerStatus struct {
 	Name        string               `json:"name"`
 	StartedAt   time.Time            `json:"startedAt"`
 	State       ContainerStatusState `json:"state"`
+
+	// Usage Last sample of the resources used by the container, while it's running
+	Usage *ResourceUsage `json:"usage,omitempty"`
 }
 
 // ContainerStatusState defines model for ContainerStatus.State.
@@ -111,6 +168,13 @@ type Credentials struct {
 	Username string `json:"username"`
 }
 
+// DailyStats defines model for DailyStats.
+type DailyStats struct {
+	Day     openapi_types.Date `json:"day"`
+	Error   int32              `json:"error"`
+	Success int32              `json:"success"`
+}
+
 // Deployment defines model for Deployment.
 type Deployment struct {
 	Author  string           `json:"author"`
@@ -122,6 +186,15 @@ type Deployment struct {
 	Title   string           `json:"title"`
 }
 
+// DeploymentLog A line printed by a command run during a deployment
+type DeploymentLog struct {
+	ID      uint64    `json:"ID"`
+	Line    string    `json:"line"`
+	Service string    `json:"service"`
+	Stream  LogStream `json:"stream"`
+	Time    time.Time `json:"time"`
+}
+
 // DeploymentStatus defines model for DeploymentStatus.
 type DeploymentStatus string
 
@@ -140,8 +213,11 @@ type DeploymentWithDetails struct {
 
 // Error defines model for Error.
 type Error struct {
-	Code    ErrorCode `json:"code"`
-	Message string    `json:"message"`
+	Code ErrorCode `json:"code"`
+
+	// Fields Invalid fields of the request, by path (ex : settings.cron)
+	Fields  *[]FieldError `json:"fields,omitempty"`
+	Message string        `json:"message"`
 }
 
 // ErrorCode defines model for ErrorCode.
@@ -149,12 +225,16 @@ type ErrorCode string
 
 // Event defines model for Event.
 type Event struct {
-	ID         uint64    `json:"ID"`
-	Msg        string    `json:"msg"`
-	ObjectId   uint64    `json:"objectId"`
-	ObjectName string    `json:"objectName"`
-	Time       time.Time `json:"time"`
-	Type       EventType `json:"type"`
+	ID         uint64 `json:"ID"`
+	Msg        string `json:"msg"`
+	ObjectId   uint64 `json:"objectId"`
+	ObjectName string `json:"objectName"`
+
+	// Service Service and stream of the LOG events
+	Service *string    `json:"service,omitempty"`
+	Stream  *LogStream `json:"stream,omitempty"`
+	Time    time.Time  `json:"time"`
+	Type    EventType  `json:"type"`
 }
 
 // EventType defines model for EventType.
@@ -168,6 +248,12 @@ type Features struct {
 	EditSettings  bool `json:"editSettings"`
 }
 
+// FieldError defines model for FieldError.
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
 // FileDiff defines model for FileDiff.
 type FileDiff struct {
 	Diff    string `json:"diff"`
@@ -175,12 +261,88 @@ type FileDiff struct {
 	OldFile string `json:"oldFile"`
 }
 
+// LogStream defines model for LogStream.
+type LogStream string
+
+// MaintenanceWindow defines model for MaintenanceWindow.
+type MaintenanceWindow struct {
+	Active   bool      `json:"active"`
+	Cron     string    `json:"cron"`
+	Duration string    `json:"duration"`
+	End      time.Time `json:"end"`
+	Start    time.Time `json:"start"`
+}
+
 // PageInfo defines model for PageInfo.
 type PageInfo struct {
 	EndCursor   string `json:"endCursor"`
 	HasNextPage bool   `json:"hasNextPage"`
 }
 
+// ResourceUsage A sample of the resources used by containers, network and block I/O are totals since they started
+type ResourceUsage struct {
+	BlockRead  uint64 `json:"blockRead"`
+	BlockWrite uint64 `json:"blockWrite"`
+
+	// CpuPercent Percentage of one CPU, 200 for two full CPUs
+	CpuPercent float64 `json:"cpuPercent"`
+
+	// MemoryLimit Memory limit of the containers, the memory of the host when unlimited
+	MemoryLimit uint64    `json:"memoryLimit"`
+	MemoryUsage uint64    `json:"memoryUsage"`
+	NetworkRx   uint64    `json:"networkRx"`
+	NetworkTx   uint64    `json:"networkTx"`
+	Time        time.Time `json:"time"`
+}
+
+// Schedule defines model for Schedule.
+type Schedule struct {
+	Cron          string       `json:"cron"`
+	DeferredUntil *time.Time   `json:"deferredUntil,omitempty"`
+	Disruptive    bool         `json:"disruptive"`
+	Jitter        *string      `json:"jitter,omitempty"`
+	Name          ScheduleName `json:"name"`
+	Next          *time.Time   `json:"next,omitempty"`
+}
+
+// ScheduleName defines model for ScheduleName.
+type ScheduleName string
+
+// Schedules defines model for Schedules.
+type Schedules struct {
+	Jobs               []Schedule          `json:"jobs"`
+	MaintenanceWindows []MaintenanceWindow `json:"maintenanceWindows"`
+	Timezone           string              `json:"timezone"`
+}
+
+// Secret defines model for Secret.
+type Secret struct {
+	Name      string    `json:"name"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// SecretValue defines model for SecretValue.
+type SecretValue struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// ServiceRequirements defines model for ServiceRequirements.
+type ServiceRequirements struct {
+	Missing []VariableRequirement `json:"missing"`
+	Service string                `json:"service"`
+}
+
+// ServiceStats defines model for ServiceStats.
+type ServiceStats struct {
+	Deployments int32 `json:"deployments"`
+	Errors      int32 `json:"errors"`
+
+	// FailureRate Ratio of failed deployments, between 0 and 1
+	FailureRate float64 `json:"failureRate"`
+	Service     string  `json:"service"`
+}
+
 // Settings defines model for Settings.
 type Settings struct {
 	Branch            *string     `json:"branch,omitempty"`
@@ -190,24 +352,46 @@ type Settings struct {
 	Repo              string      `json:"repo"`
 	Token             *string     `json:"token,omitempty"`
 	Username          *string     `json:"username,omitempty"`
+
+	// WriteBack Commit the config edits to the repo, or to a new branch, disabled when omitted
+	WriteBack *WriteBackMode `json:"writeBack,omitempty"`
 }
 
+// StackAction Action on the containers of a stack, pull-and-recreate pulls the images then recreates all the containers
+type StackAction string
+
 // StackStatus defines model for StackStatus.
 type StackStatus struct {
 	Name     string            `json:"name"`
 	Services []ContainerStatus `json:"services"`
 	StackId  string            `json:"stackId"`
+
+	// Usage Resources used by the running containers of the stack
+	Usage *ResourceUsage `json:"usage,omitempty"`
+
+	// UsageHistory Last samples of the resources used by the stack, the oldest first
+	UsageHistory *[]ResourceUsage `json:"usageHistory,omitempty"`
 }
 
 // Stats defines model for Stats.
 type Stats struct {
-	Author     string           `json:"author"`
-	Error      int32            `json:"error"`
-	Health     ContainerHealth  `json:"health"`
-	LastDeploy time.Time        `json:"lastDeploy"`
-	NextDeploy time.Time        `json:"nextDeploy"`
-	Status     DeploymentStatus `json:"status"`
-	Success    int32            `json:"success"`
+	Author     string          `json:"author"`
+	Error      int32           `json:"error"`
+	Health     ContainerHealth `json:"health"`
+	LastDeploy time.Time       `json:"lastDeploy"`
+
+	// MeanDuration Mean duration of the finished deployments, in seconds
+	MeanDuration float64 `json:"meanDuration"`
+
+	// MeanTimeToRecovery Mean time between a failed deployment and the next successful one, in seconds
+	MeanTimeToRecovery float64   `json:"meanTimeToRecovery"`
+	NextDeploy         time.Time `json:"nextDeploy"`
+
+	// P95Duration 95th percentile of the duration of the finished deployments, in seconds
+	P95Duration float64          `json:"p95Duration"`
+	Services    []ServiceStats   `json:"services"`
+	Status      DeploymentStatus `json:"status"`
+	Success     int32            `json:"success"`
 }
 
 // User defines model for User.
@@ -215,21 +399,64 @@ type User struct {
 	Username string `json:"username"`
 }
 
+// VariableRequirement defines model for VariableRequirement.
+type VariableRequirement struct {
+	Message  *string `json:"message,omitempty"`
+	Name     string  `json:"name"`
+	Required bool    `json:"required"`
+}
+
 // Versions defines model for Versions.
 type Versions string
 
+// WriteBackMode defines model for WriteBackMode.
+type WriteBackMode string
+
+// ConfigAPIGetParams defines parameters for ConfigAPIGet.
+type ConfigAPIGetParams struct {
+	Resolved *bool `form:"resolved,omitempty" json:"resolved,omitempty"`
+}
+
+// ConfigAPISetParams defines parameters for ConfigAPISet.
+type ConfigAPISetParams struct {
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// ConfigHistoryAPIListParams defines parameters for ConfigHistoryAPIList.
+type ConfigHistoryAPIListParams struct {
+	Limit  int32   `form:"limit" json:"limit"`
+	Offset *string `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// ConfigHistoryAPIDiffParams defines parameters for ConfigHistoryAPIDiff.
+type ConfigHistoryAPIDiffParams struct {
+	From string `form:"from" json:"from"`
+	To   string `form:"to" json:"to"`
+}
+
 // DeployementAPIListParams defines parameters for DeployementAPIList.
 type DeployementAPIListParams struct {
 	Limit  int32   `form:"limit" json:"limit"`
 	Offset *string `form:"offset,omitempty" json:"offset,omitempty"`
 }
 
+// DeployementAPILogsParams defines parameters for DeployementAPILogs.
+type DeployementAPILogsParams struct {
+	Limit  int32   `form:"limit" json:"limit"`
+	Offset *string `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
 // NotificationsAPIListParams defines parameters for NotificationsAPIList.
 type NotificationsAPIListParams struct {
 	Limit  int32   `form:"limit" json:"limit"`
 	Offset *string `form:"offset,omitempty" json:"offset,omitempty"`
 }
 
+// SettingsAPISetParams defines parameters for SettingsAPISet.
+type SettingsAPISetParams struct {
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
 // UserAPIChangePasswordJSONBody defines parameters for UserAPIChangePassword.
 type UserAPIChangePasswordJSONBody struct {
 	NewPass string `json:"newPass"`
@@ -245,6 +472,9 @@ type AuthAPIRegisterJSONRequestBody = Credentials
 // ConfigAPISetJSONRequestBody defines body for ConfigAPISet for application/json ContentType.
 type ConfigAPISetJSONRequestBody = Config
 
+// SecretsAPISetJSONRequestBody defines body for SecretsAPISet for application/json ContentType.
+type SecretsAPISetJSONRequestBody = SecretValue
+
 // SettingsAPISetJSONRequestBody defines body for SettingsAPISet for application/json ContentType.
 type SettingsAPISetJSONRequestBody = Settings
 
@@ -324,6 +554,9 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// AdminAPIBackup request
+	AdminAPIBackup(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// AuthAPILoginWithBody request with any body
 	AuthAPILoginWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -344,12 +577,27 @@ type ClientInterface interface {
 	AuthAPIRegister(ctx context.Context, body AuthAPIRegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// ConfigAPIGet request
-	ConfigAPIGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	ConfigAPIGet(ctx context.Context, params *ConfigAPIGetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// ConfigAPISetWithBody request with any body
-	ConfigAPISetWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	ConfigAPISetWithBody(ctx context.Context, params *ConfigAPISetParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ConfigAPISet(ctx context.Context, params *ConfigAPISetParams, body ConfigAPISetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ConfigHistoryAPIList request
+	ConfigHistoryAPIList(ctx context.Context, params *ConfigHistoryAPIListParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	ConfigAPISet(ctx context.Context, body ConfigAPISetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// ConfigHistoryAPIDiff request
+	ConfigHistoryAPIDiff(ctx context.Context, params *ConfigHistoryAPIDiffParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ConfigHistoryAPIRead request
+	ConfigHistoryAPIRead(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ConfigHistoryAPIRestore request
+	ConfigHistoryAPIRestore(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ConfigAPIRequirements request
+	ConfigAPIRequirements(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// DeployementAPIList request
 	DeployementAPIList(ctx context.Context, params *DeployementAPIListParams, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -360,6 +608,9 @@ type ClientInterface interface {
 	// DeployementAPIRead request
 	DeployementAPIRead(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// DeployementAPILogs request
+	DeployementAPILogs(ctx context.Context, id string, params *DeployementAPILogsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// DiffAPIGet request
 	DiffAPIGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -369,13 +620,36 @@ type ClientInterface interface {
 	// NotificationsAPIList request
 	NotificationsAPIList(ctx context.Context, params *NotificationsAPIListParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// SchedulesAPIGet request
+	SchedulesAPIGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SecretsAPIList request
+	SecretsAPIList(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SecretsAPISetWithBody request with any body
+	SecretsAPISetWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SecretsAPISet(ctx context.Context, body SecretsAPISetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SecretsAPIDelete request
+	SecretsAPIDelete(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// SettingsAPIGet request
 	SettingsAPIGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// SettingsAPISetWithBody request with any body
-	SettingsAPISetWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	SettingsAPISetWithBody(ctx context.Context, params *SettingsAPISetParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SettingsAPISet(ctx context.Context, params *SettingsAPISetParams, body SettingsAPISetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// StacksAPIRestartContainer request
+	StacksAPIRestartContainer(ctx context.Context, name string, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// StacksAPIAction request
+	StacksAPIAction(ctx context.Context, name string, action StackAction, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	SettingsAPISet(ctx context.Context, body SettingsAPISetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// StatsAPIDaily request
+	StatsAPIDaily(ctx context.Context, days int32, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// StatsAPIGet request
 	StatsAPIGet(ctx context.Context, days int32, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -395,6 +669,18 @@ type ClientInterface interface {
 	UserAPIChangePassword(ctx context.Context, body UserAPIChangePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
+func (c *Client) AdminAPIBackup(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminAPIBackupRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) AuthAPILoginWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewAuthAPILoginRequestWithBody(c.Server, contentType, body)
 	if err != nil {
@@ -479,8 +765,8 @@ func (c *Client) AuthAPIRegister(ctx context.Context, body AuthAPIRegisterJSONRe
 	return c.Client.Do(req)
 }
 
-func (c *Client) ConfigAPIGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewConfigAPIGetRequest(c.Server)
+func (c *Client) ConfigAPIGet(ctx context.Context, params *ConfigAPIGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConfigAPIGetRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -491,8 +777,8 @@ func (c *Client) ConfigAPIGet(ctx context.Context, reqEditors ...RequestEditorFn
 	return c.Client.Do(req)
 }
 
-func (c *Client) ConfigAPISetWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewConfigAPISetRequestWithBody(c.Server, contentType, body)
+func (c *Client) ConfigAPISetWithBody(ctx context.Context, params *ConfigAPISetParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConfigAPISetRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -503,8 +789,8 @@ func (c *Client) ConfigAPISetWithBody(ctx context.Context, contentType string, b
 	return c.Client.Do(req)
 }
 
-func (c *Client) ConfigAPISet(ctx context.Context, body ConfigAPISetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewConfigAPISetRequest(c.Server, body)
+func (c *Client) ConfigAPISet(ctx context.Context, params *ConfigAPISetParams, body ConfigAPISetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConfigAPISetRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -515,8 +801,8 @@ func (c *Client) ConfigAPISet(ctx context.Context, body ConfigAPISetJSONRequestB
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeployementAPIList(ctx context.Context, params *DeployementAPIListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeployementAPIListRequest(c.Server, params)
+func (c *Client) ConfigHistoryAPIList(ctx context.Context, params *ConfigHistoryAPIListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConfigHistoryAPIListRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -527,8 +813,8 @@ func (c *Client) DeployementAPIList(ctx context.Context, params *DeployementAPIL
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeployementAPISync(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeployementAPISyncRequest(c.Server)
+func (c *Client) ConfigHistoryAPIDiff(ctx context.Context, params *ConfigHistoryAPIDiffParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConfigHistoryAPIDiffRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -539,8 +825,8 @@ func (c *Client) DeployementAPISync(ctx context.Context, reqEditors ...RequestEd
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeployementAPIRead(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeployementAPIReadRequest(c.Server, id)
+func (c *Client) ConfigHistoryAPIRead(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConfigHistoryAPIReadRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -551,8 +837,8 @@ func (c *Client) DeployementAPIRead(ctx context.Context, id string, reqEditors .
 	return c.Client.Do(req)
 }
 
-func (c *Client) DiffAPIGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDiffAPIGetRequest(c.Server)
+func (c *Client) ConfigHistoryAPIRestore(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConfigHistoryAPIRestoreRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -563,8 +849,8 @@ func (c *Client) DiffAPIGet(ctx context.Context, reqEditors ...RequestEditorFn)
 	return c.Client.Do(req)
 }
 
-func (c *Client) FeaturesAPIGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewFeaturesAPIGetRequest(c.Server)
+func (c *Client) ConfigAPIRequirements(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConfigAPIRequirementsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -575,8 +861,8 @@ func (c *Client) FeaturesAPIGet(ctx context.Context, reqEditors ...RequestEditor
 	return c.Client.Do(req)
 }
 
-func (c *Client) NotificationsAPIList(ctx context.Context, params *NotificationsAPIListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewNotificationsAPIListRequest(c.Server, params)
+func (c *Client) DeployementAPIList(ctx context.Context, params *DeployementAPIListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeployementAPIListRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -587,8 +873,8 @@ func (c *Client) NotificationsAPIList(ctx context.Context, params *Notifications
 	return c.Client.Do(req)
 }
 
-func (c *Client) SettingsAPIGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSettingsAPIGetRequest(c.Server)
+func (c *Client) DeployementAPISync(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeployementAPISyncRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -599,8 +885,8 @@ func (c *Client) SettingsAPIGet(ctx context.Context, reqEditors ...RequestEditor
 	return c.Client.Do(req)
 }
 
-func (c *Client) SettingsAPISetWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSettingsAPISetRequestWithBody(c.Server, contentType, body)
+func (c *Client) DeployementAPIRead(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeployementAPIReadRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -611,8 +897,8 @@ func (c *Client) SettingsAPISetWithBody(ctx context.Context, contentType string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) SettingsAPISet(ctx context.Context, body SettingsAPISetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSettingsAPISetRequest(c.Server, body)
+func (c *Client) DeployementAPILogs(ctx context.Context, id string, params *DeployementAPILogsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeployementAPILogsRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
@@ -623,8 +909,8 @@ func (c *Client) SettingsAPISet(ctx context.Context, body SettingsAPISetJSONRequ
 	return c.Client.Do(req)
 }
 
-func (c *Client) StatsAPIGet(ctx context.Context, days int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewStatsAPIGetRequest(c.Server, days)
+func (c *Client) DiffAPIGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDiffAPIGetRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -635,8 +921,8 @@ func (c *Client) StatsAPIGet(ctx context.Context, days int32, reqEditors ...Requ
 	return c.Client.Do(req)
 }
 
-func (c *Client) StatusAPIGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewStatusAPIGetRequest(c.Server)
+func (c *Client) FeaturesAPIGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFeaturesAPIGetRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -647,8 +933,8 @@ func (c *Client) StatusAPIGet(ctx context.Context, reqEditors ...RequestEditorFn
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserAPIDelete(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserAPIDeleteRequest(c.Server)
+func (c *Client) NotificationsAPIList(ctx context.Context, params *NotificationsAPIListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewNotificationsAPIListRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -659,8 +945,8 @@ func (c *Client) UserAPIDelete(ctx context.Context, reqEditors ...RequestEditorF
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserAPIGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserAPIGetRequest(c.Server)
+func (c *Client) SchedulesAPIGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSchedulesAPIGetRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -671,8 +957,8 @@ func (c *Client) UserAPIGet(ctx context.Context, reqEditors ...RequestEditorFn)
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserAPIChangePasswordWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserAPIChangePasswordRequestWithBody(c.Server, contentType, body)
+func (c *Client) SecretsAPIList(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSecretsAPIListRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -683,8 +969,8 @@ func (c *Client) UserAPIChangePasswordWithBody(ctx context.Context, contentType
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserAPIChangePassword(ctx context.Context, body UserAPIChangePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserAPIChangePasswordRequest(c.Server, body)
+func (c *Client) SecretsAPISetWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSecretsAPISetRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -695,169 +981,176 @@ func (c *Client) UserAPIChangePassword(ctx context.Context, body UserAPIChangePa
 	return c.Client.Do(req)
 }
 
-// NewAuthAPILoginRequest calls the generic AuthAPILogin builder with application/json body
-func NewAuthAPILoginRequest(server string, body AuthAPILoginJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) SecretsAPISet(ctx context.Context, body SecretsAPISetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSecretsAPISetRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewAuthAPILoginRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewAuthAPILoginRequestWithBody generates requests for AuthAPILogin with any type of body
-func NewAuthAPILoginRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) SecretsAPIDelete(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSecretsAPIDeleteRequest(c.Server, name)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/auth/login")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) SettingsAPIGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSettingsAPIGetRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewAuthAPILogoutRequest generates requests for AuthAPILogout
-func NewAuthAPILogoutRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) SettingsAPISetWithBody(ctx context.Context, params *SettingsAPISetParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSettingsAPISetRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/auth/logout")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) SettingsAPISet(ctx context.Context, params *SettingsAPISetParams, body SettingsAPISetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSettingsAPISetRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewAuthAPIRefreshRequest generates requests for AuthAPIRefresh
-func NewAuthAPIRefreshRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) StacksAPIRestartContainer(ctx context.Context, name string, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStacksAPIRestartContainerRequest(c.Server, name, id)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/auth/refresh")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) StacksAPIAction(ctx context.Context, name string, action StackAction, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStacksAPIActionRequest(c.Server, name, action)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewAuthAPIRegisteredRequest generates requests for AuthAPIRegistered
-func NewAuthAPIRegisteredRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) StatsAPIDaily(ctx context.Context, days int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStatsAPIDailyRequest(c.Server, days)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/auth/register")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) StatsAPIGet(ctx context.Context, days int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStatsAPIGetRequest(c.Server, days)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewAuthAPIRegisterRequest calls the generic AuthAPIRegister builder with application/json body
-func NewAuthAPIRegisterRequest(server string, body AuthAPIRegisterJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) StatusAPIGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStatusAPIGetRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewAuthAPIRegisterRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewAuthAPIRegisterRequestWithBody generates requests for AuthAPIRegister with any type of body
-func NewAuthAPIRegisterRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) UserAPIDelete(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserAPIDeleteRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/auth/register")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UserAPIGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserAPIGetRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+func (c *Client) UserAPIChangePasswordWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserAPIChangePasswordRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+func (c *Client) UserAPIChangePassword(ctx context.Context, body UserAPIChangePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserAPIChangePasswordRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewConfigAPIGetRequest generates requests for ConfigAPIGet
-func NewConfigAPIGetRequest(server string) (*http.Request, error) {
+// NewAdminAPIBackupRequest generates requests for AdminAPIBackup
+func NewAdminAPIBackupRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -865,7 +1158,7 @@ func NewConfigAPIGetRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/config")
+	operationPath := fmt.Sprintf("/api/admin/backup")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -883,19 +1176,19 @@ func NewConfigAPIGetRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewConfigAPISetRequest calls the generic ConfigAPISet builder with application/json body
-func NewConfigAPISetRequest(server string, body ConfigAPISetJSONRequestBody) (*http.Request, error) {
+// NewAuthAPILoginRequest calls the generic AuthAPILogin builder with application/json body
+func NewAuthAPILoginRequest(server string, body AuthAPILoginJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewConfigAPISetRequestWithBody(server, "application/json", bodyReader)
+	return NewAuthAPILoginRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewConfigAPISetRequestWithBody generates requests for ConfigAPISet with any type of body
-func NewConfigAPISetRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewAuthAPILoginRequestWithBody generates requests for AuthAPILogin with any type of body
+func NewAuthAPILoginRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -903,7 +1196,7 @@ func NewConfigAPISetRequestWithBody(server string, contentType string, body io.R
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/config")
+	operationPath := fmt.Sprintf("/api/auth/login")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -923,8 +1216,8 @@ func NewConfigAPISetRequestWithBody(server string, contentType string, body io.R
 	return req, nil
 }
 
-// NewDeployementAPIListRequest generates requests for DeployementAPIList
-func NewDeployementAPIListRequest(server string, params *DeployementAPIListParams) (*http.Request, error) {
+// NewAuthAPILogoutRequest generates requests for AuthAPILogout
+func NewAuthAPILogoutRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -932,7 +1225,7 @@ func NewDeployementAPIListRequest(server string, params *DeployementAPIListParam
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/deployment")
+	operationPath := fmt.Sprintf("/api/auth/logout")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -942,41 +1235,34 @@ func NewDeployementAPIListRequest(server string, params *DeployementAPIListParam
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", false, "limit", runtime.ParamLocationQuery, params.Limit); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
+	return req, nil
+}
 
-		if params.Offset != nil {
+// NewAuthAPIRefreshRequest generates requests for AuthAPIRefresh
+func NewAuthAPIRefreshRequest(server string) (*http.Request, error) {
+	var err error
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	operationPath := fmt.Sprintf("/api/auth/refresh")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-		queryURL.RawQuery = queryValues.Encode()
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -984,8 +1270,8 @@ func NewDeployementAPIListRequest(server string, params *DeployementAPIListParam
 	return req, nil
 }
 
-// NewDeployementAPISyncRequest generates requests for DeployementAPISync
-func NewDeployementAPISyncRequest(server string) (*http.Request, error) {
+// NewAuthAPIRegisteredRequest generates requests for AuthAPIRegistered
+func NewAuthAPIRegisteredRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -993,7 +1279,7 @@ func NewDeployementAPISyncRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/deployment")
+	operationPath := fmt.Sprintf("/api/auth/register")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1003,7 +1289,7 @@ func NewDeployementAPISyncRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1011,23 +1297,27 @@ func NewDeployementAPISyncRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewDeployementAPIReadRequest generates requests for DeployementAPIRead
-func NewDeployementAPIReadRequest(server string, id string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+// NewAuthAPIRegisterRequest calls the generic AuthAPIRegister builder with application/json body
+func NewAuthAPIRegisterRequest(server string, body AuthAPIRegisterJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAuthAPIRegisterRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAuthAPIRegisterRequestWithBody generates requests for AuthAPIRegister with any type of body
+func NewAuthAPIRegisterRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/deployment/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/auth/register")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1037,16 +1327,18 @@ func NewDeployementAPIReadRequest(server string, id string) (*http.Request, erro
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewDiffAPIGetRequest generates requests for DiffAPIGet
-func NewDiffAPIGetRequest(server string) (*http.Request, error) {
+// NewConfigAPIGetRequest generates requests for ConfigAPIGet
+func NewConfigAPIGetRequest(server string, params *ConfigAPIGetParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1054,7 +1346,7 @@ func NewDiffAPIGetRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/diff")
+	operationPath := fmt.Sprintf("/api/config")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1064,6 +1356,28 @@ func NewDiffAPIGetRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Resolved != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "resolved", runtime.ParamLocationQuery, *params.Resolved); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -1072,8 +1386,19 @@ func NewDiffAPIGetRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewFeaturesAPIGetRequest generates requests for FeaturesAPIGet
-func NewFeaturesAPIGetRequest(server string) (*http.Request, error) {
+// NewConfigAPISetRequest calls the generic ConfigAPISet builder with application/json body
+func NewConfigAPISetRequest(server string, params *ConfigAPISetParams, body ConfigAPISetJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewConfigAPISetRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewConfigAPISetRequestWithBody generates requests for ConfigAPISet with any type of body
+func NewConfigAPISetRequestWithBody(server string, params *ConfigAPISetParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1081,7 +1406,7 @@ func NewFeaturesAPIGetRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/features")
+	operationPath := fmt.Sprintf("/api/config")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1091,16 +1416,33 @@ func NewFeaturesAPIGetRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
 	return req, nil
 }
 
-// NewNotificationsAPIListRequest generates requests for NotificationsAPIList
-func NewNotificationsAPIListRequest(server string, params *NotificationsAPIListParams) (*http.Request, error) {
+// NewConfigHistoryAPIListRequest generates requests for ConfigHistoryAPIList
+func NewConfigHistoryAPIListRequest(server string, params *ConfigHistoryAPIListParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1108,7 +1450,7 @@ func NewNotificationsAPIListRequest(server string, params *NotificationsAPIListP
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/notifications")
+	operationPath := fmt.Sprintf("/api/config/history")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1160,8 +1502,8 @@ func NewNotificationsAPIListRequest(server string, params *NotificationsAPIListP
 	return req, nil
 }
 
-// NewSettingsAPIGetRequest generates requests for SettingsAPIGet
-func NewSettingsAPIGetRequest(server string) (*http.Request, error) {
+// NewConfigHistoryAPIDiffRequest generates requests for ConfigHistoryAPIDiff
+func NewConfigHistoryAPIDiffRequest(server string, params *ConfigHistoryAPIDiffParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1169,7 +1511,7 @@ func NewSettingsAPIGetRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/settings")
+	operationPath := fmt.Sprintf("/api/config/history/diff")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1179,6 +1521,36 @@ func NewSettingsAPIGetRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", false, "from", runtime.ParamLocationQuery, params.From); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", false, "to", runtime.ParamLocationQuery, params.To); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -1187,27 +1559,23 @@ func NewSettingsAPIGetRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewSettingsAPISetRequest calls the generic SettingsAPISet builder with application/json body
-func NewSettingsAPISetRequest(server string, body SettingsAPISetJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// NewConfigHistoryAPIReadRequest generates requests for ConfigHistoryAPIRead
+func NewConfigHistoryAPIReadRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewSettingsAPISetRequestWithBody(server, "application/json", bodyReader)
-}
-
-// NewSettingsAPISetRequestWithBody generates requests for SettingsAPISet with any type of body
-func NewSettingsAPISetRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/settings")
+	operationPath := fmt.Sprintf("/api/config/history/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1217,23 +1585,21 @@ func NewSettingsAPISetRequestWithBody(server string, contentType string, body io
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewStatsAPIGetRequest generates requests for StatsAPIGet
-func NewStatsAPIGetRequest(server string, days int32) (*http.Request, error) {
+// NewConfigHistoryAPIRestoreRequest generates requests for ConfigHistoryAPIRestore
+func NewConfigHistoryAPIRestoreRequest(server string, id string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "days", runtime.ParamLocationPath, days)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
 	if err != nil {
 		return nil, err
 	}
@@ -1243,7 +1609,7 @@ func NewStatsAPIGetRequest(server string, days int32) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/stats/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/config/history/%s/restore", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1253,7 +1619,7 @@ func NewStatsAPIGetRequest(server string, days int32) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1261,8 +1627,8 @@ func NewStatsAPIGetRequest(server string, days int32) (*http.Request, error) {
 	return req, nil
 }
 
-// NewStatusAPIGetRequest generates requests for StatusAPIGet
-func NewStatusAPIGetRequest(server string) (*http.Request, error) {
+// NewConfigAPIRequirementsRequest generates requests for ConfigAPIRequirements
+func NewConfigAPIRequirementsRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1270,7 +1636,7 @@ func NewStatusAPIGetRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/status")
+	operationPath := fmt.Sprintf("/api/config/requirements")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1288,8 +1654,8 @@ func NewStatusAPIGetRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewUserAPIDeleteRequest generates requests for UserAPIDelete
-func NewUserAPIDeleteRequest(server string) (*http.Request, error) {
+// NewDeployementAPIListRequest generates requests for DeployementAPIList
+func NewDeployementAPIListRequest(server string, params *DeployementAPIListParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1297,7 +1663,7 @@ func NewUserAPIDeleteRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/user")
+	operationPath := fmt.Sprintf("/api/deployment")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1307,7 +1673,41 @@ func NewUserAPIDeleteRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", false, "limit", runtime.ParamLocationQuery, params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1315,8 +1715,8 @@ func NewUserAPIDeleteRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewUserAPIGetRequest generates requests for UserAPIGet
-func NewUserAPIGetRequest(server string) (*http.Request, error) {
+// NewDeployementAPISyncRequest generates requests for DeployementAPISync
+func NewDeployementAPISyncRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1324,7 +1724,7 @@ func NewUserAPIGetRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/user")
+	operationPath := fmt.Sprintf("/api/deployment")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1334,7 +1734,7 @@ func NewUserAPIGetRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1342,27 +1742,23 @@ func NewUserAPIGetRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewUserAPIChangePasswordRequest calls the generic UserAPIChangePassword builder with application/json body
-func NewUserAPIChangePasswordRequest(server string, body UserAPIChangePasswordJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// NewDeployementAPIReadRequest generates requests for DeployementAPIRead
+func NewDeployementAPIReadRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUserAPIChangePasswordRequestWithBody(server, "application/json", bodyReader)
-}
-
-// NewUserAPIChangePasswordRequestWithBody generates requests for UserAPIChangePassword with any type of body
-func NewUserAPIChangePasswordRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/user/change-password")
+	operationPath := fmt.Sprintf("/api/deployment/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1372,834 +1768,2701 @@ func NewUserAPIChangePasswordRequestWithBody(server string, contentType string,
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	return nil
-}
+// NewDeployementAPILogsRequest generates requests for DeployementAPILogs
+func NewDeployementAPILogsRequest(server string, id string, params *DeployementAPILogsParams) (*http.Request, error) {
+	var err error
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+	var pathParam0 string
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// AuthAPILoginWithBodyWithResponse request with any body
-	AuthAPILoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthAPILoginResponse, error)
+	operationPath := fmt.Sprintf("/api/deployment/%s/logs", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	AuthAPILoginWithResponse(ctx context.Context, body AuthAPILoginJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthAPILoginResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// AuthAPILogoutWithResponse request
-	AuthAPILogoutWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AuthAPILogoutResponse, error)
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	// AuthAPIRefreshWithResponse request
-	AuthAPIRefreshWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AuthAPIRefreshResponse, error)
+		if queryFrag, err := runtime.StyleParamWithLocation("form", false, "limit", runtime.ParamLocationQuery, params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	// AuthAPIRegisteredWithResponse request
-	AuthAPIRegisteredWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AuthAPIRegisteredResponse, error)
+		if params.Offset != nil {
 
-	// AuthAPIRegisterWithBodyWithResponse request with any body
-	AuthAPIRegisterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthAPIRegisterResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	AuthAPIRegisterWithResponse(ctx context.Context, body AuthAPIRegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthAPIRegisterResponse, error)
+		}
 
-	// ConfigAPIGetWithResponse request
-	ConfigAPIGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ConfigAPIGetResponse, error)
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
-	// ConfigAPISetWithBodyWithResponse request with any body
-	ConfigAPISetWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ConfigAPISetResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	ConfigAPISetWithResponse(ctx context.Context, body ConfigAPISetJSONRequestBody, reqEditors ...RequestEditorFn) (*ConfigAPISetResponse, error)
+	return req, nil
+}
 
-	// DeployementAPIListWithResponse request
-	DeployementAPIListWithResponse(ctx context.Context, params *DeployementAPIListParams, reqEditors ...RequestEditorFn) (*DeployementAPIListResponse, error)
+// NewDiffAPIGetRequest generates requests for DiffAPIGet
+func NewDiffAPIGetRequest(server string) (*http.Request, error) {
+	var err error
 
-	// DeployementAPISyncWithResponse request
-	DeployementAPISyncWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeployementAPISyncResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeployementAPIReadWithResponse request
-	DeployementAPIReadWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeployementAPIReadResponse, error)
+	operationPath := fmt.Sprintf("/api/diff")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// DiffAPIGetWithResponse request
-	DiffAPIGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DiffAPIGetResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// FeaturesAPIGetWithResponse request
-	FeaturesAPIGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*FeaturesAPIGetResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// NotificationsAPIListWithResponse request
-	NotificationsAPIListWithResponse(ctx context.Context, params *NotificationsAPIListParams, reqEditors ...RequestEditorFn) (*NotificationsAPIListResponse, error)
+	return req, nil
+}
 
-	// SettingsAPIGetWithResponse request
-	SettingsAPIGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*SettingsAPIGetResponse, error)
+// NewFeaturesAPIGetRequest generates requests for FeaturesAPIGet
+func NewFeaturesAPIGetRequest(server string) (*http.Request, error) {
+	var err error
 
-	// SettingsAPISetWithBodyWithResponse request with any body
-	SettingsAPISetWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SettingsAPISetResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	SettingsAPISetWithResponse(ctx context.Context, body SettingsAPISetJSONRequestBody, reqEditors ...RequestEditorFn) (*SettingsAPISetResponse, error)
+	operationPath := fmt.Sprintf("/api/features")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// StatsAPIGetWithResponse request
-	StatsAPIGetWithResponse(ctx context.Context, days int32, reqEditors ...RequestEditorFn) (*StatsAPIGetResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// StatusAPIGetWithResponse request
-	StatusAPIGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*StatusAPIGetResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// UserAPIDeleteWithResponse request
-	UserAPIDeleteWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserAPIDeleteResponse, error)
+	return req, nil
+}
 
-	// UserAPIGetWithResponse request
-	UserAPIGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserAPIGetResponse, error)
+// NewNotificationsAPIListRequest generates requests for NotificationsAPIList
+func NewNotificationsAPIListRequest(server string, params *NotificationsAPIListParams) (*http.Request, error) {
+	var err error
 
-	// UserAPIChangePasswordWithBodyWithResponse request with any body
-	UserAPIChangePasswordWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserAPIChangePasswordResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	UserAPIChangePasswordWithResponse(ctx context.Context, body UserAPIChangePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*UserAPIChangePasswordResponse, error)
-}
+	operationPath := fmt.Sprintf("/api/notifications")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-type AuthAPILoginResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *BooleanResponse
-	JSONDefault  *Error
-}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r AuthAPILoginResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", false, "limit", runtime.ParamLocationQuery, params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AuthAPILoginResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type AuthAPILogoutResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *BooleanResponse
-	JSONDefault  *Error
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r AuthAPILogoutResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewSchedulesAPIGetRequest generates requests for SchedulesAPIGet
+func NewSchedulesAPIGetRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AuthAPILogoutResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/api/schedules")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-type AuthAPIRefreshResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *BooleanResponse
-	JSONDefault  *Error
-}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r AuthAPIRefreshResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AuthAPIRefreshResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewSecretsAPIListRequest generates requests for SecretsAPIList
+func NewSecretsAPIListRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type AuthAPIRegisteredResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		Registered bool `json:"registered"`
+	operationPath := fmt.Sprintf("/api/secrets")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	JSONDefault *Error
-}
 
-// Status returns HTTPResponse.Status
-func (r AuthAPIRegisteredResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AuthAPIRegisteredResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type AuthAPIRegisterResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *BooleanResponse
-	JSONDefault  *Error
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r AuthAPIRegisterResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewSecretsAPISetRequest calls the generic SecretsAPISet builder with application/json body
+func NewSecretsAPISetRequest(server string, body SecretsAPISetJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewSecretsAPISetRequestWithBody(server, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AuthAPIRegisterResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewSecretsAPISetRequestWithBody generates requests for SecretsAPISet with any type of body
+func NewSecretsAPISetRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ConfigAPIGetResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Config
-	JSONDefault  *Error
-}
+	operationPath := fmt.Sprintf("/api/secrets")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r ConfigAPIGetResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ConfigAPIGetResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ConfigAPISetResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Config
-	JSONDefault  *Error
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r ConfigAPISetResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewSecretsAPIDeleteRequest generates requests for SecretsAPIDelete
+func NewSecretsAPIDeleteRequest(server string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ConfigAPISetResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeployementAPIListResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		Items    []Deployment `json:"items"`
-		PageInfo PageInfo     `json:"pageInfo"`
+	operationPath := fmt.Sprintf("/api/secrets/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	JSONDefault *Error
-}
 
-// Status returns HTTPResponse.Status
-func (r DeployementAPIListResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeployementAPIListResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+
+	return req, nil
+}
+
+// NewSettingsAPIGetRequest generates requests for SettingsAPIGet
+func NewSettingsAPIGetRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/settings")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSettingsAPISetRequest calls the generic SettingsAPISet builder with application/json body
+func NewSettingsAPISetRequest(server string, params *SettingsAPISetParams, body SettingsAPISetJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSettingsAPISetRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewSettingsAPISetRequestWithBody generates requests for SettingsAPISet with any type of body
+func NewSettingsAPISetRequestWithBody(server string, params *SettingsAPISetParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/settings")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewStacksAPIRestartContainerRequest generates requests for StacksAPIRestartContainer
+func NewStacksAPIRestartContainerRequest(server string, name string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/stacks/%s/containers/%s/restart", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewStacksAPIActionRequest generates requests for StacksAPIAction
+func NewStacksAPIActionRequest(server string, name string, action StackAction) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "action", runtime.ParamLocationPath, action)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/stacks/%s/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewStatsAPIDailyRequest generates requests for StatsAPIDaily
+func NewStatsAPIDailyRequest(server string, days int32) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "days", runtime.ParamLocationPath, days)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/stats/daily/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewStatsAPIGetRequest generates requests for StatsAPIGet
+func NewStatsAPIGetRequest(server string, days int32) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "days", runtime.ParamLocationPath, days)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/stats/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewStatusAPIGetRequest generates requests for StatusAPIGet
+func NewStatusAPIGetRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/status")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUserAPIDeleteRequest generates requests for UserAPIDelete
+func NewUserAPIDeleteRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/user")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUserAPIGetRequest generates requests for UserAPIGet
+func NewUserAPIGetRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/user")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUserAPIChangePasswordRequest calls the generic UserAPIChangePassword builder with application/json body
+func NewUserAPIChangePasswordRequest(server string, body UserAPIChangePasswordJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUserAPIChangePasswordRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUserAPIChangePasswordRequestWithBody generates requests for UserAPIChangePassword with any type of body
+func NewUserAPIChangePasswordRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/user/change-password")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// AdminAPIBackupWithResponse request
+	AdminAPIBackupWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminAPIBackupResponse, error)
+
+	// AuthAPILoginWithBodyWithResponse request with any body
+	AuthAPILoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthAPILoginResponse, error)
+
+	AuthAPILoginWithResponse(ctx context.Context, body AuthAPILoginJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthAPILoginResponse, error)
+
+	// AuthAPILogoutWithResponse request
+	AuthAPILogoutWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AuthAPILogoutResponse, error)
+
+	// AuthAPIRefreshWithResponse request
+	AuthAPIRefreshWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AuthAPIRefreshResponse, error)
+
+	// AuthAPIRegisteredWithResponse request
+	AuthAPIRegisteredWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AuthAPIRegisteredResponse, error)
+
+	// AuthAPIRegisterWithBodyWithResponse request with any body
+	AuthAPIRegisterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthAPIRegisterResponse, error)
+
+	AuthAPIRegisterWithResponse(ctx context.Context, body AuthAPIRegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthAPIRegisterResponse, error)
+
+	// ConfigAPIGetWithResponse request
+	ConfigAPIGetWithResponse(ctx context.Context, params *ConfigAPIGetParams, reqEditors ...RequestEditorFn) (*ConfigAPIGetResponse, error)
+
+	// ConfigAPISetWithBodyWithResponse request with any body
+	ConfigAPISetWithBodyWithResponse(ctx context.Context, params *ConfigAPISetParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ConfigAPISetResponse, error)
+
+	ConfigAPISetWithResponse(ctx context.Context, params *ConfigAPISetParams, body ConfigAPISetJSONRequestBody, reqEditors ...RequestEditorFn) (*ConfigAPISetResponse, error)
+
+	// ConfigHistoryAPIListWithResponse request
+	ConfigHistoryAPIListWithResponse(ctx context.Context, params *ConfigHistoryAPIListParams, reqEditors ...RequestEditorFn) (*ConfigHistoryAPIListResponse, error)
+
+	// ConfigHistoryAPIDiffWithResponse request
+	ConfigHistoryAPIDiffWithResponse(ctx context.Context, params *ConfigHistoryAPIDiffParams, reqEditors ...RequestEditorFn) (*ConfigHistoryAPIDiffResponse, error)
+
+	// ConfigHistoryAPIReadWithResponse request
+	ConfigHistoryAPIReadWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*ConfigHistoryAPIReadResponse, error)
+
+	// ConfigHistoryAPIRestoreWithResponse request
+	ConfigHistoryAPIRestoreWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*ConfigHistoryAPIRestoreResponse, error)
+
+	// ConfigAPIRequirementsWithResponse request
+	ConfigAPIRequirementsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ConfigAPIRequirementsResponse, error)
+
+	// DeployementAPIListWithResponse request
+	DeployementAPIListWithResponse(ctx context.Context, params *DeployementAPIListParams, reqEditors ...RequestEditorFn) (*DeployementAPIListResponse, error)
+
+	// DeployementAPISyncWithResponse request
+	DeployementAPISyncWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeployementAPISyncResponse, error)
+
+	// DeployementAPIReadWithResponse request
+	DeployementAPIReadWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeployementAPIReadResponse, error)
+
+	// DeployementAPILogsWithResponse request
+	DeployementAPILogsWithResponse(ctx context.Context, id string, params *DeployementAPILogsParams, reqEditors ...RequestEditorFn) (*DeployementAPILogsResponse, error)
+
+	// DiffAPIGetWithResponse request
+	DiffAPIGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DiffAPIGetResponse, error)
+
+	// FeaturesAPIGetWithResponse request
+	FeaturesAPIGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*FeaturesAPIGetResponse, error)
+
+	// NotificationsAPIListWithResponse request
+	NotificationsAPIListWithResponse(ctx context.Context, params *NotificationsAPIListParams, reqEditors ...RequestEditorFn) (*NotificationsAPIListResponse, error)
+
+	// SchedulesAPIGetWithResponse request
+	SchedulesAPIGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*SchedulesAPIGetResponse, error)
+
+	// SecretsAPIListWithResponse request
+	SecretsAPIListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*SecretsAPIListResponse, error)
+
+	// SecretsAPISetWithBodyWithResponse request with any body
+	SecretsAPISetWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SecretsAPISetResponse, error)
+
+	SecretsAPISetWithResponse(ctx context.Context, body SecretsAPISetJSONRequestBody, reqEditors ...RequestEditorFn) (*SecretsAPISetResponse, error)
+
+	// SecretsAPIDeleteWithResponse request
+	SecretsAPIDeleteWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*SecretsAPIDeleteResponse, error)
+
+	// SettingsAPIGetWithResponse request
+	SettingsAPIGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*SettingsAPIGetResponse, error)
+
+	// SettingsAPISetWithBodyWithResponse request with any body
+	SettingsAPISetWithBodyWithResponse(ctx context.Context, params *SettingsAPISetParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SettingsAPISetResponse, error)
+
+	SettingsAPISetWithResponse(ctx context.Context, params *SettingsAPISetParams, body SettingsAPISetJSONRequestBody, reqEditors ...RequestEditorFn) (*SettingsAPISetResponse, error)
+
+	// StacksAPIRestartContainerWithResponse request
+	StacksAPIRestartContainerWithResponse(ctx context.Context, name string, id string, reqEditors ...RequestEditorFn) (*StacksAPIRestartContainerResponse, error)
+
+	// StacksAPIActionWithResponse request
+	StacksAPIActionWithResponse(ctx context.Context, name string, action StackAction, reqEditors ...RequestEditorFn) (*StacksAPIActionResponse, error)
+
+	// StatsAPIDailyWithResponse request
+	StatsAPIDailyWithResponse(ctx context.Context, days int32, reqEditors ...RequestEditorFn) (*StatsAPIDailyResponse, error)
+
+	// StatsAPIGetWithResponse request
+	StatsAPIGetWithResponse(ctx context.Context, days int32, reqEditors ...RequestEditorFn) (*StatsAPIGetResponse, error)
+
+	// StatusAPIGetWithResponse request
+	StatusAPIGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*StatusAPIGetResponse, error)
+
+	// UserAPIDeleteWithResponse request
+	UserAPIDeleteWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserAPIDeleteResponse, error)
+
+	// UserAPIGetWithResponse request
+	UserAPIGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserAPIGetResponse, error)
+
+	// UserAPIChangePasswordWithBodyWithResponse request with any body
+	UserAPIChangePasswordWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserAPIChangePasswordResponse, error)
+
+	UserAPIChangePasswordWithResponse(ctx context.Context, body UserAPIChangePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*UserAPIChangePasswordResponse, error)
+}
+
+type AdminAPIBackupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminAPIBackupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminAPIBackupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AuthAPILoginResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BooleanResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r AuthAPILoginResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AuthAPILoginResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AuthAPILogoutResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BooleanResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r AuthAPILogoutResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AuthAPILogoutResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AuthAPIRefreshResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BooleanResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r AuthAPIRefreshResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AuthAPIRefreshResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AuthAPIRegisteredResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Registered bool `json:"registered"`
+	}
+	JSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r AuthAPIRegisteredResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AuthAPIRegisteredResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AuthAPIRegisterResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BooleanResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r AuthAPIRegisterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AuthAPIRegisterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ConfigAPIGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Config
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ConfigAPIGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ConfigAPIGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ConfigAPISetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Config
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ConfigAPISetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ConfigAPISetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ConfigHistoryAPIListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Items    []ConfigRevision `json:"items"`
+		PageInfo PageInfo         `json:"pageInfo"`
+	}
+	JSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ConfigHistoryAPIListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ConfigHistoryAPIListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ConfigHistoryAPIDiffResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *FileDiff
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ConfigHistoryAPIDiffResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ConfigHistoryAPIDiffResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ConfigHistoryAPIReadResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ConfigRevisionWithContent
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ConfigHistoryAPIReadResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ConfigHistoryAPIReadResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ConfigHistoryAPIRestoreResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ConfigRevision
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ConfigHistoryAPIRestoreResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ConfigHistoryAPIRestoreResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ConfigAPIRequirementsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ServiceRequirements
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ConfigAPIRequirementsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ConfigAPIRequirementsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeployementAPIListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Items    []Deployment `json:"items"`
+		PageInfo PageInfo     `json:"pageInfo"`
+	}
+	JSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeployementAPIListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeployementAPIListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
 }
 
 type DeployementAPISyncResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *DeploymentWithDetails
+	JSON200      *DeploymentWithDetails
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeployementAPISyncResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeployementAPISyncResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeployementAPIReadResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeploymentWithDetails
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeployementAPIReadResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeployementAPIReadResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeployementAPILogsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Items    []DeploymentLog `json:"items"`
+		PageInfo PageInfo        `json:"pageInfo"`
+	}
+	JSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeployementAPILogsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeployementAPILogsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DiffAPIGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]FileDiff
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DiffAPIGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DiffAPIGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FeaturesAPIGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Features
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r FeaturesAPIGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FeaturesAPIGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type NotificationsAPIListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Items    []Event  `json:"items"`
+		PageInfo PageInfo `json:"pageInfo"`
+	}
+	JSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r NotificationsAPIListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r NotificationsAPIListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SchedulesAPIGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Schedules
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SchedulesAPIGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SchedulesAPIGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SecretsAPIListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Secret
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SecretsAPIListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SecretsAPIListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SecretsAPISetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Secret
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SecretsAPISetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SecretsAPISetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SecretsAPIDeleteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BooleanResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SecretsAPIDeleteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SecretsAPIDeleteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SettingsAPIGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Settings
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SettingsAPIGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SettingsAPIGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SettingsAPISetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Settings
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SettingsAPISetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SettingsAPISetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StacksAPIRestartContainerResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeploymentWithDetails
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r StacksAPIRestartContainerResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StacksAPIRestartContainerResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StacksAPIActionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeploymentWithDetails
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r StacksAPIActionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StacksAPIActionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StatsAPIDailyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]DailyStats
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r StatsAPIDailyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StatsAPIDailyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StatsAPIGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Stats
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r StatsAPIGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StatsAPIGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StatusAPIGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]StackStatus
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r StatusAPIGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StatusAPIGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserAPIDeleteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BooleanResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UserAPIDeleteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserAPIDeleteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserAPIGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *User
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UserAPIGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserAPIGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserAPIChangePasswordResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BooleanResponse
 	JSONDefault  *Error
 }
 
-// Status returns HTTPResponse.Status
-func (r DeployementAPISyncResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// Status returns HTTPResponse.Status
+func (r UserAPIChangePasswordResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserAPIChangePasswordResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// AdminAPIBackupWithResponse request returning *AdminAPIBackupResponse
+func (c *ClientWithResponses) AdminAPIBackupWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminAPIBackupResponse, error) {
+	rsp, err := c.AdminAPIBackup(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminAPIBackupResponse(rsp)
+}
+
+// AuthAPILoginWithBodyWithResponse request with arbitrary body returning *AuthAPILoginResponse
+func (c *ClientWithResponses) AuthAPILoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthAPILoginResponse, error) {
+	rsp, err := c.AuthAPILoginWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthAPILoginResponse(rsp)
+}
+
+func (c *ClientWithResponses) AuthAPILoginWithResponse(ctx context.Context, body AuthAPILoginJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthAPILoginResponse, error) {
+	rsp, err := c.AuthAPILogin(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthAPILoginResponse(rsp)
+}
+
+// AuthAPILogoutWithResponse request returning *AuthAPILogoutResponse
+func (c *ClientWithResponses) AuthAPILogoutWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AuthAPILogoutResponse, error) {
+	rsp, err := c.AuthAPILogout(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthAPILogoutResponse(rsp)
+}
+
+// AuthAPIRefreshWithResponse request returning *AuthAPIRefreshResponse
+func (c *ClientWithResponses) AuthAPIRefreshWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AuthAPIRefreshResponse, error) {
+	rsp, err := c.AuthAPIRefresh(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthAPIRefreshResponse(rsp)
+}
+
+// AuthAPIRegisteredWithResponse request returning *AuthAPIRegisteredResponse
+func (c *ClientWithResponses) AuthAPIRegisteredWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AuthAPIRegisteredResponse, error) {
+	rsp, err := c.AuthAPIRegistered(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthAPIRegisteredResponse(rsp)
+}
+
+// AuthAPIRegisterWithBodyWithResponse request with arbitrary body returning *AuthAPIRegisterResponse
+func (c *ClientWithResponses) AuthAPIRegisterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthAPIRegisterResponse, error) {
+	rsp, err := c.AuthAPIRegisterWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthAPIRegisterResponse(rsp)
+}
+
+func (c *ClientWithResponses) AuthAPIRegisterWithResponse(ctx context.Context, body AuthAPIRegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthAPIRegisterResponse, error) {
+	rsp, err := c.AuthAPIRegister(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthAPIRegisterResponse(rsp)
+}
+
+// ConfigAPIGetWithResponse request returning *ConfigAPIGetResponse
+func (c *ClientWithResponses) ConfigAPIGetWithResponse(ctx context.Context, params *ConfigAPIGetParams, reqEditors ...RequestEditorFn) (*ConfigAPIGetResponse, error) {
+	rsp, err := c.ConfigAPIGet(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConfigAPIGetResponse(rsp)
+}
+
+// ConfigAPISetWithBodyWithResponse request with arbitrary body returning *ConfigAPISetResponse
+func (c *ClientWithResponses) ConfigAPISetWithBodyWithResponse(ctx context.Context, params *ConfigAPISetParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ConfigAPISetResponse, error) {
+	rsp, err := c.ConfigAPISetWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConfigAPISetResponse(rsp)
+}
+
+func (c *ClientWithResponses) ConfigAPISetWithResponse(ctx context.Context, params *ConfigAPISetParams, body ConfigAPISetJSONRequestBody, reqEditors ...RequestEditorFn) (*ConfigAPISetResponse, error) {
+	rsp, err := c.ConfigAPISet(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConfigAPISetResponse(rsp)
+}
+
+// ConfigHistoryAPIListWithResponse request returning *ConfigHistoryAPIListResponse
+func (c *ClientWithResponses) ConfigHistoryAPIListWithResponse(ctx context.Context, params *ConfigHistoryAPIListParams, reqEditors ...RequestEditorFn) (*ConfigHistoryAPIListResponse, error) {
+	rsp, err := c.ConfigHistoryAPIList(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConfigHistoryAPIListResponse(rsp)
+}
+
+// ConfigHistoryAPIDiffWithResponse request returning *ConfigHistoryAPIDiffResponse
+func (c *ClientWithResponses) ConfigHistoryAPIDiffWithResponse(ctx context.Context, params *ConfigHistoryAPIDiffParams, reqEditors ...RequestEditorFn) (*ConfigHistoryAPIDiffResponse, error) {
+	rsp, err := c.ConfigHistoryAPIDiff(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConfigHistoryAPIDiffResponse(rsp)
+}
+
+// ConfigHistoryAPIReadWithResponse request returning *ConfigHistoryAPIReadResponse
+func (c *ClientWithResponses) ConfigHistoryAPIReadWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*ConfigHistoryAPIReadResponse, error) {
+	rsp, err := c.ConfigHistoryAPIRead(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConfigHistoryAPIReadResponse(rsp)
+}
+
+// ConfigHistoryAPIRestoreWithResponse request returning *ConfigHistoryAPIRestoreResponse
+func (c *ClientWithResponses) ConfigHistoryAPIRestoreWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*ConfigHistoryAPIRestoreResponse, error) {
+	rsp, err := c.ConfigHistoryAPIRestore(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConfigHistoryAPIRestoreResponse(rsp)
+}
+
+// ConfigAPIRequirementsWithResponse request returning *ConfigAPIRequirementsResponse
+func (c *ClientWithResponses) ConfigAPIRequirementsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ConfigAPIRequirementsResponse, error) {
+	rsp, err := c.ConfigAPIRequirements(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConfigAPIRequirementsResponse(rsp)
+}
+
+// DeployementAPIListWithResponse request returning *DeployementAPIListResponse
+func (c *ClientWithResponses) DeployementAPIListWithResponse(ctx context.Context, params *DeployementAPIListParams, reqEditors ...RequestEditorFn) (*DeployementAPIListResponse, error) {
+	rsp, err := c.DeployementAPIList(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeployementAPIListResponse(rsp)
+}
+
+// DeployementAPISyncWithResponse request returning *DeployementAPISyncResponse
+func (c *ClientWithResponses) DeployementAPISyncWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeployementAPISyncResponse, error) {
+	rsp, err := c.DeployementAPISync(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeployementAPISyncResponse(rsp)
+}
+
+// DeployementAPIReadWithResponse request returning *DeployementAPIReadResponse
+func (c *ClientWithResponses) DeployementAPIReadWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeployementAPIReadResponse, error) {
+	rsp, err := c.DeployementAPIRead(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeployementAPIReadResponse(rsp)
+}
+
+// DeployementAPILogsWithResponse request returning *DeployementAPILogsResponse
+func (c *ClientWithResponses) DeployementAPILogsWithResponse(ctx context.Context, id string, params *DeployementAPILogsParams, reqEditors ...RequestEditorFn) (*DeployementAPILogsResponse, error) {
+	rsp, err := c.DeployementAPILogs(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeployementAPILogsResponse(rsp)
+}
+
+// DiffAPIGetWithResponse request returning *DiffAPIGetResponse
+func (c *ClientWithResponses) DiffAPIGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DiffAPIGetResponse, error) {
+	rsp, err := c.DiffAPIGet(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDiffAPIGetResponse(rsp)
+}
+
+// FeaturesAPIGetWithResponse request returning *FeaturesAPIGetResponse
+func (c *ClientWithResponses) FeaturesAPIGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*FeaturesAPIGetResponse, error) {
+	rsp, err := c.FeaturesAPIGet(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFeaturesAPIGetResponse(rsp)
+}
+
+// NotificationsAPIListWithResponse request returning *NotificationsAPIListResponse
+func (c *ClientWithResponses) NotificationsAPIListWithResponse(ctx context.Context, params *NotificationsAPIListParams, reqEditors ...RequestEditorFn) (*NotificationsAPIListResponse, error) {
+	rsp, err := c.NotificationsAPIList(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseNotificationsAPIListResponse(rsp)
+}
+
+// SchedulesAPIGetWithResponse request returning *SchedulesAPIGetResponse
+func (c *ClientWithResponses) SchedulesAPIGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*SchedulesAPIGetResponse, error) {
+	rsp, err := c.SchedulesAPIGet(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSchedulesAPIGetResponse(rsp)
+}
+
+// SecretsAPIListWithResponse request returning *SecretsAPIListResponse
+func (c *ClientWithResponses) SecretsAPIListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*SecretsAPIListResponse, error) {
+	rsp, err := c.SecretsAPIList(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSecretsAPIListResponse(rsp)
+}
+
+// SecretsAPISetWithBodyWithResponse request with arbitrary body returning *SecretsAPISetResponse
+func (c *ClientWithResponses) SecretsAPISetWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SecretsAPISetResponse, error) {
+	rsp, err := c.SecretsAPISetWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSecretsAPISetResponse(rsp)
+}
+
+func (c *ClientWithResponses) SecretsAPISetWithResponse(ctx context.Context, body SecretsAPISetJSONRequestBody, reqEditors ...RequestEditorFn) (*SecretsAPISetResponse, error) {
+	rsp, err := c.SecretsAPISet(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSecretsAPISetResponse(rsp)
+}
+
+// SecretsAPIDeleteWithResponse request returning *SecretsAPIDeleteResponse
+func (c *ClientWithResponses) SecretsAPIDeleteWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*SecretsAPIDeleteResponse, error) {
+	rsp, err := c.SecretsAPIDelete(ctx, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSecretsAPIDeleteResponse(rsp)
+}
+
+// SettingsAPIGetWithResponse request returning *SettingsAPIGetResponse
+func (c *ClientWithResponses) SettingsAPIGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*SettingsAPIGetResponse, error) {
+	rsp, err := c.SettingsAPIGet(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSettingsAPIGetResponse(rsp)
+}
+
+// SettingsAPISetWithBodyWithResponse request with arbitrary body returning *SettingsAPISetResponse
+func (c *ClientWithResponses) SettingsAPISetWithBodyWithResponse(ctx context.Context, params *SettingsAPISetParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SettingsAPISetResponse, error) {
+	rsp, err := c.SettingsAPISetWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSettingsAPISetResponse(rsp)
+}
+
+func (c *ClientWithResponses) SettingsAPISetWithResponse(ctx context.Context, params *SettingsAPISetParams, body SettingsAPISetJSONRequestBody, reqEditors ...RequestEditorFn) (*SettingsAPISetResponse, error) {
+	rsp, err := c.SettingsAPISet(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSettingsAPISetResponse(rsp)
+}
+
+// StacksAPIRestartContainerWithResponse request returning *StacksAPIRestartContainerResponse
+func (c *ClientWithResponses) StacksAPIRestartContainerWithResponse(ctx context.Context, name string, id string, reqEditors ...RequestEditorFn) (*StacksAPIRestartContainerResponse, error) {
+	rsp, err := c.StacksAPIRestartContainer(ctx, name, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStacksAPIRestartContainerResponse(rsp)
+}
+
+// StacksAPIActionWithResponse request returning *StacksAPIActionResponse
+func (c *ClientWithResponses) StacksAPIActionWithResponse(ctx context.Context, name string, action StackAction, reqEditors ...RequestEditorFn) (*StacksAPIActionResponse, error) {
+	rsp, err := c.StacksAPIAction(ctx, name, action, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStacksAPIActionResponse(rsp)
+}
+
+// StatsAPIDailyWithResponse request returning *StatsAPIDailyResponse
+func (c *ClientWithResponses) StatsAPIDailyWithResponse(ctx context.Context, days int32, reqEditors ...RequestEditorFn) (*StatsAPIDailyResponse, error) {
+	rsp, err := c.StatsAPIDaily(ctx, days, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStatsAPIDailyResponse(rsp)
+}
+
+// StatsAPIGetWithResponse request returning *StatsAPIGetResponse
+func (c *ClientWithResponses) StatsAPIGetWithResponse(ctx context.Context, days int32, reqEditors ...RequestEditorFn) (*StatsAPIGetResponse, error) {
+	rsp, err := c.StatsAPIGet(ctx, days, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStatsAPIGetResponse(rsp)
+}
+
+// StatusAPIGetWithResponse request returning *StatusAPIGetResponse
+func (c *ClientWithResponses) StatusAPIGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*StatusAPIGetResponse, error) {
+	rsp, err := c.StatusAPIGet(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStatusAPIGetResponse(rsp)
+}
+
+// UserAPIDeleteWithResponse request returning *UserAPIDeleteResponse
+func (c *ClientWithResponses) UserAPIDeleteWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserAPIDeleteResponse, error) {
+	rsp, err := c.UserAPIDelete(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserAPIDeleteResponse(rsp)
+}
+
+// UserAPIGetWithResponse request returning *UserAPIGetResponse
+func (c *ClientWithResponses) UserAPIGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserAPIGetResponse, error) {
+	rsp, err := c.UserAPIGet(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserAPIGetResponse(rsp)
+}
+
+// UserAPIChangePasswordWithBodyWithResponse request with arbitrary body returning *UserAPIChangePasswordResponse
+func (c *ClientWithResponses) UserAPIChangePasswordWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserAPIChangePasswordResponse, error) {
+	rsp, err := c.UserAPIChangePasswordWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserAPIChangePasswordResponse(rsp)
+}
+
+func (c *ClientWithResponses) UserAPIChangePasswordWithResponse(ctx context.Context, body UserAPIChangePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*UserAPIChangePasswordResponse, error) {
+	rsp, err := c.UserAPIChangePassword(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserAPIChangePasswordResponse(rsp)
+}
+
+// ParseAdminAPIBackupResponse parses an HTTP response from a AdminAPIBackupWithResponse call
+func ParseAdminAPIBackupResponse(rsp *http.Response) (*AdminAPIBackupResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminAPIBackupResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAuthAPILoginResponse parses an HTTP response from a AuthAPILoginWithResponse call
+func ParseAuthAPILoginResponse(rsp *http.Response) (*AuthAPILoginResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AuthAPILoginResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BooleanResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAuthAPILogoutResponse parses an HTTP response from a AuthAPILogoutWithResponse call
+func ParseAuthAPILogoutResponse(rsp *http.Response) (*AuthAPILogoutResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AuthAPILogoutResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BooleanResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAuthAPIRefreshResponse parses an HTTP response from a AuthAPIRefreshWithResponse call
+func ParseAuthAPIRefreshResponse(rsp *http.Response) (*AuthAPIRefreshResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AuthAPIRefreshResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BooleanResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return http.StatusText(0)
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeployementAPISyncResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseAuthAPIRegisteredResponse parses an HTTP response from a AuthAPIRegisteredWithResponse call
+func ParseAuthAPIRegisteredResponse(rsp *http.Response) (*AuthAPIRegisteredResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeployementAPIReadResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DeploymentWithDetails
-	JSONDefault  *Error
-}
+	response := &AuthAPIRegisteredResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Registered bool `json:"registered"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
 
-// Status returns HTTPResponse.Status
-func (r DeployementAPIReadResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeployementAPIReadResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseAuthAPIRegisterResponse parses an HTTP response from a AuthAPIRegisterWithResponse call
+func ParseAuthAPIRegisterResponse(rsp *http.Response) (*AuthAPIRegisterResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DiffAPIGetResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]FileDiff
-	JSONDefault  *Error
-}
+	response := &AuthAPIRegisterResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BooleanResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
 
-// Status returns HTTPResponse.Status
-func (r DiffAPIGetResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DiffAPIGetResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseConfigAPIGetResponse parses an HTTP response from a ConfigAPIGetWithResponse call
+func ParseConfigAPIGetResponse(rsp *http.Response) (*ConfigAPIGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type FeaturesAPIGetResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Features
-	JSONDefault  *Error
-}
+	response := &ConfigAPIGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Config
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
 
-// Status returns HTTPResponse.Status
-func (r FeaturesAPIGetResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r FeaturesAPIGetResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseConfigAPISetResponse parses an HTTP response from a ConfigAPISetWithResponse call
+func ParseConfigAPISetResponse(rsp *http.Response) (*ConfigAPISetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type NotificationsAPIListResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		Items    []Event  `json:"items"`
-		PageInfo PageInfo `json:"pageInfo"`
+	response := &ConfigAPISetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	JSONDefault *Error
-}
 
-// Status returns HTTPResponse.Status
-func (r NotificationsAPIListResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Config
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return http.StatusText(0)
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r NotificationsAPIListResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseConfigHistoryAPIListResponse parses an HTTP response from a ConfigHistoryAPIListWithResponse call
+func ParseConfigHistoryAPIListResponse(rsp *http.Response) (*ConfigHistoryAPIListResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type SettingsAPIGetResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Settings
-	JSONDefault  *Error
-}
+	response := &ConfigHistoryAPIListResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Items    []ConfigRevision `json:"items"`
+			PageInfo PageInfo         `json:"pageInfo"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
 
-// Status returns HTTPResponse.Status
-func (r SettingsAPIGetResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r SettingsAPIGetResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseConfigHistoryAPIDiffResponse parses an HTTP response from a ConfigHistoryAPIDiffWithResponse call
+func ParseConfigHistoryAPIDiffResponse(rsp *http.Response) (*ConfigHistoryAPIDiffResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ConfigHistoryAPIDiffResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FileDiff
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return 0
-}
 
-type SettingsAPISetResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Settings
-	JSONDefault  *Error
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r SettingsAPISetResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseConfigHistoryAPIReadResponse parses an HTTP response from a ConfigHistoryAPIReadWithResponse call
+func ParseConfigHistoryAPIReadResponse(rsp *http.Response) (*ConfigHistoryAPIReadResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r SettingsAPISetResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &ConfigHistoryAPIReadResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type StatsAPIGetResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Stats
-	JSONDefault  *Error
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ConfigRevisionWithContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r StatsAPIGetResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r StatsAPIGetResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type StatusAPIGetResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]StackStatus
-	JSONDefault  *Error
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r StatusAPIGetResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseConfigHistoryAPIRestoreResponse parses an HTTP response from a ConfigHistoryAPIRestoreWithResponse call
+func ParseConfigHistoryAPIRestoreResponse(rsp *http.Response) (*ConfigHistoryAPIRestoreResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r StatusAPIGetResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &ConfigHistoryAPIRestoreResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type UserAPIDeleteResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *BooleanResponse
-	JSONDefault  *Error
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ConfigRevision
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r UserAPIDeleteResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UserAPIDeleteResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type UserAPIGetResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *User
-	JSONDefault  *Error
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r UserAPIGetResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseConfigAPIRequirementsResponse parses an HTTP response from a ConfigAPIRequirementsWithResponse call
+func ParseConfigAPIRequirementsResponse(rsp *http.Response) (*ConfigAPIRequirementsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UserAPIGetResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &ConfigAPIRequirementsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type UserAPIChangePasswordResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *BooleanResponse
-	JSONDefault  *Error
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ServiceRequirements
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r UserAPIChangePasswordResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UserAPIChangePasswordResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-// AuthAPILoginWithBodyWithResponse request with arbitrary body returning *AuthAPILoginResponse
-func (c *ClientWithResponses) AuthAPILoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthAPILoginResponse, error) {
-	rsp, err := c.AuthAPILoginWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseAuthAPILoginResponse(rsp)
+	return response, nil
 }
 
-func (c *ClientWithResponses) AuthAPILoginWithResponse(ctx context.Context, body AuthAPILoginJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthAPILoginResponse, error) {
-	rsp, err := c.AuthAPILogin(ctx, body, reqEditors...)
+// ParseDeployementAPIListResponse parses an HTTP response from a DeployementAPIListWithResponse call
+func ParseDeployementAPIListResponse(rsp *http.Response) (*DeployementAPIListResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseAuthAPILoginResponse(rsp)
-}
 
-// AuthAPILogoutWithResponse request returning *AuthAPILogoutResponse
-func (c *ClientWithResponses) AuthAPILogoutWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AuthAPILogoutResponse, error) {
-	rsp, err := c.AuthAPILogout(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeployementAPIListResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseAuthAPILogoutResponse(rsp)
-}
 
-// AuthAPIRefreshWithResponse request returning *AuthAPIRefreshResponse
-func (c *ClientWithResponses) AuthAPIRefreshWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AuthAPIRefreshResponse, error) {
-	rsp, err := c.AuthAPIRefresh(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Items    []Deployment `json:"items"`
+			PageInfo PageInfo     `json:"pageInfo"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return ParseAuthAPIRefreshResponse(rsp)
+
+	return response, nil
 }
 
-// AuthAPIRegisteredWithResponse request returning *AuthAPIRegisteredResponse
-func (c *ClientWithResponses) AuthAPIRegisteredWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AuthAPIRegisteredResponse, error) {
-	rsp, err := c.AuthAPIRegistered(ctx, reqEditors...)
+// ParseDeployementAPISyncResponse parses an HTTP response from a DeployementAPISyncWithResponse call
+func ParseDeployementAPISyncResponse(rsp *http.Response) (*DeployementAPISyncResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseAuthAPIRegisteredResponse(rsp)
-}
 
-// AuthAPIRegisterWithBodyWithResponse request with arbitrary body returning *AuthAPIRegisterResponse
-func (c *ClientWithResponses) AuthAPIRegisterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthAPIRegisterResponse, error) {
-	rsp, err := c.AuthAPIRegisterWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeployementAPISyncResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseAuthAPIRegisterResponse(rsp)
-}
 
-func (c *ClientWithResponses) AuthAPIRegisterWithResponse(ctx context.Context, body AuthAPIRegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*AuthAPIRegisterResponse, error) {
-	rsp, err := c.AuthAPIRegister(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeploymentWithDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return ParseAuthAPIRegisterResponse(rsp)
+
+	return response, nil
 }
 
-// ConfigAPIGetWithResponse request returning *ConfigAPIGetResponse
-func (c *ClientWithResponses) ConfigAPIGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ConfigAPIGetResponse, error) {
-	rsp, err := c.ConfigAPIGet(ctx, reqEditors...)
+// ParseDeployementAPIReadResponse parses an HTTP response from a DeployementAPIReadWithResponse call
+func ParseDeployementAPIReadResponse(rsp *http.Response) (*DeployementAPIReadResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseConfigAPIGetResponse(rsp)
-}
 
-// ConfigAPISetWithBodyWithResponse request with arbitrary body returning *ConfigAPISetResponse
-func (c *ClientWithResponses) ConfigAPISetWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ConfigAPISetResponse, error) {
-	rsp, err := c.ConfigAPISetWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeployementAPIReadResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseConfigAPISetResponse(rsp)
-}
 
-func (c *ClientWithResponses) ConfigAPISetWithResponse(ctx context.Context, body ConfigAPISetJSONRequestBody, reqEditors ...RequestEditorFn) (*ConfigAPISetResponse, error) {
-	rsp, err := c.ConfigAPISet(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeploymentWithDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return ParseConfigAPISetResponse(rsp)
+
+	return response, nil
 }
 
-// DeployementAPIListWithResponse request returning *DeployementAPIListResponse
-func (c *ClientWithResponses) DeployementAPIListWithResponse(ctx context.Context, params *DeployementAPIListParams, reqEditors ...RequestEditorFn) (*DeployementAPIListResponse, error) {
-	rsp, err := c.DeployementAPIList(ctx, params, reqEditors...)
+// ParseDeployementAPILogsResponse parses an HTTP response from a DeployementAPILogsWithResponse call
+func ParseDeployementAPILogsResponse(rsp *http.Response) (*DeployementAPILogsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeployementAPIListResponse(rsp)
-}
 
-// DeployementAPISyncWithResponse request returning *DeployementAPISyncResponse
-func (c *ClientWithResponses) DeployementAPISyncWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeployementAPISyncResponse, error) {
-	rsp, err := c.DeployementAPISync(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeployementAPILogsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeployementAPISyncResponse(rsp)
-}
 
-// DeployementAPIReadWithResponse request returning *DeployementAPIReadResponse
-func (c *ClientWithResponses) DeployementAPIReadWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeployementAPIReadResponse, error) {
-	rsp, err := c.DeployementAPIRead(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Items    []DeploymentLog `json:"items"`
+			PageInfo PageInfo        `json:"pageInfo"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return ParseDeployementAPIReadResponse(rsp)
+
+	return response, nil
 }
 
-// DiffAPIGetWithResponse request returning *DiffAPIGetResponse
-func (c *ClientWithResponses) DiffAPIGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DiffAPIGetResponse, error) {
-	rsp, err := c.DiffAPIGet(ctx, reqEditors...)
+// ParseDiffAPIGetResponse parses an HTTP response from a DiffAPIGetWithResponse call
+func ParseDiffAPIGetResponse(rsp *http.Response) (*DiffAPIGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDiffAPIGetResponse(rsp)
-}
 
-// FeaturesAPIGetWithResponse request returning *FeaturesAPIGetResponse
-func (c *ClientWithResponses) FeaturesAPIGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*FeaturesAPIGetResponse, error) {
-	rsp, err := c.FeaturesAPIGet(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DiffAPIGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseFeaturesAPIGetResponse(rsp)
-}
 
-// NotificationsAPIListWithResponse request returning *NotificationsAPIListResponse
-func (c *ClientWithResponses) NotificationsAPIListWithResponse(ctx context.Context, params *NotificationsAPIListParams, reqEditors ...RequestEditorFn) (*NotificationsAPIListResponse, error) {
-	rsp, err := c.NotificationsAPIList(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []FileDiff
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return ParseNotificationsAPIListResponse(rsp)
+
+	return response, nil
 }
 
-// SettingsAPIGetWithResponse request returning *SettingsAPIGetResponse
-func (c *ClientWithResponses) SettingsAPIGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*SettingsAPIGetResponse, error) {
-	rsp, err := c.SettingsAPIGet(ctx, reqEditors...)
+// ParseFeaturesAPIGetResponse parses an HTTP response from a FeaturesAPIGetWithResponse call
+func ParseFeaturesAPIGetResponse(rsp *http.Response) (*FeaturesAPIGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseSettingsAPIGetResponse(rsp)
-}
 
-// SettingsAPISetWithBodyWithResponse request with arbitrary body returning *SettingsAPISetResponse
-func (c *ClientWithResponses) SettingsAPISetWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SettingsAPISetResponse, error) {
-	rsp, err := c.SettingsAPISetWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &FeaturesAPIGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseSettingsAPISetResponse(rsp)
-}
 
-func (c *ClientWithResponses) SettingsAPISetWithResponse(ctx context.Context, body SettingsAPISetJSONRequestBody, reqEditors ...RequestEditorFn) (*SettingsAPISetResponse, error) {
-	rsp, err := c.SettingsAPISet(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Features
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return ParseSettingsAPISetResponse(rsp)
+
+	return response, nil
 }
 
-// StatsAPIGetWithResponse request returning *StatsAPIGetResponse
-func (c *ClientWithResponses) StatsAPIGetWithResponse(ctx context.Context, days int32, reqEditors ...RequestEditorFn) (*StatsAPIGetResponse, error) {
-	rsp, err := c.StatsAPIGet(ctx, days, reqEditors...)
+// ParseNotificationsAPIListResponse parses an HTTP response from a NotificationsAPIListWithResponse call
+func ParseNotificationsAPIListResponse(rsp *http.Response) (*NotificationsAPIListResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseStatsAPIGetResponse(rsp)
-}
 
-// StatusAPIGetWithResponse request returning *StatusAPIGetResponse
-func (c *ClientWithResponses) StatusAPIGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*StatusAPIGetResponse, error) {
-	rsp, err := c.StatusAPIGet(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &NotificationsAPIListResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseStatusAPIGetResponse(rsp)
-}
 
-// UserAPIDeleteWithResponse request returning *UserAPIDeleteResponse
-func (c *ClientWithResponses) UserAPIDeleteWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserAPIDeleteResponse, error) {
-	rsp, err := c.UserAPIDelete(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Items    []Event  `json:"items"`
+			PageInfo PageInfo `json:"pageInfo"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return ParseUserAPIDeleteResponse(rsp)
+
+	return response, nil
 }
 
-// UserAPIGetWithResponse request returning *UserAPIGetResponse
-func (c *ClientWithResponses) UserAPIGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserAPIGetResponse, error) {
-	rsp, err := c.UserAPIGet(ctx, reqEditors...)
+// ParseSchedulesAPIGetResponse parses an HTTP response from a SchedulesAPIGetWithResponse call
+func ParseSchedulesAPIGetResponse(rsp *http.Response) (*SchedulesAPIGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUserAPIGetResponse(rsp)
-}
 
-// UserAPIChangePasswordWithBodyWithResponse request with arbitrary body returning *UserAPIChangePasswordResponse
-func (c *ClientWithResponses) UserAPIChangePasswordWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserAPIChangePasswordResponse, error) {
-	rsp, err := c.UserAPIChangePasswordWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &SchedulesAPIGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUserAPIChangePasswordResponse(rsp)
-}
 
-func (c *ClientWithResponses) UserAPIChangePasswordWithResponse(ctx context.Context, body UserAPIChangePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*UserAPIChangePasswordResponse, error) {
-	rsp, err := c.UserAPIChangePassword(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Schedules
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return ParseUserAPIChangePasswordResponse(rsp)
+
+	return response, nil
 }
 
-// ParseAuthAPILoginResponse parses an HTTP response from a AuthAPILoginWithResponse call
-func ParseAuthAPILoginResponse(rsp *http.Response) (*AuthAPILoginResponse, error) {
+// ParseSecretsAPIListResponse parses an HTTP response from a SecretsAPIListWithResponse call
+func ParseSecretsAPIListResponse(rsp *http.Response) (*SecretsAPIListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AuthAPILoginResponse{
+	response := &SecretsAPIListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest BooleanResponse
+		var dest []Secret
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2217,22 +4480,22 @@ func ParseAuthAPILoginResponse(rsp *http.Response) (*AuthAPILoginResponse, error
 	return response, nil
 }
 
-// ParseAuthAPILogoutResponse parses an HTTP response from a AuthAPILogoutWithResponse call
-func ParseAuthAPILogoutResponse(rsp *http.Response) (*AuthAPILogoutResponse, error) {
+// ParseSecretsAPISetResponse parses an HTTP response from a SecretsAPISetWithResponse call
+func ParseSecretsAPISetResponse(rsp *http.Response) (*SecretsAPISetResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AuthAPILogoutResponse{
+	response := &SecretsAPISetResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest BooleanResponse
+		var dest Secret
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2250,15 +4513,15 @@ func ParseAuthAPILogoutResponse(rsp *http.Response) (*AuthAPILogoutResponse, err
 	return response, nil
 }
 
-// ParseAuthAPIRefreshResponse parses an HTTP response from a AuthAPIRefreshWithResponse call
-func ParseAuthAPIRefreshResponse(rsp *http.Response) (*AuthAPIRefreshResponse, error) {
+// ParseSecretsAPIDeleteResponse parses an HTTP response from a SecretsAPIDeleteWithResponse call
+func ParseSecretsAPIDeleteResponse(rsp *http.Response) (*SecretsAPIDeleteResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AuthAPIRefreshResponse{
+	response := &SecretsAPIDeleteResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -2283,24 +4546,22 @@ func ParseAuthAPIRefreshResponse(rsp *http.Response) (*AuthAPIRefreshResponse, e
 	return response, nil
 }
 
-// ParseAuthAPIRegisteredResponse parses an HTTP response from a AuthAPIRegisteredWithResponse call
-func ParseAuthAPIRegisteredResponse(rsp *http.Response) (*AuthAPIRegisteredResponse, error) {
+// ParseSettingsAPIGetResponse parses an HTTP response from a SettingsAPIGetWithResponse call
+func ParseSettingsAPIGetResponse(rsp *http.Response) (*SettingsAPIGetResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AuthAPIRegisteredResponse{
+	response := &SettingsAPIGetResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			Registered bool `json:"registered"`
-		}
+		var dest Settings
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2318,22 +4579,22 @@ func ParseAuthAPIRegisteredResponse(rsp *http.Response) (*AuthAPIRegisteredRespo
 	return response, nil
 }
 
-// ParseAuthAPIRegisterResponse parses an HTTP response from a AuthAPIRegisterWithResponse call
-func ParseAuthAPIRegisterResponse(rsp *http.Response) (*AuthAPIRegisterResponse, error) {
+// ParseSettingsAPISetResponse parses an HTTP response from a SettingsAPISetWithResponse call
+func ParseSettingsAPISetResponse(rsp *http.Response) (*SettingsAPISetResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AuthAPIRegisterResponse{
+	response := &SettingsAPISetResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest BooleanResponse
+		var dest Settings
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2351,22 +4612,22 @@ func ParseAuthAPIRegisterResponse(rsp *http.Response) (*AuthAPIRegisterResponse,
 	return response, nil
 }
 
-// ParseConfigAPIGetResponse parses an HTTP response from a ConfigAPIGetWithResponse call
-func ParseConfigAPIGetResponse(rsp *http.Response) (*ConfigAPIGetResponse, error) {
+// ParseStacksAPIRestartContainerResponse parses an HTTP response from a StacksAPIRestartContainerWithResponse call
+func ParseStacksAPIRestartContainerResponse(rsp *http.Response) (*StacksAPIRestartContainerResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ConfigAPIGetResponse{
+	response := &StacksAPIRestartContainerResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Config
+		var dest DeploymentWithDetails
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2384,22 +4645,22 @@ func ParseConfigAPIGetResponse(rsp *http.Response) (*ConfigAPIGetResponse, error
 	return response, nil
 }
 
-// ParseConfigAPISetResponse parses an HTTP response from a ConfigAPISetWithResponse call
-func ParseConfigAPISetResponse(rsp *http.Response) (*ConfigAPISetResponse, error) {
+// ParseStacksAPIActionResponse parses an HTTP response from a StacksAPIActionWithResponse call
+func ParseStacksAPIActionResponse(rsp *http.Response) (*StacksAPIActionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ConfigAPISetResponse{
+	response := &StacksAPIActionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Config
+		var dest DeploymentWithDetails
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2417,25 +4678,22 @@ func ParseConfigAPISetResponse(rsp *http.Response) (*ConfigAPISetResponse, error
 	return response, nil
 }
 
-// ParseDeployementAPIListResponse parses an HTTP response from a DeployementAPIListWithResponse call
-func ParseDeployementAPIListResponse(rsp *http.Response) (*DeployementAPIListResponse, error) {
+// ParseStatsAPIDailyResponse parses an HTTP response from a StatsAPIDailyWithResponse call
+func ParseStatsAPIDailyResponse(rsp *http.Response) (*StatsAPIDailyResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeployementAPIListResponse{
+	response := &StatsAPIDailyResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			Items    []Deployment `json:"items"`
-			PageInfo PageInfo     `json:"pageInfo"`
-		}
+		var dest []DailyStats
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2453,22 +4711,22 @@ func ParseDeployementAPIListResponse(rsp *http.Response) (*DeployementAPIListRes
 	return response, nil
 }
 
-// ParseDeployementAPISyncResponse parses an HTTP response from a DeployementAPISyncWithResponse call
-func ParseDeployementAPISyncResponse(rsp *http.Response) (*DeployementAPISyncResponse, error) {
+// ParseStatsAPIGetResponse parses an HTTP response from a StatsAPIGetWithResponse call
+func ParseStatsAPIGetResponse(rsp *http.Response) (*StatsAPIGetResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeployementAPISyncResponse{
+	response := &StatsAPIGetResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DeploymentWithDetails
+		var dest Stats
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2486,22 +4744,22 @@ func ParseDeployementAPISyncResponse(rsp *http.Response) (*DeployementAPISyncRes
 	return response, nil
 }
 
-// ParseDeployementAPIReadResponse parses an HTTP response from a DeployementAPIReadWithResponse call
-func ParseDeployementAPIReadResponse(rsp *http.Response) (*DeployementAPIReadResponse, error) {
+// ParseStatusAPIGetResponse parses an HTTP response from a StatusAPIGetWithResponse call
+func ParseStatusAPIGetResponse(rsp *http.Response) (*StatusAPIGetResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeployementAPIReadResponse{
+	response := &StatusAPIGetResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DeploymentWithDetails
+		var dest []StackStatus
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2519,22 +4777,22 @@ func ParseDeployementAPIReadResponse(rsp *http.Response) (*DeployementAPIReadRes
 	return response, nil
 }
 
-// ParseDiffAPIGetResponse parses an HTTP response from a DiffAPIGetWithResponse call
-func ParseDiffAPIGetResponse(rsp *http.Response) (*DiffAPIGetResponse, error) {
+// ParseUserAPIDeleteResponse parses an HTTP response from a UserAPIDeleteWithResponse call
+func ParseUserAPIDeleteResponse(rsp *http.Response) (*UserAPIDeleteResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DiffAPIGetResponse{
+	response := &UserAPIDeleteResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []FileDiff
+		var dest BooleanResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2552,22 +4810,22 @@ func ParseDiffAPIGetResponse(rsp *http.Response) (*DiffAPIGetResponse, error) {
 	return response, nil
 }
 
-// ParseFeaturesAPIGetResponse parses an HTTP response from a FeaturesAPIGetWithResponse call
-func ParseFeaturesAPIGetResponse(rsp *http.Response) (*FeaturesAPIGetResponse, error) {
+// ParseUserAPIGetResponse parses an HTTP response from a UserAPIGetWithResponse call
+func ParseUserAPIGetResponse(rsp *http.Response) (*UserAPIGetResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &FeaturesAPIGetResponse{
+	response := &UserAPIGetResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Features
+		var dest User
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2585,25 +4843,22 @@ func ParseFeaturesAPIGetResponse(rsp *http.Response) (*FeaturesAPIGetResponse, e
 	return response, nil
 }
 
-// ParseNotificationsAPIListResponse parses an HTTP response from a NotificationsAPIListWithResponse call
-func ParseNotificationsAPIListResponse(rsp *http.Response) (*NotificationsAPIListResponse, error) {
+// ParseUserAPIChangePasswordResponse parses an HTTP response from a UserAPIChangePasswordWithResponse call
+func ParseUserAPIChangePasswordResponse(rsp *http.Response) (*UserAPIChangePasswordResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &NotificationsAPIListResponse{
+	response := &UserAPIChangePasswordResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			Items    []Event  `json:"items"`
-			PageInfo PageInfo `json:"pageInfo"`
-		}
+		var dest BooleanResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2618,318 +4873,481 @@ func ParseNotificationsAPIListResponse(rsp *http.Response) (*NotificationsAPILis
 
 	}
 
-	return response, nil
+	return response, nil
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+
+	// (GET /api/admin/backup)
+	AdminAPIBackup(w http.ResponseWriter, r *http.Request)
+
+	// (POST /api/auth/login)
+	AuthAPILogin(w http.ResponseWriter, r *http.Request)
+
+	// (POST /api/auth/logout)
+	AuthAPILogout(w http.ResponseWriter, r *http.Request)
+
+	// (POST /api/auth/refresh)
+	AuthAPIRefresh(w http.ResponseWriter, r *http.Request)
+
+	// (GET /api/auth/register)
+	AuthAPIRegistered(w http.ResponseWriter, r *http.Request)
+
+	// (POST /api/auth/register)
+	AuthAPIRegister(w http.ResponseWriter, r *http.Request)
+
+	// (GET /api/config)
+	ConfigAPIGet(w http.ResponseWriter, r *http.Request, params ConfigAPIGetParams)
+
+	// (POST /api/config)
+	ConfigAPISet(w http.ResponseWriter, r *http.Request, params ConfigAPISetParams)
+
+	// (GET /api/config/history)
+	ConfigHistoryAPIList(w http.ResponseWriter, r *http.Request, params ConfigHistoryAPIListParams)
+
+	// (GET /api/config/history/diff)
+	ConfigHistoryAPIDiff(w http.ResponseWriter, r *http.Request, params ConfigHistoryAPIDiffParams)
+
+	// (GET /api/config/history/{id})
+	ConfigHistoryAPIRead(w http.ResponseWriter, r *http.Request, id string)
+
+	// (POST /api/config/history/{id}/restore)
+	ConfigHistoryAPIRestore(w http.ResponseWriter, r *http.Request, id string)
+
+	// (GET /api/config/requirements)
+	ConfigAPIRequirements(w http.ResponseWriter, r *http.Request)
+
+	// (GET /api/deployment)
+	DeployementAPIList(w http.ResponseWriter, r *http.Request, params DeployementAPIListParams)
+
+	// (POST /api/deployment)
+	DeployementAPISync(w http.ResponseWriter, r *http.Request)
+
+	// (GET /api/deployment/{id})
+	DeployementAPIRead(w http.ResponseWriter, r *http.Request, id string)
+
+	// (GET /api/deployment/{id}/logs)
+	DeployementAPILogs(w http.ResponseWriter, r *http.Request, id string, params DeployementAPILogsParams)
+
+	// (GET /api/diff)
+	DiffAPIGet(w http.ResponseWriter, r *http.Request)
+
+	// (GET /api/features)
+	FeaturesAPIGet(w http.ResponseWriter, r *http.Request)
+
+	// (GET /api/notifications)
+	NotificationsAPIList(w http.ResponseWriter, r *http.Request, params NotificationsAPIListParams)
+
+	// (GET /api/schedules)
+	SchedulesAPIGet(w http.ResponseWriter, r *http.Request)
+
+	// (GET /api/secrets)
+	SecretsAPIList(w http.ResponseWriter, r *http.Request)
+
+	// (POST /api/secrets)
+	SecretsAPISet(w http.ResponseWriter, r *http.Request)
+
+	// (DELETE /api/secrets/{name})
+	SecretsAPIDelete(w http.ResponseWriter, r *http.Request, name string)
+
+	// (GET /api/settings)
+	SettingsAPIGet(w http.ResponseWriter, r *http.Request)
+
+	// (POST /api/settings)
+	SettingsAPISet(w http.ResponseWriter, r *http.Request, params SettingsAPISetParams)
+
+	// (POST /api/stacks/{name}/containers/{id}/restart)
+	StacksAPIRestartContainer(w http.ResponseWriter, r *http.Request, name string, id string)
+
+	// (POST /api/stacks/{name}/{action})
+	StacksAPIAction(w http.ResponseWriter, r *http.Request, name string, action StackAction)
+
+	// (GET /api/stats/daily/{days})
+	StatsAPIDaily(w http.ResponseWriter, r *http.Request, days int32)
+
+	// (GET /api/stats/{days})
+	StatsAPIGet(w http.ResponseWriter, r *http.Request, days int32)
+
+	// (GET /api/status)
+	StatusAPIGet(w http.ResponseWriter, r *http.Request)
+
+	// (DELETE /api/user)
+	UserAPIDelete(w http.ResponseWriter, r *http.Request)
+
+	// (GET /api/user)
+	UserAPIGet(w http.ResponseWriter, r *http.Request)
+
+	// (POST /api/user/change-password)
+	UserAPIChangePassword(w http.ResponseWriter, r *http.Request)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// AdminAPIBackup operation middleware
+func (siw *ServerInterfaceWrapper) AdminAPIBackup(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdminAPIBackup(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AuthAPILogin operation middleware
+func (siw *ServerInterfaceWrapper) AuthAPILogin(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AuthAPILogin(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AuthAPILogout operation middleware
+func (siw *ServerInterfaceWrapper) AuthAPILogout(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AuthAPILogout(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AuthAPIRefresh operation middleware
+func (siw *ServerInterfaceWrapper) AuthAPIRefresh(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AuthAPIRefresh(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AuthAPIRegistered operation middleware
+func (siw *ServerInterfaceWrapper) AuthAPIRegistered(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AuthAPIRegistered(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AuthAPIRegister operation middleware
+func (siw *ServerInterfaceWrapper) AuthAPIRegister(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AuthAPIRegister(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-// ParseSettingsAPIGetResponse parses an HTTP response from a SettingsAPIGetWithResponse call
-func ParseSettingsAPIGetResponse(rsp *http.Response) (*SettingsAPIGetResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+// ConfigAPIGet operation middleware
+func (siw *ServerInterfaceWrapper) ConfigAPIGet(w http.ResponseWriter, r *http.Request) {
 
-	response := &SettingsAPIGetResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	var err error
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Settings
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	ctx := r.Context()
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSONDefault = &dest
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	}
+	r = r.WithContext(ctx)
 
-	return response, nil
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ConfigAPIGetParams
 
-// ParseSettingsAPISetResponse parses an HTTP response from a SettingsAPISetWithResponse call
-func ParseSettingsAPISetResponse(rsp *http.Response) (*SettingsAPISetResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	// ------------- Optional query parameter "resolved" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "resolved", r.URL.Query(), &params.Resolved)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "resolved", Err: err})
+		return
 	}
 
-	response := &SettingsAPISetResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ConfigAPIGet(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Settings
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	handler.ServeHTTP(w, r)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSONDefault = &dest
+// ConfigAPISet operation middleware
+func (siw *ServerInterfaceWrapper) ConfigAPISet(w http.ResponseWriter, r *http.Request) {
 
-	}
+	var err error
 
-	return response, nil
-}
+	ctx := r.Context()
 
-// ParseStatsAPIGetResponse parses an HTTP response from a StatsAPIGetWithResponse call
-func ParseStatsAPIGetResponse(rsp *http.Response) (*StatsAPIGetResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	response := &StatsAPIGetResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	r = r.WithContext(ctx)
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Stats
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ConfigAPISetParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
 		}
-		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
 		}
-		response.JSONDefault = &dest
+
+		params.IfMatch = &IfMatch
 
 	}
 
-	return response, nil
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ConfigAPISet(w, r, params)
+	}))
 
-// ParseStatusAPIGetResponse parses an HTTP response from a StatusAPIGetWithResponse call
-func ParseStatusAPIGetResponse(rsp *http.Response) (*StatusAPIGetResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	response := &StatusAPIGetResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	handler.ServeHTTP(w, r)
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []StackStatus
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+// ConfigHistoryAPIList operation middleware
+func (siw *ServerInterfaceWrapper) ConfigHistoryAPIList(w http.ResponseWriter, r *http.Request) {
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSONDefault = &dest
+	var err error
 
-	}
+	ctx := r.Context()
 
-	return response, nil
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-// ParseUserAPIDeleteResponse parses an HTTP response from a UserAPIDeleteWithResponse call
-func ParseUserAPIDeleteResponse(rsp *http.Response) (*UserAPIDeleteResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+	r = r.WithContext(ctx)
 
-	response := &UserAPIDeleteResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ConfigHistoryAPIListParams
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest BooleanResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// ------------- Required query parameter "limit" -------------
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSONDefault = &dest
+	if paramValue := r.URL.Query().Get("limit"); paramValue != "" {
 
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "limit"})
+		return
 	}
 
-	return response, nil
-}
-
-// ParseUserAPIGetResponse parses an HTTP response from a UserAPIGetWithResponse call
-func ParseUserAPIGetResponse(rsp *http.Response) (*UserAPIGetResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindQueryParameter("form", false, true, "limit", r.URL.Query(), &params.Limit)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
 	}
 
-	response := &UserAPIGetResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	// ------------- Optional query parameter "offset" -------------
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest User
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	err = runtime.BindQueryParameter("form", false, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSONDefault = &dest
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ConfigHistoryAPIList(w, r, params)
+	}))
 
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	return response, nil
+	handler.ServeHTTP(w, r)
 }
 
-// ParseUserAPIChangePasswordResponse parses an HTTP response from a UserAPIChangePasswordWithResponse call
-func ParseUserAPIChangePasswordResponse(rsp *http.Response) (*UserAPIChangePasswordResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// ConfigHistoryAPIDiff operation middleware
+func (siw *ServerInterfaceWrapper) ConfigHistoryAPIDiff(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ConfigHistoryAPIDiffParams
+
+	// ------------- Required query parameter "from" -------------
+
+	if paramValue := r.URL.Query().Get("from"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "from"})
+		return
 	}
 
-	response := &UserAPIChangePasswordResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	err = runtime.BindQueryParameter("form", false, true, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest BooleanResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// ------------- Required query parameter "to" -------------
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSONDefault = &dest
+	if paramValue := r.URL.Query().Get("to"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "to"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", false, true, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ConfigHistoryAPIDiff(w, r, params)
+	}))
 
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	return response, nil
+	handler.ServeHTTP(w, r)
 }
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-
-	// (POST /api/auth/login)
-	AuthAPILogin(w http.ResponseWriter, r *http.Request)
+// ConfigHistoryAPIRead operation middleware
+func (siw *ServerInterfaceWrapper) ConfigHistoryAPIRead(w http.ResponseWriter, r *http.Request) {
 
-	// (POST /api/auth/logout)
-	AuthAPILogout(w http.ResponseWriter, r *http.Request)
+	var err error
 
-	// (POST /api/auth/refresh)
-	AuthAPIRefresh(w http.ResponseWriter, r *http.Request)
+	// ------------- Path parameter "id" -------------
+	var id string
 
-	// (GET /api/auth/register)
-	AuthAPIRegistered(w http.ResponseWriter, r *http.Request)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-	// (POST /api/auth/register)
-	AuthAPIRegister(w http.ResponseWriter, r *http.Request)
+	ctx := r.Context()
 
-	// (GET /api/config)
-	ConfigAPIGet(w http.ResponseWriter, r *http.Request)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	// (POST /api/config)
-	ConfigAPISet(w http.ResponseWriter, r *http.Request)
+	r = r.WithContext(ctx)
 
-	// (GET /api/deployment)
-	DeployementAPIList(w http.ResponseWriter, r *http.Request, params DeployementAPIListParams)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ConfigHistoryAPIRead(w, r, id)
+	}))
 
-	// (POST /api/deployment)
-	DeployementAPISync(w http.ResponseWriter, r *http.Request)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	// (GET /api/deployment/{id})
-	DeployementAPIRead(w http.ResponseWriter, r *http.Request, id string)
+	handler.ServeHTTP(w, r)
+}
 
-	// (GET /api/diff)
-	DiffAPIGet(w http.ResponseWriter, r *http.Request)
+// ConfigHistoryAPIRestore operation middleware
+func (siw *ServerInterfaceWrapper) ConfigHistoryAPIRestore(w http.ResponseWriter, r *http.Request) {
 
-	// (GET /api/features)
-	FeaturesAPIGet(w http.ResponseWriter, r *http.Request)
+	var err error
 
-	// (GET /api/notifications)
-	NotificationsAPIList(w http.ResponseWriter, r *http.Request, params NotificationsAPIListParams)
+	// ------------- Path parameter "id" -------------
+	var id string
 
-	// (GET /api/settings)
-	SettingsAPIGet(w http.ResponseWriter, r *http.Request)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-	// (POST /api/settings)
-	SettingsAPISet(w http.ResponseWriter, r *http.Request)
+	ctx := r.Context()
 
-	// (GET /api/stats/{days})
-	StatsAPIGet(w http.ResponseWriter, r *http.Request, days int32)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	// (GET /api/status)
-	StatusAPIGet(w http.ResponseWriter, r *http.Request)
+	r = r.WithContext(ctx)
 
-	// (DELETE /api/user)
-	UserAPIDelete(w http.ResponseWriter, r *http.Request)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ConfigHistoryAPIRestore(w, r, id)
+	}))
 
-	// (GET /api/user)
-	UserAPIGet(w http.ResponseWriter, r *http.Request)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	// (POST /api/user/change-password)
-	UserAPIChangePassword(w http.ResponseWriter, r *http.Request)
+	handler.ServeHTTP(w, r)
 }
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
-}
+// ConfigAPIRequirements operation middleware
+func (siw *ServerInterfaceWrapper) ConfigAPIRequirements(w http.ResponseWriter, r *http.Request) {
 
-type MiddlewareFunc func(http.Handler) http.Handler
+	ctx := r.Context()
 
-// AuthAPILogin operation middleware
-func (siw *ServerInterfaceWrapper) AuthAPILogin(w http.ResponseWriter, r *http.Request) {
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AuthAPILogin(w, r)
+		siw.Handler.ConfigAPIRequirements(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2939,8 +5357,10 @@ func (siw *ServerInterfaceWrapper) AuthAPILogin(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// AuthAPILogout operation middleware
-func (siw *ServerInterfaceWrapper) AuthAPILogout(w http.ResponseWriter, r *http.Request) {
+// DeployementAPIList operation middleware
+func (siw *ServerInterfaceWrapper) DeployementAPIList(w http.ResponseWriter, r *http.Request) {
+
+	var err error
 
 	ctx := r.Context()
 
@@ -2948,8 +5368,34 @@ func (siw *ServerInterfaceWrapper) AuthAPILogout(w http.ResponseWriter, r *http.
 
 	r = r.WithContext(ctx)
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeployementAPIListParams
+
+	// ------------- Required query parameter "limit" -------------
+
+	if paramValue := r.URL.Query().Get("limit"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "limit"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", false, true, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AuthAPILogout(w, r)
+		siw.Handler.DeployementAPIList(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2959,8 +5405,8 @@ func (siw *ServerInterfaceWrapper) AuthAPILogout(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// AuthAPIRefresh operation middleware
-func (siw *ServerInterfaceWrapper) AuthAPIRefresh(w http.ResponseWriter, r *http.Request) {
+// DeployementAPISync operation middleware
+func (siw *ServerInterfaceWrapper) DeployementAPISync(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -2969,7 +5415,7 @@ func (siw *ServerInterfaceWrapper) AuthAPIRefresh(w http.ResponseWriter, r *http
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AuthAPIRefresh(w, r)
+		siw.Handler.DeployementAPISync(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2979,11 +5425,28 @@ func (siw *ServerInterfaceWrapper) AuthAPIRefresh(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// AuthAPIRegistered operation middleware
-func (siw *ServerInterfaceWrapper) AuthAPIRegistered(w http.ResponseWriter, r *http.Request) {
+// DeployementAPIRead operation middleware
+func (siw *ServerInterfaceWrapper) DeployementAPIRead(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AuthAPIRegistered(w, r)
+		siw.Handler.DeployementAPIRead(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2993,11 +5456,54 @@ func (siw *ServerInterfaceWrapper) AuthAPIRegistered(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// AuthAPIRegister operation middleware
-func (siw *ServerInterfaceWrapper) AuthAPIRegister(w http.ResponseWriter, r *http.Request) {
+// DeployementAPILogs operation middleware
+func (siw *ServerInterfaceWrapper) DeployementAPILogs(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeployementAPILogsParams
+
+	// ------------- Required query parameter "limit" -------------
+
+	if paramValue := r.URL.Query().Get("limit"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "limit"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", false, true, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AuthAPIRegister(w, r)
+		siw.Handler.DeployementAPILogs(w, r, id, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3007,8 +5513,8 @@ func (siw *ServerInterfaceWrapper) AuthAPIRegister(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// ConfigAPIGet operation middleware
-func (siw *ServerInterfaceWrapper) ConfigAPIGet(w http.ResponseWriter, r *http.Request) {
+// DiffAPIGet operation middleware
+func (siw *ServerInterfaceWrapper) DiffAPIGet(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -3017,7 +5523,7 @@ func (siw *ServerInterfaceWrapper) ConfigAPIGet(w http.ResponseWriter, r *http.R
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ConfigAPIGet(w, r)
+		siw.Handler.DiffAPIGet(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3027,8 +5533,8 @@ func (siw *ServerInterfaceWrapper) ConfigAPIGet(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// ConfigAPISet operation middleware
-func (siw *ServerInterfaceWrapper) ConfigAPISet(w http.ResponseWriter, r *http.Request) {
+// FeaturesAPIGet operation middleware
+func (siw *ServerInterfaceWrapper) FeaturesAPIGet(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -3037,7 +5543,7 @@ func (siw *ServerInterfaceWrapper) ConfigAPISet(w http.ResponseWriter, r *http.R
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ConfigAPISet(w, r)
+		siw.Handler.FeaturesAPIGet(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3047,8 +5553,8 @@ func (siw *ServerInterfaceWrapper) ConfigAPISet(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// DeployementAPIList operation middleware
-func (siw *ServerInterfaceWrapper) DeployementAPIList(w http.ResponseWriter, r *http.Request) {
+// NotificationsAPIList operation middleware
+func (siw *ServerInterfaceWrapper) NotificationsAPIList(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3059,7 +5565,7 @@ func (siw *ServerInterfaceWrapper) DeployementAPIList(w http.ResponseWriter, r *
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params DeployementAPIListParams
+	var params NotificationsAPIListParams
 
 	// ------------- Required query parameter "limit" -------------
 
@@ -3085,7 +5591,7 @@ func (siw *ServerInterfaceWrapper) DeployementAPIList(w http.ResponseWriter, r *
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeployementAPIList(w, r, params)
+		siw.Handler.NotificationsAPIList(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3095,8 +5601,8 @@ func (siw *ServerInterfaceWrapper) DeployementAPIList(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// DeployementAPISync operation middleware
-func (siw *ServerInterfaceWrapper) DeployementAPISync(w http.ResponseWriter, r *http.Request) {
+// SchedulesAPIGet operation middleware
+func (siw *ServerInterfaceWrapper) SchedulesAPIGet(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -3105,7 +5611,7 @@ func (siw *ServerInterfaceWrapper) DeployementAPISync(w http.ResponseWriter, r *
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeployementAPISync(w, r)
+		siw.Handler.SchedulesAPIGet(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3115,20 +5621,29 @@ func (siw *ServerInterfaceWrapper) DeployementAPISync(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// DeployementAPIRead operation middleware
-func (siw *ServerInterfaceWrapper) DeployementAPIRead(w http.ResponseWriter, r *http.Request) {
+// SecretsAPIList operation middleware
+func (siw *ServerInterfaceWrapper) SecretsAPIList(w http.ResponseWriter, r *http.Request) {
 
-	var err error
+	ctx := r.Context()
 
-	// ------------- Path parameter "id" -------------
-	var id string
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SecretsAPIList(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
+	handler.ServeHTTP(w, r)
+}
+
+// SecretsAPISet operation middleware
+func (siw *ServerInterfaceWrapper) SecretsAPISet(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
@@ -3136,7 +5651,7 @@ func (siw *ServerInterfaceWrapper) DeployementAPIRead(w http.ResponseWriter, r *
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeployementAPIRead(w, r, id)
+		siw.Handler.SecretsAPISet(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3146,8 +5661,19 @@ func (siw *ServerInterfaceWrapper) DeployementAPIRead(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// DiffAPIGet operation middleware
-func (siw *ServerInterfaceWrapper) DiffAPIGet(w http.ResponseWriter, r *http.Request) {
+// SecretsAPIDelete operation middleware
+func (siw *ServerInterfaceWrapper) SecretsAPIDelete(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
 
 	ctx := r.Context()
 
@@ -3156,7 +5682,7 @@ func (siw *ServerInterfaceWrapper) DiffAPIGet(w http.ResponseWriter, r *http.Req
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DiffAPIGet(w, r)
+		siw.Handler.SecretsAPIDelete(w, r, name)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3166,8 +5692,8 @@ func (siw *ServerInterfaceWrapper) DiffAPIGet(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// FeaturesAPIGet operation middleware
-func (siw *ServerInterfaceWrapper) FeaturesAPIGet(w http.ResponseWriter, r *http.Request) {
+// SettingsAPIGet operation middleware
+func (siw *ServerInterfaceWrapper) SettingsAPIGet(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -3176,7 +5702,7 @@ func (siw *ServerInterfaceWrapper) FeaturesAPIGet(w http.ResponseWriter, r *http
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.FeaturesAPIGet(w, r)
+		siw.Handler.SettingsAPIGet(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3186,8 +5712,8 @@ func (siw *ServerInterfaceWrapper) FeaturesAPIGet(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// NotificationsAPIList operation middleware
-func (siw *ServerInterfaceWrapper) NotificationsAPIList(w http.ResponseWriter, r *http.Request) {
+// SettingsAPISet operation middleware
+func (siw *ServerInterfaceWrapper) SettingsAPISet(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3198,33 +5724,71 @@ func (siw *ServerInterfaceWrapper) NotificationsAPIList(w http.ResponseWriter, r
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params NotificationsAPIListParams
+	var params SettingsAPISetParams
 
-	// ------------- Required query parameter "limit" -------------
+	headers := r.Header
 
-	if paramValue := r.URL.Query().Get("limit"); paramValue != "" {
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "limit"})
-		return
 	}
 
-	err = runtime.BindQueryParameter("form", false, true, "limit", r.URL.Query(), &params.Limit)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SettingsAPISet(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// StacksAPIRestartContainer operation middleware
+func (siw *ServerInterfaceWrapper) StacksAPIRestartContainer(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "offset" -------------
+	// ------------- Path parameter "id" -------------
+	var id string
 
-	err = runtime.BindQueryParameter("form", false, false, "offset", r.URL.Query(), &params.Offset)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.NotificationsAPIList(w, r, params)
+		siw.Handler.StacksAPIRestartContainer(w, r, name, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3234,8 +5798,28 @@ func (siw *ServerInterfaceWrapper) NotificationsAPIList(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// SettingsAPIGet operation middleware
-func (siw *ServerInterfaceWrapper) SettingsAPIGet(w http.ResponseWriter, r *http.Request) {
+// StacksAPIAction operation middleware
+func (siw *ServerInterfaceWrapper) StacksAPIAction(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "action" -------------
+	var action StackAction
+
+	err = runtime.BindStyledParameterWithOptions("simple", "action", r.PathValue("action"), &action, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "action", Err: err})
+		return
+	}
 
 	ctx := r.Context()
 
@@ -3244,7 +5828,7 @@ func (siw *ServerInterfaceWrapper) SettingsAPIGet(w http.ResponseWriter, r *http
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SettingsAPIGet(w, r)
+		siw.Handler.StacksAPIAction(w, r, name, action)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3254,8 +5838,19 @@ func (siw *ServerInterfaceWrapper) SettingsAPIGet(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// SettingsAPISet operation middleware
-func (siw *ServerInterfaceWrapper) SettingsAPISet(w http.ResponseWriter, r *http.Request) {
+// StatsAPIDaily operation middleware
+func (siw *ServerInterfaceWrapper) StatsAPIDaily(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "days" -------------
+	var days int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "days", r.PathValue("days"), &days, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "days", Err: err})
+		return
+	}
 
 	ctx := r.Context()
 
@@ -3264,7 +5859,7 @@ func (siw *ServerInterfaceWrapper) SettingsAPISet(w http.ResponseWriter, r *http
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SettingsAPISet(w, r)
+		siw.Handler.StatsAPIDaily(w, r, days)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3505,6 +6100,7 @@ func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.H
 		ErrorHandlerFunc:   options.ErrorHandlerFunc,
 	}
 
+	m.HandleFunc("GET "+options.BaseURL+"/api/admin/backup", wrapper.AdminAPIBackup)
 	m.HandleFunc("POST "+options.BaseURL+"/api/auth/login", wrapper.AuthAPILogin)
 	m.HandleFunc("POST "+options.BaseURL+"/api/auth/logout", wrapper.AuthAPILogout)
 	m.HandleFunc("POST "+options.BaseURL+"/api/auth/refresh", wrapper.AuthAPIRefresh)
@@ -3512,14 +6108,27 @@ func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.H
 	m.HandleFunc("POST "+options.BaseURL+"/api/auth/register", wrapper.AuthAPIRegister)
 	m.HandleFunc("GET "+options.BaseURL+"/api/config", wrapper.ConfigAPIGet)
 	m.HandleFunc("POST "+options.BaseURL+"/api/config", wrapper.ConfigAPISet)
+	m.HandleFunc("GET "+options.BaseURL+"/api/config/history", wrapper.ConfigHistoryAPIList)
+	m.HandleFunc("GET "+options.BaseURL+"/api/config/history/diff", wrapper.ConfigHistoryAPIDiff)
+	m.HandleFunc("GET "+options.BaseURL+"/api/config/history/{id}", wrapper.ConfigHistoryAPIRead)
+	m.HandleFunc("POST "+options.BaseURL+"/api/config/history/{id}/restore", wrapper.ConfigHistoryAPIRestore)
+	m.HandleFunc("GET "+options.BaseURL+"/api/config/requirements", wrapper.ConfigAPIRequirements)
 	m.HandleFunc("GET "+options.BaseURL+"/api/deployment", wrapper.DeployementAPIList)
 	m.HandleFunc("POST "+options.BaseURL+"/api/deployment", wrapper.DeployementAPISync)
 	m.HandleFunc("GET "+options.BaseURL+"/api/deployment/{id}", wrapper.DeployementAPIRead)
+	m.HandleFunc("GET "+options.BaseURL+"/api/deployment/{id}/logs", wrapper.DeployementAPILogs)
 	m.HandleFunc("GET "+options.BaseURL+"/api/diff", wrapper.DiffAPIGet)
 	m.HandleFunc("GET "+options.BaseURL+"/api/features", wrapper.FeaturesAPIGet)
 	m.HandleFunc("GET "+options.BaseURL+"/api/notifications", wrapper.NotificationsAPIList)
+	m.HandleFunc("GET "+options.BaseURL+"/api/schedules", wrapper.SchedulesAPIGet)
+	m.HandleFunc("GET "+options.BaseURL+"/api/secrets", wrapper.SecretsAPIList)
+	m.HandleFunc("POST "+options.BaseURL+"/api/secrets", wrapper.SecretsAPISet)
+	m.HandleFunc("DELETE "+options.BaseURL+"/api/secrets/{name}", wrapper.SecretsAPIDelete)
 	m.HandleFunc("GET "+options.BaseURL+"/api/settings", wrapper.SettingsAPIGet)
 	m.HandleFunc("POST "+options.BaseURL+"/api/settings", wrapper.SettingsAPISet)
+	m.HandleFunc("POST "+options.BaseURL+"/api/stacks/{name}/containers/{id}/restart", wrapper.StacksAPIRestartContainer)
+	m.HandleFunc("POST "+options.BaseURL+"/api/stacks/{name}/{action}", wrapper.StacksAPIAction)
+	m.HandleFunc("GET "+options.BaseURL+"/api/stats/daily/{days}", wrapper.StatsAPIDaily)
 	m.HandleFunc("GET "+options.BaseURL+"/api/stats/{days}", wrapper.StatsAPIGet)
 	m.HandleFunc("GET "+options.BaseURL+"/api/status", wrapper.StatusAPIGet)
 	m.HandleFunc("DELETE "+options.BaseURL+"/api/user", wrapper.UserAPIDelete)
@@ -3529,6 +6138,50 @@ func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.H
 	return m
 }
 
+type AdminAPIBackupRequestObject struct {
+}
+
+type AdminAPIBackupResponseObject interface {
+	VisitAdminAPIBackupResponse(w http.ResponseWriter) error
+}
+
+type AdminAPIBackup200ResponseHeaders struct {
+	ContentDisposition string
+}
+
+type AdminAPIBackup200ApplicationgzipResponse struct {
+	Body          io.Reader
+	Headers       AdminAPIBackup200ResponseHeaders
+	ContentLength int64
+}
+
+func (response AdminAPIBackup200ApplicationgzipResponse) VisitAdminAPIBackupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/gzip")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type AdminAPIBackupdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response AdminAPIBackupdefaultJSONResponse) VisitAdminAPIBackupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
 type AuthAPILoginRequestObject struct {
 	Body *AuthAPILoginJSONRequestBody
 }
@@ -3561,169 +6214,334 @@ func (response AuthAPILogindefaultJSONResponse) VisitAuthAPILoginResponse(w http
 type AuthAPILogoutRequestObject struct {
 }
 
-type AuthAPILogoutResponseObject interface {
-	VisitAuthAPILogoutResponse(w http.ResponseWriter) error
+type AuthAPILogoutResponseObject interface {
+	VisitAuthAPILogoutResponse(w http.ResponseWriter) error
+}
+
+type AuthAPILogout200JSONResponse BooleanResponse
+
+func (response AuthAPILogout200JSONResponse) VisitAuthAPILogoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AuthAPILogoutdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response AuthAPILogoutdefaultJSONResponse) VisitAuthAPILogoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type AuthAPIRefreshRequestObject struct {
+}
+
+type AuthAPIRefreshResponseObject interface {
+	VisitAuthAPIRefreshResponse(w http.ResponseWriter) error
+}
+
+type AuthAPIRefresh200JSONResponse BooleanResponse
+
+func (response AuthAPIRefresh200JSONResponse) VisitAuthAPIRefreshResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AuthAPIRefreshdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response AuthAPIRefreshdefaultJSONResponse) VisitAuthAPIRefreshResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type AuthAPIRegisteredRequestObject struct {
+}
+
+type AuthAPIRegisteredResponseObject interface {
+	VisitAuthAPIRegisteredResponse(w http.ResponseWriter) error
+}
+
+type AuthAPIRegistered200JSONResponse struct {
+	Registered bool `json:"registered"`
+}
+
+func (response AuthAPIRegistered200JSONResponse) VisitAuthAPIRegisteredResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AuthAPIRegistereddefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response AuthAPIRegistereddefaultJSONResponse) VisitAuthAPIRegisteredResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type AuthAPIRegisterRequestObject struct {
+	Body *AuthAPIRegisterJSONRequestBody
+}
+
+type AuthAPIRegisterResponseObject interface {
+	VisitAuthAPIRegisterResponse(w http.ResponseWriter) error
+}
+
+type AuthAPIRegister200JSONResponse BooleanResponse
+
+func (response AuthAPIRegister200JSONResponse) VisitAuthAPIRegisterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AuthAPIRegisterdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response AuthAPIRegisterdefaultJSONResponse) VisitAuthAPIRegisterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ConfigAPIGetRequestObject struct {
+	Params ConfigAPIGetParams
+}
+
+type ConfigAPIGetResponseObject interface {
+	VisitConfigAPIGetResponse(w http.ResponseWriter) error
+}
+
+type ConfigAPIGet200ResponseHeaders struct {
+	ETag string
+}
+
+type ConfigAPIGet200JSONResponse struct {
+	Body    Config
+	Headers ConfigAPIGet200ResponseHeaders
+}
+
+func (response ConfigAPIGet200JSONResponse) VisitConfigAPIGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ConfigAPIGetdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response ConfigAPIGetdefaultJSONResponse) VisitConfigAPIGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ConfigAPISetRequestObject struct {
+	Params ConfigAPISetParams
+	Body   *ConfigAPISetJSONRequestBody
+}
+
+type ConfigAPISetResponseObject interface {
+	VisitConfigAPISetResponse(w http.ResponseWriter) error
+}
+
+type ConfigAPISet200ResponseHeaders struct {
+	ETag string
 }
 
-type AuthAPILogout200JSONResponse BooleanResponse
+type ConfigAPISet200JSONResponse struct {
+	Body    Config
+	Headers ConfigAPISet200ResponseHeaders
+}
 
-func (response AuthAPILogout200JSONResponse) VisitAuthAPILogoutResponse(w http.ResponseWriter) error {
+func (response ConfigAPISet200JSONResponse) VisitConfigAPISetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
 	w.WriteHeader(200)
 
-	return json.NewEncoder(w).Encode(response)
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type AuthAPILogoutdefaultJSONResponse struct {
+type ConfigAPISetdefaultJSONResponse struct {
 	Body       Error
 	StatusCode int
 }
 
-func (response AuthAPILogoutdefaultJSONResponse) VisitAuthAPILogoutResponse(w http.ResponseWriter) error {
+func (response ConfigAPISetdefaultJSONResponse) VisitConfigAPISetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(response.StatusCode)
 
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type AuthAPIRefreshRequestObject struct {
+type ConfigHistoryAPIListRequestObject struct {
+	Params ConfigHistoryAPIListParams
 }
 
-type AuthAPIRefreshResponseObject interface {
-	VisitAuthAPIRefreshResponse(w http.ResponseWriter) error
+type ConfigHistoryAPIListResponseObject interface {
+	VisitConfigHistoryAPIListResponse(w http.ResponseWriter) error
 }
 
-type AuthAPIRefresh200JSONResponse BooleanResponse
+type ConfigHistoryAPIList200JSONResponse struct {
+	Items    []ConfigRevision `json:"items"`
+	PageInfo PageInfo         `json:"pageInfo"`
+}
 
-func (response AuthAPIRefresh200JSONResponse) VisitAuthAPIRefreshResponse(w http.ResponseWriter) error {
+func (response ConfigHistoryAPIList200JSONResponse) VisitConfigHistoryAPIListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AuthAPIRefreshdefaultJSONResponse struct {
+type ConfigHistoryAPIListdefaultJSONResponse struct {
 	Body       Error
 	StatusCode int
 }
 
-func (response AuthAPIRefreshdefaultJSONResponse) VisitAuthAPIRefreshResponse(w http.ResponseWriter) error {
+func (response ConfigHistoryAPIListdefaultJSONResponse) VisitConfigHistoryAPIListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(response.StatusCode)
 
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type AuthAPIRegisteredRequestObject struct {
+type ConfigHistoryAPIDiffRequestObject struct {
+	Params ConfigHistoryAPIDiffParams
 }
 
-type AuthAPIRegisteredResponseObject interface {
-	VisitAuthAPIRegisteredResponse(w http.ResponseWriter) error
+type ConfigHistoryAPIDiffResponseObject interface {
+	VisitConfigHistoryAPIDiffResponse(w http.ResponseWriter) error
 }
 
-type AuthAPIRegistered200JSONResponse struct {
-	Registered bool `json:"registered"`
-}
+type ConfigHistoryAPIDiff200JSONResponse FileDiff
 
-func (response AuthAPIRegistered200JSONResponse) VisitAuthAPIRegisteredResponse(w http.ResponseWriter) error {
+func (response ConfigHistoryAPIDiff200JSONResponse) VisitConfigHistoryAPIDiffResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AuthAPIRegistereddefaultJSONResponse struct {
+type ConfigHistoryAPIDiffdefaultJSONResponse struct {
 	Body       Error
 	StatusCode int
 }
 
-func (response AuthAPIRegistereddefaultJSONResponse) VisitAuthAPIRegisteredResponse(w http.ResponseWriter) error {
+func (response ConfigHistoryAPIDiffdefaultJSONResponse) VisitConfigHistoryAPIDiffResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(response.StatusCode)
 
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type AuthAPIRegisterRequestObject struct {
-	Body *AuthAPIRegisterJSONRequestBody
+type ConfigHistoryAPIReadRequestObject struct {
+	Id string `json:"id"`
 }
 
-type AuthAPIRegisterResponseObject interface {
-	VisitAuthAPIRegisterResponse(w http.ResponseWriter) error
+type ConfigHistoryAPIReadResponseObject interface {
+	VisitConfigHistoryAPIReadResponse(w http.ResponseWriter) error
 }
 
-type AuthAPIRegister200JSONResponse BooleanResponse
+type ConfigHistoryAPIRead200JSONResponse ConfigRevisionWithContent
 
-func (response AuthAPIRegister200JSONResponse) VisitAuthAPIRegisterResponse(w http.ResponseWriter) error {
+func (response ConfigHistoryAPIRead200JSONResponse) VisitConfigHistoryAPIReadResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AuthAPIRegisterdefaultJSONResponse struct {
+type ConfigHistoryAPIReaddefaultJSONResponse struct {
 	Body       Error
 	StatusCode int
 }
 
-func (response AuthAPIRegisterdefaultJSONResponse) VisitAuthAPIRegisterResponse(w http.ResponseWriter) error {
+func (response ConfigHistoryAPIReaddefaultJSONResponse) VisitConfigHistoryAPIReadResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(response.StatusCode)
 
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type ConfigAPIGetRequestObject struct {
+type ConfigHistoryAPIRestoreRequestObject struct {
+	Id string `json:"id"`
 }
 
-type ConfigAPIGetResponseObject interface {
-	VisitConfigAPIGetResponse(w http.ResponseWriter) error
+type ConfigHistoryAPIRestoreResponseObject interface {
+	VisitConfigHistoryAPIRestoreResponse(w http.ResponseWriter) error
 }
 
-type ConfigAPIGet200JSONResponse Config
+type ConfigHistoryAPIRestore200JSONResponse ConfigRevision
 
-func (response ConfigAPIGet200JSONResponse) VisitConfigAPIGetResponse(w http.ResponseWriter) error {
+func (response ConfigHistoryAPIRestore200JSONResponse) VisitConfigHistoryAPIRestoreResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ConfigAPIGetdefaultJSONResponse struct {
+type ConfigHistoryAPIRestoredefaultJSONResponse struct {
 	Body       Error
 	StatusCode int
 }
 
-func (response ConfigAPIGetdefaultJSONResponse) VisitConfigAPIGetResponse(w http.ResponseWriter) error {
+func (response ConfigHistoryAPIRestoredefaultJSONResponse) VisitConfigHistoryAPIRestoreResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(response.StatusCode)
 
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type ConfigAPISetRequestObject struct {
-	Body *ConfigAPISetJSONRequestBody
+type ConfigAPIRequirementsRequestObject struct {
 }
 
-type ConfigAPISetResponseObject interface {
-	VisitConfigAPISetResponse(w http.ResponseWriter) error
+type ConfigAPIRequirementsResponseObject interface {
+	VisitConfigAPIRequirementsResponse(w http.ResponseWriter) error
 }
 
-type ConfigAPISet200JSONResponse Config
+type ConfigAPIRequirements200JSONResponse []ServiceRequirements
 
-func (response ConfigAPISet200JSONResponse) VisitConfigAPISetResponse(w http.ResponseWriter) error {
+func (response ConfigAPIRequirements200JSONResponse) VisitConfigAPIRequirementsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ConfigAPISetdefaultJSONResponse struct {
+type ConfigAPIRequirementsdefaultJSONResponse struct {
 	Body       Error
 	StatusCode int
 }
 
-func (response ConfigAPISetdefaultJSONResponse) VisitConfigAPISetResponse(w http.ResponseWriter) error {
+func (response ConfigAPIRequirementsdefaultJSONResponse) VisitConfigAPIRequirementsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(response.StatusCode)
 
@@ -3827,6 +6645,39 @@ func (response DeployementAPIReaddefaultJSONResponse) VisitDeployementAPIReadRes
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
+type DeployementAPILogsRequestObject struct {
+	Id     string `json:"id"`
+	Params DeployementAPILogsParams
+}
+
+type DeployementAPILogsResponseObject interface {
+	VisitDeployementAPILogsResponse(w http.ResponseWriter) error
+}
+
+type DeployementAPILogs200JSONResponse struct {
+	Items    []DeploymentLog `json:"items"`
+	PageInfo PageInfo        `json:"pageInfo"`
+}
+
+func (response DeployementAPILogs200JSONResponse) VisitDeployementAPILogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeployementAPILogsdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response DeployementAPILogsdefaultJSONResponse) VisitDeployementAPILogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
 type DiffAPIGetRequestObject struct {
 }
 
@@ -3915,6 +6766,120 @@ func (response NotificationsAPIListdefaultJSONResponse) VisitNotificationsAPILis
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
+type SchedulesAPIGetRequestObject struct {
+}
+
+type SchedulesAPIGetResponseObject interface {
+	VisitSchedulesAPIGetResponse(w http.ResponseWriter) error
+}
+
+type SchedulesAPIGet200JSONResponse Schedules
+
+func (response SchedulesAPIGet200JSONResponse) VisitSchedulesAPIGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SchedulesAPIGetdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response SchedulesAPIGetdefaultJSONResponse) VisitSchedulesAPIGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type SecretsAPIListRequestObject struct {
+}
+
+type SecretsAPIListResponseObject interface {
+	VisitSecretsAPIListResponse(w http.ResponseWriter) error
+}
+
+type SecretsAPIList200JSONResponse []Secret
+
+func (response SecretsAPIList200JSONResponse) VisitSecretsAPIListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SecretsAPIListdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response SecretsAPIListdefaultJSONResponse) VisitSecretsAPIListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type SecretsAPISetRequestObject struct {
+	Body *SecretsAPISetJSONRequestBody
+}
+
+type SecretsAPISetResponseObject interface {
+	VisitSecretsAPISetResponse(w http.ResponseWriter) error
+}
+
+type SecretsAPISet200JSONResponse Secret
+
+func (response SecretsAPISet200JSONResponse) VisitSecretsAPISetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SecretsAPISetdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response SecretsAPISetdefaultJSONResponse) VisitSecretsAPISetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type SecretsAPIDeleteRequestObject struct {
+	Name string `json:"name"`
+}
+
+type SecretsAPIDeleteResponseObject interface {
+	VisitSecretsAPIDeleteResponse(w http.ResponseWriter) error
+}
+
+type SecretsAPIDelete200JSONResponse BooleanResponse
+
+func (response SecretsAPIDelete200JSONResponse) VisitSecretsAPIDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SecretsAPIDeletedefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response SecretsAPIDeletedefaultJSONResponse) VisitSecretsAPIDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
 type SettingsAPIGetRequestObject struct {
 }
 
@@ -3922,13 +6887,21 @@ type SettingsAPIGetResponseObject interface {
 	VisitSettingsAPIGetResponse(w http.ResponseWriter) error
 }
 
-type SettingsAPIGet200JSONResponse Settings
+type SettingsAPIGet200ResponseHeaders struct {
+	ETag string
+}
+
+type SettingsAPIGet200JSONResponse struct {
+	Body    Settings
+	Headers SettingsAPIGet200ResponseHeaders
+}
 
 func (response SettingsAPIGet200JSONResponse) VisitSettingsAPIGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
 	w.WriteHeader(200)
 
-	return json.NewEncoder(w).Encode(response)
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
 type SettingsAPIGetdefaultJSONResponse struct {
@@ -3944,20 +6917,29 @@ func (response SettingsAPIGetdefaultJSONResponse) VisitSettingsAPIGetResponse(w
 }
 
 type SettingsAPISetRequestObject struct {
-	Body *SettingsAPISetJSONRequestBody
+	Params SettingsAPISetParams
+	Body   *SettingsAPISetJSONRequestBody
 }
 
 type SettingsAPISetResponseObject interface {
 	VisitSettingsAPISetResponse(w http.ResponseWriter) error
 }
 
-type SettingsAPISet200JSONResponse Settings
+type SettingsAPISet200ResponseHeaders struct {
+	ETag string
+}
+
+type SettingsAPISet200JSONResponse struct {
+	Body    Settings
+	Headers SettingsAPISet200ResponseHeaders
+}
 
 func (response SettingsAPISet200JSONResponse) VisitSettingsAPISetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
 	w.WriteHeader(200)
 
-	return json.NewEncoder(w).Encode(response)
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
 type SettingsAPISetdefaultJSONResponse struct {
@@ -3972,6 +6954,95 @@ func (response SettingsAPISetdefaultJSONResponse) VisitSettingsAPISetResponse(w
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
+type StacksAPIRestartContainerRequestObject struct {
+	Name string `json:"name"`
+	Id   string `json:"id"`
+}
+
+type StacksAPIRestartContainerResponseObject interface {
+	VisitStacksAPIRestartContainerResponse(w http.ResponseWriter) error
+}
+
+type StacksAPIRestartContainer200JSONResponse DeploymentWithDetails
+
+func (response StacksAPIRestartContainer200JSONResponse) VisitStacksAPIRestartContainerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StacksAPIRestartContainerdefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response StacksAPIRestartContainerdefaultJSONResponse) VisitStacksAPIRestartContainerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type StacksAPIActionRequestObject struct {
+	Name   string      `json:"name"`
+	Action StackAction `json:"action"`
+}
+
+type StacksAPIActionResponseObject interface {
+	VisitStacksAPIActionResponse(w http.ResponseWriter) error
+}
+
+type StacksAPIAction200JSONResponse DeploymentWithDetails
+
+func (response StacksAPIAction200JSONResponse) VisitStacksAPIActionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StacksAPIActiondefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response StacksAPIActiondefaultJSONResponse) VisitStacksAPIActionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type StatsAPIDailyRequestObject struct {
+	Days int32 `json:"days"`
+}
+
+type StatsAPIDailyResponseObject interface {
+	VisitStatsAPIDailyResponse(w http.ResponseWriter) error
+}
+
+type StatsAPIDaily200JSONResponse []DailyStats
+
+func (response StatsAPIDaily200JSONResponse) VisitStatsAPIDailyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StatsAPIDailydefaultJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response StatsAPIDailydefaultJSONResponse) VisitStatsAPIDailyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
 type StatsAPIGetRequestObject struct {
 	Days int32 `json:"days"`
 }
@@ -4117,6 +7188,9 @@ func (response UserAPIChangePassworddefaultJSONResponse) VisitUserAPIChangePassw
 // StrictServerInterface represents all server handlers.
 type StrictServerInterface interface {
 
+	// (GET /api/admin/backup)
+	AdminAPIBackup(ctx context.Context, request AdminAPIBackupRequestObject) (AdminAPIBackupResponseObject, error)
+
 	// (POST /api/auth/login)
 	AuthAPILogin(ctx context.Context, request AuthAPILoginRequestObject) (AuthAPILoginResponseObject, error)
 
@@ -4138,6 +7212,21 @@ type StrictServerInterface interface {
 	// (POST /api/config)
 	ConfigAPISet(ctx context.Context, request ConfigAPISetRequestObject) (ConfigAPISetResponseObject, error)
 
+	// (GET /api/config/history)
+	ConfigHistoryAPIList(ctx context.Context, request ConfigHistoryAPIListRequestObject) (ConfigHistoryAPIListResponseObject, error)
+
+	// (GET /api/config/history/diff)
+	ConfigHistoryAPIDiff(ctx context.Context, request ConfigHistoryAPIDiffRequestObject) (ConfigHistoryAPIDiffResponseObject, error)
+
+	// (GET /api/config/history/{id})
+	ConfigHistoryAPIRead(ctx context.Context, request ConfigHistoryAPIReadRequestObject) (ConfigHistoryAPIReadResponseObject, error)
+
+	// (POST /api/config/history/{id}/restore)
+	ConfigHistoryAPIRestore(ctx context.Context, request ConfigHistoryAPIRestoreRequestObject) (ConfigHistoryAPIRestoreResponseObject, error)
+
+	// (GET /api/config/requirements)
+	ConfigAPIRequirements(ctx context.Context, request ConfigAPIRequirementsRequestObject) (ConfigAPIRequirementsResponseObject, error)
+
 	// (GET /api/deployment)
 	DeployementAPIList(ctx context.Context, request DeployementAPIListRequestObject) (DeployementAPIListResponseObject, error)
 
@@ -4147,6 +7236,9 @@ type StrictServerInterface interface {
 	// (GET /api/deployment/{id})
 	DeployementAPIRead(ctx context.Context, request DeployementAPIReadRequestObject) (DeployementAPIReadResponseObject, error)
 
+	// (GET /api/deployment/{id}/logs)
+	DeployementAPILogs(ctx context.Context, request DeployementAPILogsRequestObject) (DeployementAPILogsResponseObject, error)
+
 	// (GET /api/diff)
 	DiffAPIGet(ctx context.Context, request DiffAPIGetRequestObject) (DiffAPIGetResponseObject, error)
 
@@ -4156,12 +7248,33 @@ type StrictServerInterface interface {
 	// (GET /api/notifications)
 	NotificationsAPIList(ctx context.Context, request NotificationsAPIListRequestObject) (NotificationsAPIListResponseObject, error)
 
+	// (GET /api/schedules)
+	SchedulesAPIGet(ctx context.Context, request SchedulesAPIGetRequestObject) (SchedulesAPIGetResponseObject, error)
+
+	// (GET /api/secrets)
+	SecretsAPIList(ctx context.Context, request SecretsAPIListRequestObject) (SecretsAPIListResponseObject, error)
+
+	// (POST /api/secrets)
+	SecretsAPISet(ctx context.Context, request SecretsAPISetRequestObject) (SecretsAPISetResponseObject, error)
+
+	// (DELETE /api/secrets/{name})
+	SecretsAPIDelete(ctx context.Context, request SecretsAPIDeleteRequestObject) (SecretsAPIDeleteResponseObject, error)
+
 	// (GET /api/settings)
 	SettingsAPIGet(ctx context.Context, request SettingsAPIGetRequestObject) (SettingsAPIGetResponseObject, error)
 
 	// (POST /api/settings)
 	SettingsAPISet(ctx context.Context, request SettingsAPISetRequestObject) (SettingsAPISetResponseObject, error)
 
+	// (POST /api/stacks/{name}/containers/{id}/restart)
+	StacksAPIRestartContainer(ctx context.Context, request StacksAPIRestartContainerRequestObject) (StacksAPIRestartContainerResponseObject, error)
+
+	// (POST /api/stacks/{name}/{action})
+	StacksAPIAction(ctx context.Context, request StacksAPIActionRequestObject) (StacksAPIActionResponseObject, error)
+
+	// (GET /api/stats/daily/{days})
+	StatsAPIDaily(ctx context.Context, request StatsAPIDailyRequestObject) (StatsAPIDailyResponseObject, error)
+
 	// (GET /api/stats/{days})
 	StatsAPIGet(ctx context.Context, request StatsAPIGetRequestObject) (StatsAPIGetResponseObject, error)
 
@@ -4207,6 +7320,30 @@ type strictHandler struct {
 	options     StrictHTTPServerOptions
 }
 
+// AdminAPIBackup operation middleware
+func (sh *strictHandler) AdminAPIBackup(w http.ResponseWriter, r *http.Request) {
+	var request AdminAPIBackupRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminAPIBackup(ctx, request.(AdminAPIBackupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminAPIBackup")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AdminAPIBackupResponseObject); ok {
+		if err := validResponse.VisitAdminAPIBackupResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // AuthAPILogin operation middleware
 func (sh *strictHandler) AuthAPILogin(w http.ResponseWriter, r *http.Request) {
 	var request AuthAPILoginRequestObject
@@ -4342,9 +7479,11 @@ func (sh *strictHandler) AuthAPIRegister(w http.ResponseWriter, r *http.Request)
 }
 
 // ConfigAPIGet operation middleware
-func (sh *strictHandler) ConfigAPIGet(w http.ResponseWriter, r *http.Request) {
+func (sh *strictHandler) ConfigAPIGet(w http.ResponseWriter, r *http.Request, params ConfigAPIGetParams) {
 	var request ConfigAPIGetRequestObject
 
+	request.Params = params
+
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
 		return sh.ssi.ConfigAPIGet(ctx, request.(ConfigAPIGetRequestObject))
 	}
@@ -4366,9 +7505,11 @@ func (sh *strictHandler) ConfigAPIGet(w http.ResponseWriter, r *http.Request) {
 }
 
 // ConfigAPISet operation middleware
-func (sh *strictHandler) ConfigAPISet(w http.ResponseWriter, r *http.Request) {
+func (sh *strictHandler) ConfigAPISet(w http.ResponseWriter, r *http.Request, params ConfigAPISetParams) {
 	var request ConfigAPISetRequestObject
 
+	request.Params = params
+
 	var body ConfigAPISetJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
@@ -4396,6 +7537,134 @@ func (sh *strictHandler) ConfigAPISet(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// ConfigHistoryAPIList operation middleware
+func (sh *strictHandler) ConfigHistoryAPIList(w http.ResponseWriter, r *http.Request, params ConfigHistoryAPIListParams) {
+	var request ConfigHistoryAPIListRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ConfigHistoryAPIList(ctx, request.(ConfigHistoryAPIListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ConfigHistoryAPIList")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ConfigHistoryAPIListResponseObject); ok {
+		if err := validResponse.VisitConfigHistoryAPIListResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ConfigHistoryAPIDiff operation middleware
+func (sh *strictHandler) ConfigHistoryAPIDiff(w http.ResponseWriter, r *http.Request, params ConfigHistoryAPIDiffParams) {
+	var request ConfigHistoryAPIDiffRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ConfigHistoryAPIDiff(ctx, request.(ConfigHistoryAPIDiffRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ConfigHistoryAPIDiff")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ConfigHistoryAPIDiffResponseObject); ok {
+		if err := validResponse.VisitConfigHistoryAPIDiffResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ConfigHistoryAPIRead operation middleware
+func (sh *strictHandler) ConfigHistoryAPIRead(w http.ResponseWriter, r *http.Request, id string) {
+	var request ConfigHistoryAPIReadRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ConfigHistoryAPIRead(ctx, request.(ConfigHistoryAPIReadRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ConfigHistoryAPIRead")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ConfigHistoryAPIReadResponseObject); ok {
+		if err := validResponse.VisitConfigHistoryAPIReadResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ConfigHistoryAPIRestore operation middleware
+func (sh *strictHandler) ConfigHistoryAPIRestore(w http.ResponseWriter, r *http.Request, id string) {
+	var request ConfigHistoryAPIRestoreRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ConfigHistoryAPIRestore(ctx, request.(ConfigHistoryAPIRestoreRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ConfigHistoryAPIRestore")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ConfigHistoryAPIRestoreResponseObject); ok {
+		if err := validResponse.VisitConfigHistoryAPIRestoreResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ConfigAPIRequirements operation middleware
+func (sh *strictHandler) ConfigAPIRequirements(w http.ResponseWriter, r *http.Request) {
+	var request ConfigAPIRequirementsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ConfigAPIRequirements(ctx, request.(ConfigAPIRequirementsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ConfigAPIRequirements")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ConfigAPIRequirementsResponseObject); ok {
+		if err := validResponse.VisitConfigAPIRequirementsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // DeployementAPIList operation middleware
 func (sh *strictHandler) DeployementAPIList(w http.ResponseWriter, r *http.Request, params DeployementAPIListParams) {
 	var request DeployementAPIListRequestObject
@@ -4472,6 +7741,33 @@ func (sh *strictHandler) DeployementAPIRead(w http.ResponseWriter, r *http.Reque
 	}
 }
 
+// DeployementAPILogs operation middleware
+func (sh *strictHandler) DeployementAPILogs(w http.ResponseWriter, r *http.Request, id string, params DeployementAPILogsParams) {
+	var request DeployementAPILogsRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeployementAPILogs(ctx, request.(DeployementAPILogsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeployementAPILogs")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeployementAPILogsResponseObject); ok {
+		if err := validResponse.VisitDeployementAPILogsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // DiffAPIGet operation middleware
 func (sh *strictHandler) DiffAPIGet(w http.ResponseWriter, r *http.Request) {
 	var request DiffAPIGetRequestObject
@@ -4546,6 +7842,111 @@ func (sh *strictHandler) NotificationsAPIList(w http.ResponseWriter, r *http.Req
 	}
 }
 
+// SchedulesAPIGet operation middleware
+func (sh *strictHandler) SchedulesAPIGet(w http.ResponseWriter, r *http.Request) {
+	var request SchedulesAPIGetRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SchedulesAPIGet(ctx, request.(SchedulesAPIGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SchedulesAPIGet")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SchedulesAPIGetResponseObject); ok {
+		if err := validResponse.VisitSchedulesAPIGetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SecretsAPIList operation middleware
+func (sh *strictHandler) SecretsAPIList(w http.ResponseWriter, r *http.Request) {
+	var request SecretsAPIListRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SecretsAPIList(ctx, request.(SecretsAPIListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SecretsAPIList")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SecretsAPIListResponseObject); ok {
+		if err := validResponse.VisitSecretsAPIListResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SecretsAPISet operation middleware
+func (sh *strictHandler) SecretsAPISet(w http.ResponseWriter, r *http.Request) {
+	var request SecretsAPISetRequestObject
+
+	var body SecretsAPISetJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SecretsAPISet(ctx, request.(SecretsAPISetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SecretsAPISet")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SecretsAPISetResponseObject); ok {
+		if err := validResponse.VisitSecretsAPISetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SecretsAPIDelete operation middleware
+func (sh *strictHandler) SecretsAPIDelete(w http.ResponseWriter, r *http.Request, name string) {
+	var request SecretsAPIDeleteRequestObject
+
+	request.Name = name
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SecretsAPIDelete(ctx, request.(SecretsAPIDeleteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SecretsAPIDelete")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SecretsAPIDeleteResponseObject); ok {
+		if err := validResponse.VisitSecretsAPIDeleteResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // SettingsAPIGet operation middleware
 func (sh *strictHandler) SettingsAPIGet(w http.ResponseWriter, r *http.Request) {
 	var request SettingsAPIGetRequestObject
@@ -4571,9 +7972,11 @@ func (sh *strictHandler) SettingsAPIGet(w http.ResponseWriter, r *http.Request)
 }
 
 // SettingsAPISet operation middleware
-func (sh *strictHandler) SettingsAPISet(w http.ResponseWriter, r *http.Request) {
+func (sh *strictHandler) SettingsAPISet(w http.ResponseWriter, r *http.Request, params SettingsAPISetParams) {
 	var request SettingsAPISetRequestObject
 
+	request.Params = params
+
 	var body SettingsAPISetJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
@@ -4601,6 +8004,86 @@ func (sh *strictHandler) SettingsAPISet(w http.ResponseWriter, r *http.Request)
 	}
 }
 
+// StacksAPIRestartContainer operation middleware
+func (sh *strictHandler) StacksAPIRestartContainer(w http.ResponseWriter, r *http.Request, name string, id string) {
+	var request StacksAPIRestartContainerRequestObject
+
+	request.Name = name
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.StacksAPIRestartContainer(ctx, request.(StacksAPIRestartContainerRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StacksAPIRestartContainer")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(StacksAPIRestartContainerResponseObject); ok {
+		if err := validResponse.VisitStacksAPIRestartContainerResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// StacksAPIAction operation middleware
+func (sh *strictHandler) StacksAPIAction(w http.ResponseWriter, r *http.Request, name string, action StackAction) {
+	var request StacksAPIActionRequestObject
+
+	request.Name = name
+	request.Action = action
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.StacksAPIAction(ctx, request.(StacksAPIActionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StacksAPIAction")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(StacksAPIActionResponseObject); ok {
+		if err := validResponse.VisitStacksAPIActionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// StatsAPIDaily operation middleware
+func (sh *strictHandler) StatsAPIDaily(w http.ResponseWriter, r *http.Request, days int32) {
+	var request StatsAPIDailyRequestObject
+
+	request.Days = days
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.StatsAPIDaily(ctx, request.(StatsAPIDailyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StatsAPIDaily")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(StatsAPIDailyResponseObject); ok {
+		if err := validResponse.VisitStatsAPIDailyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // StatsAPIGet operation middleware
 func (sh *strictHandler) StatsAPIGet(w http.ResponseWriter, r *http.Request, days int32) {
 	var request StatsAPIGetRequestObject
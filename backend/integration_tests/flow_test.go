@@ -121,7 +121,7 @@ func TestFileGeneration(t *testing.T) {
 
 	t.Cleanup(func() {
 		/// cleanup homepage container after test finishes
-		dockerDeployer := docker.NewDeployer(events.NewVoidDispatcher(), shell.NewExecutor())
+		dockerDeployer := docker.NewDeployer(events.NewVoidDispatcher(), shell.NewExecutor(), nil)
 		dockerDeployer.RemoveServices([]string{"homepage"}, servicesDir)
 	})
 }
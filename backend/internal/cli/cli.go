@@ -17,11 +17,27 @@ func NewRootCmd(executor shell.Executor) *cobra.Command {
 		Use:   "autonas",
 		Short: "AutoNAS CLI",
 	}
-	rootCmd.AddCommand(NewRunCommand(executor, func(params RunParams) (*gorm.DB, error) {
-		return storage.NewGormDb(
-			filepath.Join(params.GetDBDir(), "autonas.db"),
-			params.GetAddWritePerm(),
-		)
-	}))
+	rootCmd.AddCommand(NewRunCommand(executor, newGormDb))
+	rootCmd.AddCommand(NewSchemaCommand())
+	rootCmd.AddCommand(NewDBCommand(newGormDb, openGormDb))
 	return rootCmd
 }
+
+// newGormDb opens and migrates the postgres database when a dsn is set, else the sqlite database
+func newGormDb(params RunParams) (*gorm.DB, error) {
+	if params.DatabaseDSN != "" {
+		return storage.NewPostgresDb(params.DatabaseDSN)
+	}
+	return storage.NewGormDb(dbFile(params), params.GetAddWritePerm())
+}
+
+func openGormDb(params RunParams) (*gorm.DB, error) {
+	if params.DatabaseDSN != "" {
+		return storage.OpenPostgresDb(params.DatabaseDSN)
+	}
+	return storage.OpenGormDb(dbFile(params), params.GetAddWritePerm())
+}
+
+func dbFile(params RunParams) string {
+	return filepath.Join(params.GetDBDir(), "autonas.db")
+}
@@ -0,0 +1,142 @@
+package cli
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"omar-kada/autonas/internal/storage"
+	"omar-kada/autonas/models"
+	"omar-kada/autonas/testutil"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func setupPruneDB(t *testing.T) *gorm.DB {
+	db := testutil.NewMemoryStorage()
+	for _, age := range []int{100, 50, 1} {
+		start := time.Now().AddDate(0, 0, -age)
+		assert.NoError(t, db.Create(&models.Deployment{
+			Time: start, EndTime: start.Add(time.Minute), Status: models.DeploymentStatusSuccess,
+		}).Error)
+	}
+	return db
+}
+
+func countDeployments(t *testing.T, db *gorm.DB) int64 {
+	var count int64
+	assert.NoError(t, db.Model(&models.Deployment{}).Count(&count).Error)
+	return count
+}
+
+func TestDBPruneCommand_ConfigRetention(t *testing.T) {
+	db := setupPruneDB(t)
+	configFile := filepath.Join(t.TempDir(), "config.yaml")
+	assert.NoError(t, os.WriteFile(configFile, []byte("settings:\n  retention:\n    maxAge: 60d\n"), 0o600))
+
+	cmd := NewDBCommand(func(_ RunParams) (*gorm.DB, error) { return db, nil }, nil)
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{"prune", "-f", configFile})
+
+	assert.NoError(t, cmd.Execute())
+	assert.Equal(t, "deleted 1 deployments, 0 files, 0 events and 0 logs, compressed 0 diffs\n", out.String())
+	assert.Equal(t, int64(2), countDeployments(t, db))
+}
+
+func TestDBPruneCommand_FlagsOverrideConfig(t *testing.T) {
+	db := setupPruneDB(t)
+	configFile := filepath.Join(t.TempDir(), "config.yaml")
+	assert.NoError(t, os.WriteFile(configFile, []byte("settings:\n  retention:\n    maxAge: 60d\n"), 0o600))
+
+	cmd := NewDBCommand(func(_ RunParams) (*gorm.DB, error) { return db, nil }, nil)
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetArgs([]string{"prune", "-f", configFile, "--max-age", "10d", "--vacuum=false"})
+
+	assert.NoError(t, cmd.Execute())
+	assert.Equal(t, int64(1), countDeployments(t, db))
+}
+
+func TestDBPruneCommand_NoRetention(t *testing.T) {
+	db := setupPruneDB(t)
+	cmd := NewDBCommand(func(_ RunParams) (*gorm.DB, error) { return db, nil }, nil)
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{"prune", "-f", filepath.Join(t.TempDir(), "missing.yaml")})
+
+	assert.ErrorContains(t, cmd.Execute(), "no retention defined")
+	assert.Equal(t, int64(3), countDeployments(t, db))
+}
+
+func TestDBBackupAndRestoreCommands(t *testing.T) {
+	db := setupPruneDB(t)
+	dir := t.TempDir()
+	configFile := filepath.Join(dir, "config.yaml")
+	assert.NoError(t, os.WriteFile(configFile, []byte("settings: {}\n"), 0o600))
+	archive := filepath.Join(dir, "backup.tar.gz")
+
+	cmd := NewDBCommand(func(_ RunParams) (*gorm.DB, error) { return db, nil }, nil)
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{"backup", "-f", configFile, "-o", archive})
+	assert.NoError(t, cmd.Execute())
+	assert.FileExists(t, archive)
+
+	// an existing archive isn't overwritten
+	cmd.SetArgs([]string{"backup", "-f", configFile, "-o", archive})
+	assert.Error(t, cmd.Execute())
+
+	workingDir := filepath.Join(dir, "new-host")
+	newConfigFile := filepath.Join(workingDir, "config.yaml")
+	assert.NoError(t, os.MkdirAll(workingDir, 0o700))
+	cmd = NewDBCommand(nil, nil)
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{"restore", archive, "-f", newConfigFile, "-d", workingDir})
+	assert.NoError(t, cmd.Execute())
+
+	restored, err := newGormDb(RunParams{DeploymentParams: models.DeploymentParams{WorkingDir: workingDir}})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), countDeployments(t, restored))
+	config, _ := os.ReadFile(newConfigFile)
+	assert.Equal(t, "settings: {}\n", string(config))
+}
+
+func TestDBMigrateAndStatusCommands(t *testing.T) {
+	db, err := storage.OpenGormDb(":memory:", 0o000)
+	assert.NoError(t, err)
+	opener := func(_ RunParams) (*gorm.DB, error) { return db, nil }
+	latest := storage.NewMigrator(db).Latest()
+
+	cmd := NewDBCommand(nil, opener)
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{"status"})
+	assert.NoError(t, cmd.Execute())
+	assert.Contains(t, out.String(), fmt.Sprintf("schema version 0, latest %d\n", latest))
+	assert.Regexp(t, `1\s+initial schema\s+pending`, out.String())
+
+	out.Reset()
+	cmd = NewDBCommand(nil, opener)
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{"migrate"})
+	assert.NoError(t, cmd.Execute())
+	assert.Equal(t, fmt.Sprintf("schema migrated from version 0 to %d\n", latest), out.String())
+
+	out.Reset()
+	cmd = NewDBCommand(nil, opener)
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{"migrate", "--to", "1"})
+	assert.NoError(t, cmd.Execute())
+	assert.Equal(t, fmt.Sprintf("schema migrated from version %d to 1\n", latest), out.String())
+
+	out.Reset()
+	cmd = NewDBCommand(nil, opener)
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{"status"})
+	assert.NoError(t, cmd.Execute())
+	assert.Regexp(t, `1\s+initial schema\s+applied \d{4}-`, out.String())
+}
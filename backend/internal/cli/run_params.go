@@ -1,6 +1,11 @@
 package cli
 
 import (
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+
 	"omar-kada/autonas/internal/cli/defaults"
 	"omar-kada/autonas/models"
 )
@@ -11,6 +16,10 @@ const (
 	_servicesDir  defaults.VarKey = "services-dir"
 	_addWritePerm defaults.VarKey = "add-write-perm"
 	_port         defaults.VarKey = "port"
+	_masterKey    defaults.VarKey = "master-key-file"
+	_profile      defaults.VarKey = "profile"
+	_metricsToken defaults.VarKey = "metrics-token"
+	_dbDSN        defaults.VarKey = "db-dsn"
 )
 
 var varInfoMap = defaults.VariableInfoMap{
@@ -19,25 +28,62 @@ var varInfoMap = defaults.VariableInfoMap{
 	_servicesDir:  {EnvKey: "AUTONAS_SERVICES_DIR", DefaultValue: "."},
 	_addWritePerm: {EnvKey: "AUTONAS_ADD_WRITE_PERM", DefaultValue: "false"},
 	_port:         {EnvKey: "AUTONAS_PORT", DefaultValue: 5005},
+	_masterKey:    {EnvKey: "AUTONAS_MASTER_KEY_FILE"},
+	_profile:      {EnvKey: "AUTONAS_PROFILE"},
+	_metricsToken: {EnvKey: "AUTONAS_METRICS_TOKEN"},
+	_dbDSN:        {EnvKey: "AUTONAS_DB_DSN"},
 }
 
 // RunParams contain parameters of the run command
 type RunParams struct {
 	models.DeploymentParams
 	models.ServerParams
-	ConfigFile string
+	ConfigFile    string
+	MasterKeyFile string
+	// Profile is a comma separated list of config overlays, applied in order
+	Profile string
+	// DatabaseDSN is the connection string of a postgres database, sqlite is used when empty
+	DatabaseDSN string
+}
+
+// GetProfiles returns the config overlays to apply, in order
+func (p RunParams) GetProfiles() []string {
+	var profiles []string
+	for profile := range strings.SplitSeq(p.Profile, ",") {
+		if profile = strings.TrimSpace(profile); profile != "" {
+			profiles = append(profiles, profile)
+		}
+	}
+	return profiles
 }
 
 func getParamsWithDefaults(p RunParams) RunParams {
+	workingDir := varInfoMap.EnvOrDefault(p.WorkingDir, _workingDir)
+	masterKeyFile := varInfoMap.EnvOrDefault(p.MasterKeyFile, _masterKey)
+	if masterKeyFile == "" {
+		masterKeyFile = filepath.Join(workingDir, "master.key")
+	}
+	profile := varInfoMap.EnvOrDefault(p.Profile, _profile)
+	if profile == "" {
+		hostname, err := os.Hostname()
+		if err != nil {
+			slog.Warn("couldn't get hostname, no config overlay will be applied", "error", err)
+		}
+		profile = hostname
+	}
 	return RunParams{
-		ConfigFile: varInfoMap.EnvOrDefault(p.ConfigFile, _file),
+		ConfigFile:    varInfoMap.EnvOrDefault(p.ConfigFile, _file),
+		MasterKeyFile: masterKeyFile,
+		Profile:       profile,
+		DatabaseDSN:   varInfoMap.EnvOrDefault(p.DatabaseDSN, _dbDSN),
 		DeploymentParams: models.DeploymentParams{
-			WorkingDir:   varInfoMap.EnvOrDefault(p.WorkingDir, _workingDir),
+			WorkingDir:   workingDir,
 			ServicesDir:  varInfoMap.EnvOrDefault(p.ServicesDir, _servicesDir),
 			AddWritePerm: varInfoMap.EnvOrDefault(p.AddWritePerm, _addWritePerm),
 		},
 		ServerParams: models.ServerParams{
-			Port: varInfoMap.EnvOrDefaultInt(p.Port, _port),
+			Port:         varInfoMap.EnvOrDefaultInt(p.Port, _port),
+			MetricsToken: varInfoMap.EnvOrDefault(p.MetricsToken, _metricsToken),
 		},
 	}
 }
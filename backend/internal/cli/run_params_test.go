@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"os"
 	"testing"
 
 	"omar-kada/autonas/models"
@@ -117,3 +118,18 @@ func TestGetParamsWithDefaults_MixedSources(t *testing.T) {
 	assert.Equal(t, "true", result.AddWritePerm)
 	assert.Equal(t, 9090, result.Port)
 }
+
+func TestGetParamsWithDefaults_Profile(t *testing.T) {
+	t.Setenv("AUTONAS_PROFILE", "")
+	hostname, _ := os.Hostname()
+
+	result := getParamsWithDefaults(RunParams{})
+	assert.Equal(t, hostname, result.Profile)
+
+	t.Setenv("AUTONAS_PROFILE", "nas1")
+	result = getParamsWithDefaults(RunParams{})
+	assert.Equal(t, []string{"nas1"}, result.GetProfiles())
+
+	result = getParamsWithDefaults(RunParams{Profile: "nas1, gpu,"})
+	assert.Equal(t, []string{"nas1", "gpu"}, result.GetProfiles())
+}
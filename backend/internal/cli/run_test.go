@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	"omar-kada/autonas/models"
 	"omar-kada/autonas/testutil"
 
 	"github.com/stretchr/testify/assert"
@@ -24,6 +25,11 @@ func (m *Mocker) Exec(cmd string, cmdArgs ...string) ([]byte, error) {
 	return args.Get(0).([]byte), args.Error(1)
 }
 
+func (m *Mocker) Stream(_ func(stream models.LogStream, line string), cmd string, cmdArgs ...string) error {
+	args := m.Called(cmd, cmdArgs)
+	return args.Error(0)
+}
+
 func initMemoryStorage(_ RunParams) (*gorm.DB, error) {
 	return testutil.NewMemoryStorage(), nil
 }
@@ -56,9 +62,9 @@ func TestRunCommand_CmdParams(t *testing.T) {
 	os.MkdirAll(workingDir, 0o750)
 
 	mocker.On(
-		"Exec", "docker",
+		"Stream", "docker",
 		[]string{"compose", "--project-directory", filepath.Join(servicesDir, "homepage"), "up", "-d"},
-	).Return([]byte{}, nil)
+	).Return(nil)
 
 	remoteRepoPath := initConfigRepo(t)
 
@@ -110,9 +116,9 @@ func TestRunCommand_EnvParams(t *testing.T) {
 	os.MkdirAll(workingDir, 0o750)
 
 	mocker.On(
-		"Exec", "docker",
+		"Stream", "docker",
 		[]string{"compose", "--project-directory", filepath.Join(servicesDir, "homepage"), "up", "-d"},
-	).Return([]byte{}, nil)
+	).Return(nil)
 
 	remoteRepoPath := initConfigRepo(t)
 
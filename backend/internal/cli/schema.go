@@ -0,0 +1,22 @@
+package cli
+
+import (
+	"encoding/json"
+
+	"omar-kada/autonas/internal/storage"
+
+	"github.com/spf13/cobra"
+)
+
+// NewSchemaCommand creates a command printing the JSON Schema of the config file
+func NewSchemaCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "schema",
+		Short: "Print the JSON Schema of the config file, to validate it in editors",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			encoder := json.NewEncoder(cmd.OutOrStdout())
+			encoder.SetIndent("", "  ")
+			return encoder.Encode(storage.ConfigJSONSchema())
+		},
+	}
+}
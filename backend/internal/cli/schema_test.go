@@ -0,0 +1,24 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSchemaCommand(t *testing.T) {
+	cmd := NewSchemaCommand()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+
+	assert.NoError(t, cmd.Execute())
+
+	var schema map[string]any
+	assert.NoError(t, json.Unmarshal(out.Bytes(), &schema))
+	properties := schema["properties"].(map[string]any)
+	assert.Contains(t, properties, "settings")
+	assert.Contains(t, properties, "environment")
+	assert.Contains(t, properties, "services")
+}
@@ -7,6 +7,7 @@ import (
 	"os"
 	"path/filepath"
 	"slices"
+	"strings"
 
 	"omar-kada/autonas/internal/events"
 	"omar-kada/autonas/internal/files"
@@ -20,13 +21,17 @@ type Deployer interface {
 	RemoveServices(services []string, servicesDir string) map[string]error
 	DeployServices(cfg models.Config, params models.DeploymentParams) map[string]error
 	RemoveAndDeployStacks(oldCfg, cfg models.Config, params models.DeploymentParams) error
+	UpdateImages(services []string, servicesDir string) map[string]error
+	PruneImages() error
+	RunStackAction(stack string, action models.StackAction, servicesDir string) error
+	RestartContainer(stack, containerID string) error
 }
 
 // NewDeployer creates an instance of Manager for docker containers
-func NewDeployer(dispatcher events.Dispatcher, executor shell.Executor) Deployer {
+func NewDeployer(dispatcher events.Dispatcher, executor shell.Executor, secrets SecretStore) Deployer {
 	return &deployer{
 		cmdExecuter:  executor,
-		envGenerator: NewEnvGenerator(),
+		envGenerator: NewEnvGenerator(secrets),
 		copier:       files.NewCopier(),
 		dispatcher:   dispatcher,
 		ctx:          context.Background(),
@@ -93,6 +98,7 @@ func (d deployer) DeployServices(cfg models.Config, params models.DeploymentPara
 			errors[service] = err
 			continue
 		}
+		d.warnMissingVariables(cfg, params.ServicesDir, service)
 		if err := d.composeUp(filepath.Join(params.ServicesDir, service)); err != nil {
 			d.dispatcher.Dispatch(d.ctx, models.EventError, fmt.Sprintf("Error running docker compose for %s : %v", service, err))
 			errors[service] = err
@@ -101,17 +107,115 @@ func (d deployer) DeployServices(cfg models.Config, params models.DeploymentPara
 	return errors
 }
 
+func (d deployer) warnMissingVariables(cfg models.Config, servicesDir, service string) {
+	missing, err := MissingVariables(cfg, servicesDir, service)
+	if err != nil || len(missing) == 0 {
+		return
+	}
+	names := make([]string, 0, len(missing))
+	for _, variable := range missing {
+		names = append(names, variable.Name)
+	}
+	d.dispatcher.Dispatch(d.ctx, models.EventMisc, fmt.Sprintf("Variables %v used by %s have no value", names, service))
+}
+
 func (d deployer) composeUp(composePath string) error {
-	args := []string{"compose", "--project-directory", composePath, "up", "-d"}
-	if _, err := d.cmdExecuter.Exec("docker", args...); err != nil {
+	if err := d.compose(composePath, "up", "-d"); err != nil {
 		return fmt.Errorf("failed to run docker compose up : %w", err)
 	}
 	return nil
 }
 
+// UpdateImages pulls the images of already deployed services, and recreates the containers
+// whose image changed.
+func (d deployer) UpdateImages(services []string, servicesDir string) map[string]error {
+	errors := make(map[string]error)
+	for _, service := range services {
+		composeDir := filepath.Join(servicesDir, service)
+
+		if info, err := os.Stat(composeDir); os.IsNotExist(err) || !info.IsDir() {
+			d.dispatcher.Dispatch(d.ctx, models.EventMisc, fmt.Sprintf("Skipping image update for %s: directory does not exist", service))
+			continue
+		}
+
+		if err := d.composePull(composeDir); err != nil {
+			d.dispatcher.Dispatch(d.ctx, models.EventError, fmt.Sprintf("Error pulling images for %s : %v", service, err))
+			errors[service] = err
+			continue
+		}
+		if err := d.composeUp(composeDir); err != nil {
+			d.dispatcher.Dispatch(d.ctx, models.EventError, fmt.Sprintf("Error running docker compose for %s : %v", service, err))
+			errors[service] = err
+		}
+	}
+	return errors
+}
+
+// PruneImages removes the dangling images
+func (d deployer) PruneImages() error {
+	out, err := d.cmdExecuter.Exec("docker", "image", "prune", "-f")
+	if err != nil {
+		return fmt.Errorf("failed to run docker image prune : %w", err)
+	}
+	d.dispatcher.Dispatch(d.ctx, models.EventMisc, strings.TrimSpace(string(out)))
+	return nil
+}
+
+// RunStackAction runs the docker compose commands of the action on a deployed stack
+func (d deployer) RunStackAction(stack string, action models.StackAction, servicesDir string) error {
+	composeDir := filepath.Join(servicesDir, stack)
+	if info, err := os.Stat(composeDir); os.IsNotExist(err) || !info.IsDir() {
+		return fmt.Errorf("stack %s isn't deployed", stack)
+	}
+
+	switch action {
+	case models.StackActionPullAndRecreate:
+		if err := d.composePull(composeDir); err != nil {
+			return err
+		}
+		if err := d.compose(composeDir, "up", "-d", "--force-recreate"); err != nil {
+			return fmt.Errorf("failed to run docker compose up : %w", err)
+		}
+	case models.StackActionRestart, models.StackActionStop, models.StackActionStart, models.StackActionDown:
+		if err := d.compose(composeDir, string(action)); err != nil {
+			return fmt.Errorf("failed to run docker compose %s : %w", action, err)
+		}
+	default:
+		return fmt.Errorf("unknown stack action %q", action)
+	}
+	return nil
+}
+
+// RestartContainer restarts a container, its output is dispatched as logs of the stack
+func (d deployer) RestartContainer(stack, containerID string) error {
+	onLine := func(stream models.LogStream, line string) {
+		d.dispatcher.Dispatch(events.GetLogContext(d.ctx, stack, stream), models.EventLog, line)
+	}
+	if err := d.cmdExecuter.Stream(onLine, "docker", "restart", containerID); err != nil {
+		return fmt.Errorf("failed to restart container %s : %w", containerID, err)
+	}
+	return nil
+}
+
+func (d deployer) composePull(composePath string) error {
+	if err := d.compose(composePath, "pull"); err != nil {
+		return fmt.Errorf("failed to run docker compose pull : %w", err)
+	}
+	return nil
+}
+
+// compose runs a docker compose command of the stack in composePath, its output is dispatched
+// as logs of the stack
+func (d deployer) compose(composePath string, args ...string) error {
+	service := filepath.Base(composePath)
+	onLine := func(stream models.LogStream, line string) {
+		d.dispatcher.Dispatch(events.GetLogContext(d.ctx, service, stream), models.EventLog, line)
+	}
+	return d.cmdExecuter.Stream(onLine, "docker", append([]string{"compose", "--project-directory", composePath}, args...)...)
+}
+
 func (d deployer) composeDown(composePath string) error {
-	args := []string{"compose", "--project-directory", composePath, "down"}
-	if _, err := d.cmdExecuter.Exec("docker", args...); err != nil {
+	if err := d.compose(composePath, "down"); err != nil {
 		return fmt.Errorf("failed to run docker compose down : %w", err)
 	}
 	return nil
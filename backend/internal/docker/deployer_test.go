@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"testing"
 
@@ -33,6 +34,12 @@ func (m *Mocker) Exec(cmd string, cmdArgs ...string) ([]byte, error) {
 	return args.Get(0).([]byte), args.Error(1)
 }
 
+func (m *Mocker) Stream(onLine func(stream models.LogStream, line string), cmd string, cmdArgs ...string) error {
+	args := m.Called(cmd, cmdArgs)
+	onLine(models.LogStreamStdout, strings.Join(cmdArgs, " "))
+	return args.Error(0)
+}
+
 func (m *Mocker) Copy(src, dest string) error {
 	args := m.Called(src, dest)
 	return args.Error(0)
@@ -96,8 +103,8 @@ func TestDeployServices_SingleService_WithOverride(t *testing.T) {
 		mocker.On("Copy", "repo/services/svc1", serviceDir).Return(nil),
 		mocker.On("WriteToFile", envFilePath, wantEnv).Return(nil),
 		mocker.On(
-			"Exec", "docker", []string{"compose", "--project-directory", filepath.Join(baseDir, "svc1"), "up", "-d"},
-		).Return([]byte{}, nil),
+			"Stream", "docker", []string{"compose", "--project-directory", filepath.Join(baseDir, "svc1"), "up", "-d"},
+		).Return(nil),
 	)
 	errs := deployer.DeployServices(mockConfig, models.DeploymentParams{
 		ServicesDir: baseDir,
@@ -115,11 +122,11 @@ func TestRemoveServices_MultipleServices(t *testing.T) {
 
 	deployer := newDeployerWithMocks(mocker)
 	mocker.On(
-		"Exec", "docker", []string{"compose", "--project-directory", filepath.Join(baseDir, "svc1"), "down"},
-	).Return([]byte{}, nil)
+		"Stream", "docker", []string{"compose", "--project-directory", filepath.Join(baseDir, "svc1"), "down"},
+	).Return(nil)
 	mocker.On(
-		"Exec", "docker", []string{"compose", "--project-directory", filepath.Join(baseDir, "svc2"), "down"},
-	).Return([]byte{}, fmt.Errorf("mock error"))
+		"Stream", "docker", []string{"compose", "--project-directory", filepath.Join(baseDir, "svc2"), "down"},
+	).Return(fmt.Errorf("mock error"))
 	errs := deployer.RemoveServices([]string{"svc1", "svc2"}, baseDir)
 
 	assert.Len(t, errs, 1)
@@ -163,7 +170,7 @@ func TestDeployServices_Errors(t *testing.T) {
 			deployer := newDeployerWithMocks(mocker)
 			mocker.On("Copy", mock.Anything, mock.Anything).Return(tc.errors.writeFileErr)
 			mocker.On("WriteToFile", mock.Anything, mock.Anything).Return(tc.errors.writeFileErr)
-			mocker.On("Exec", "docker", mock.Anything).Return([]byte{}, tc.errors.runCmdErr)
+			mocker.On("Stream", "docker", mock.Anything).Return(tc.errors.runCmdErr)
 			errs := deployer.DeployServices(mockConfig, models.DeploymentParams{
 				ServicesDir: "/services",
 			})
@@ -184,8 +191,8 @@ func TestRemoveAndDeployStacks_Success(t *testing.T) {
 		).Return(nil),
 		mocker.On("WriteToFile", mock.Anything, mock.Anything).Return(nil),
 		mocker.On(
-			"Exec", "docker", []string{"compose", "--project-directory", filepath.Join("/", "services", "svc1"), "up", "-d"},
-		).Return([]byte{}, nil),
+			"Stream", "docker", []string{"compose", "--project-directory", filepath.Join("/", "services", "svc1"), "up", "-d"},
+		).Return(nil),
 	)
 
 	err := deployer.RemoveAndDeployStacks(mockConfig, mockConfig, models.DeploymentParams{
@@ -232,8 +239,8 @@ func TestRemoveAndDeployStacks_Errors(t *testing.T) {
 			mock.InOrder(
 				mocker.On("WriteToFile", mock.Anything, mock.Anything).Return(tc.errors.writeErr),
 				mocker.On(
-					"Exec", "docker", []string{"compose", "--project-directory", filepath.Join("/", "services", "svc1"), "up", "-d"},
-				).Return([]byte{}, tc.errors.runErr),
+					"Stream", "docker", []string{"compose", "--project-directory", filepath.Join("/", "services", "svc1"), "up", "-d"},
+				).Return(tc.errors.runErr),
 			)
 
 			mocker.On(
@@ -252,3 +259,116 @@ func TestRemoveAndDeployStacks_Errors(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateImages(t *testing.T) {
+	mocker := &Mocker{}
+	baseDir := t.TempDir()
+	assert.NoError(t, os.Mkdir(filepath.Join(baseDir, "svc1"), 0o750))
+	assert.NoError(t, os.Mkdir(filepath.Join(baseDir, "svc2"), 0o750))
+
+	deployer := newDeployerWithMocks(mocker)
+	svc1 := filepath.Join(baseDir, "svc1")
+	svc2 := filepath.Join(baseDir, "svc2")
+	mocker.On("Stream", "docker", []string{"compose", "--project-directory", svc1, "pull"}).Return(nil)
+	mocker.On("Stream", "docker", []string{"compose", "--project-directory", svc1, "up", "-d"}).Return(nil)
+	mocker.On("Stream", "docker", []string{"compose", "--project-directory", svc2, "pull"}).Return(ErrRunCmd)
+
+	errs := deployer.UpdateImages([]string{"svc1", "svc2", "missing"}, baseDir)
+
+	assert.Len(t, errs, 1)
+	assert.ErrorIs(t, errs["svc2"], ErrRunCmd)
+	mocker.AssertNotCalled(t, "Stream", "docker", []string{"compose", "--project-directory", svc2, "up", "-d"})
+}
+
+func TestPruneImages(t *testing.T) {
+	mocker := &Mocker{}
+	deployer := newDeployerWithMocks(mocker)
+	mocker.On("Exec", "docker", []string{"image", "prune", "-f"}).Return([]byte("Total reclaimed space: 0B\n"), nil).Once()
+	mocker.On("Exec", "docker", []string{"image", "prune", "-f"}).Return([]byte{}, ErrRunCmd).Once()
+
+	assert.NoError(t, deployer.PruneImages())
+	assert.ErrorIs(t, deployer.PruneImages(), ErrRunCmd)
+}
+
+type recordingHandler struct {
+	events []models.Event
+}
+
+func (h *recordingHandler) HandleEvent(_ context.Context, event models.Event) {
+	h.events = append(h.events, event)
+}
+
+func TestComposeOutputIsDispatchedAsLogs(t *testing.T) {
+	mocker := &Mocker{}
+	baseDir := t.TempDir()
+	assert.NoError(t, os.Mkdir(filepath.Join(baseDir, "svc1"), 0o750))
+	handler := &recordingHandler{}
+	deployer := newDeployerWithMocks(mocker)
+	deployer.dispatcher = events.NewDefaultDispatcher([]events.EventHandler{handler})
+	mocker.On("Stream", "docker", mock.Anything).Return(nil)
+
+	errs := deployer.RemoveServices([]string{"svc1"}, baseDir)
+
+	assert.Empty(t, errs)
+	logs := slices.DeleteFunc(handler.events, func(event models.Event) bool { return event.Type != models.EventLog })
+	assert.Len(t, logs, 1)
+	assert.Equal(t, "svc1", logs[0].Service)
+	assert.Equal(t, models.LogStreamStdout, logs[0].Stream)
+	assert.Equal(t, "compose --project-directory "+filepath.Join(baseDir, "svc1")+" down", logs[0].Msg)
+	assert.NotZero(t, logs[0].ObjectID)
+}
+
+func TestRunStackAction(t *testing.T) {
+	baseDir := t.TempDir()
+	assert.NoError(t, os.Mkdir(filepath.Join(baseDir, "svc1"), 0o750))
+	svc1 := filepath.Join(baseDir, "svc1")
+
+	testCases := []struct {
+		action   models.StackAction
+		commands [][]string
+	}{
+		{models.StackActionRestart, [][]string{{"restart"}}},
+		{models.StackActionStop, [][]string{{"stop"}}},
+		{models.StackActionStart, [][]string{{"start"}}},
+		{models.StackActionDown, [][]string{{"down"}}},
+		{models.StackActionPullAndRecreate, [][]string{{"pull"}, {"up", "-d", "--force-recreate"}}},
+	}
+	for _, tc := range testCases {
+		t.Run(string(tc.action), func(t *testing.T) {
+			mocker := &Mocker{}
+			for _, command := range tc.commands {
+				mocker.On("Stream", "docker", append([]string{"compose", "--project-directory", svc1}, command...)).Return(nil).Once()
+			}
+
+			assert.NoError(t, newDeployerWithMocks(mocker).RunStackAction("svc1", tc.action, baseDir))
+			mocker.AssertExpectations(t)
+		})
+	}
+}
+
+func TestRunStackAction_Errors(t *testing.T) {
+	baseDir := t.TempDir()
+	assert.NoError(t, os.Mkdir(filepath.Join(baseDir, "svc1"), 0o750))
+	mocker := &Mocker{}
+	mocker.On("Stream", "docker", []string{"compose", "--project-directory", filepath.Join(baseDir, "svc1"), "pull"}).Return(ErrRunCmd)
+	deployer := newDeployerWithMocks(mocker)
+
+	assert.ErrorContains(t, deployer.RunStackAction("missing", models.StackActionRestart, baseDir), "isn't deployed")
+	assert.ErrorContains(t, deployer.RunStackAction("svc1", "kill", baseDir), "unknown stack action")
+	assert.ErrorIs(t, deployer.RunStackAction("svc1", models.StackActionPullAndRecreate, baseDir), ErrRunCmd)
+	mocker.AssertNumberOfCalls(t, "Stream", 1)
+}
+
+func TestRestartContainer(t *testing.T) {
+	mocker := &Mocker{}
+	handler := &recordingHandler{}
+	deployer := newDeployerWithMocks(mocker)
+	deployer.dispatcher = events.NewDefaultDispatcher([]events.EventHandler{handler})
+	mocker.On("Stream", "docker", []string{"restart", "abc"}).Return(nil).Once()
+	mocker.On("Stream", "docker", []string{"restart", "abc"}).Return(ErrRunCmd).Once()
+
+	assert.NoError(t, deployer.RestartContainer("svc1", "abc"))
+	assert.Len(t, handler.events, 1)
+	assert.Equal(t, "svc1", handler.events[0].Service)
+	assert.ErrorIs(t, deployer.RestartContainer("svc1", "abc"), ErrRunCmd)
+}
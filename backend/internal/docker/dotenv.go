@@ -0,0 +1,228 @@
+package docker
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/elliotchance/orderedmap/v3"
+)
+
+const (
+	generatedHeader  = "# The next values are generated by AutoNAS : "
+	overriddenSuffix = " # Overridden by AutoNAS "
+)
+
+var (
+	overriddenMarker = strings.TrimRight(overriddenSuffix, " ")
+
+	exportRegexp = regexp.MustCompile(`^export\s+`)
+	envKeyRegexp = regexp.MustCompile(`^[A-Za-z0-9_.\-\[\]]+$`)
+	// unquotedValueRegexp matches values that can be written without quotes
+	unquotedValueRegexp = regexp.MustCompile(`^[A-Za-z0-9_./:@,+=%-]*$`)
+	// escapeSeqRegexp matches the escape sequences supported by docker compose in double quoted values
+	escapeSeqRegexp = regexp.MustCompile(`\\(?:[abcfnrtv$"\\]|0\d{0,3})`)
+)
+
+// envEntry is a statement of a .env file, comments and blank lines have no key
+type envEntry struct {
+	key   string
+	value string
+	// lines of the statement as written in the file, quoted values can span multiple lines
+	lines []string
+}
+
+// envFile is a parsed .env file that keeps the original formatting,
+// parsing follows the docker compose rules
+type envFile struct {
+	entries []envEntry
+}
+
+// readEnvFile parses the .env file at path, a missing file is an empty envFile
+func readEnvFile(path string) (*envFile, error) {
+	content, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return &envFile{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	env, err := parseEnv(string(content))
+	if err != nil {
+		return nil, fmt.Errorf("invalid env file %s : %w", path, err)
+	}
+	return env, nil
+}
+
+// parseEnv parses the content of a .env file, the block generated by AutoNAS is dropped
+// and the lines it overrode are restored, so that generating the file again is idempotent
+func parseEnv(content string) (*envFile, error) {
+	lines := strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n")
+	if strings.HasSuffix(content, "\n") {
+		lines = lines[:len(lines)-1]
+	}
+	lines = restoreOverriddenLines(lines)
+
+	env := &envFile{}
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			env.entries = append(env.entries, envEntry{lines: []string{line}})
+			continue
+		}
+
+		statement := exportRegexp.ReplaceAllString(trimmed, "")
+		key, rawValue, hasValue := strings.Cut(statement, "=")
+		key = strings.TrimSpace(key)
+		if !envKeyRegexp.MatchString(key) {
+			return nil, fmt.Errorf("line %d: invalid variable name %q", i+1, key)
+		}
+		if !hasValue {
+			// the value is inherited from the environment
+			env.entries = append(env.entries, envEntry{key: key, lines: []string{line}})
+			continue
+		}
+
+		rawValue = strings.TrimLeft(rawValue, " \t")
+		value, end, err := parseEnvValue(rawValue, lines, i)
+		if err != nil {
+			return nil, err
+		}
+		env.entries = append(env.entries, envEntry{key: key, value: value, lines: lines[i : end+1]})
+		i = end
+	}
+	return env, nil
+}
+
+// restoreOverriddenLines removes the generated block and un-comments overridden lines
+func restoreOverriddenLines(lines []string) []string {
+	restored := make([]string, 0, len(lines))
+	for _, line := range lines {
+		if strings.TrimSpace(line) == strings.TrimSpace(generatedHeader) {
+			break
+		}
+		trimmed := strings.TrimRight(line, " ")
+		if strings.HasPrefix(trimmed, "# ") && strings.HasSuffix(trimmed, overriddenMarker) {
+			line = trimmed[len("# ") : len(trimmed)-len(overriddenMarker)]
+		}
+		restored = append(restored, line)
+	}
+	return restored
+}
+
+// parseEnvValue parses the value starting at line start,
+// it returns the value and the index of the line where the statement ends
+func parseEnvValue(rawValue string, lines []string, start int) (string, int, error) {
+	if rawValue == "" || (rawValue[0] != '"' && rawValue[0] != '\'') {
+		return strings.TrimRight(cutInlineComment(rawValue), " \t"), start, nil
+	}
+
+	quote := rawValue[0]
+	var chars strings.Builder
+	escaped := false
+	text := rawValue[1:]
+	for lineIndex := start; lineIndex < len(lines); lineIndex++ {
+		if lineIndex > start {
+			text = lines[lineIndex]
+			chars.WriteByte('\n')
+		}
+		for i := 0; i < len(text); i++ {
+			char := text[i]
+			switch {
+			case char == quote && !escaped:
+				value := chars.String()
+				if quote == '"' {
+					value = expandEscapes(value)
+				}
+				return value, lineIndex, nil
+			case char == quote:
+				escaped = false
+				if quote == '\'' {
+					// single quoted values are read as is, the backslash is kept
+					chars.WriteByte('\\')
+				}
+				chars.WriteByte(char)
+			case escaped:
+				escaped = false
+				chars.WriteByte('\\')
+				chars.WriteByte(char)
+			case char == '\\':
+				escaped = true
+			default:
+				chars.WriteByte(char)
+			}
+		}
+	}
+	return "", 0, fmt.Errorf("line %d: unterminated quoted value", start+1)
+}
+
+// cutInlineComment removes the comment of an unquoted value, it starts at a # preceded by a space or a tab
+func cutInlineComment(value string) string {
+	for i := 1; i < len(value); i++ {
+		if value[i] == '#' && (value[i-1] == ' ' || value[i-1] == '\t') {
+			return value[:i]
+		}
+	}
+	return value
+}
+
+func expandEscapes(value string) string {
+	return escapeSeqRegexp.ReplaceAllStringFunc(value, func(match string) string {
+		if match == `\$` {
+			// kept escaped for docker compose interpolation
+			return "$$"
+		}
+		if strings.HasPrefix(match, `\0`) {
+			match = strings.Replace(match, `\0`, `\`, 1)
+		}
+		v, _, _, err := strconv.UnquoteChar(match, '"')
+		if err != nil {
+			return match
+		}
+		return string(v)
+	})
+}
+
+// has returns true if the variable is defined in the file
+func (env envFile) has(key string) bool {
+	for _, entry := range env.entries {
+		if entry.key == key {
+			return true
+		}
+	}
+	return false
+}
+
+// render writes back the file with the variables defined in generated
+// commented out, followed by the generated block
+func (env envFile) render(generated *orderedmap.OrderedMap[string, string]) string {
+	var content strings.Builder
+	for _, entry := range env.entries {
+		overridden := entry.key != "" && generated.Has(entry.key)
+		for _, line := range entry.lines {
+			if overridden {
+				fmt.Fprintf(&content, "# %s%s\n", line, overriddenSuffix)
+			} else {
+				fmt.Fprintf(&content, "%s\n", line)
+			}
+		}
+	}
+	fmt.Fprintf(&content, "%s\n", generatedHeader)
+	for key, value := range generated.AllFromFront() {
+		fmt.Fprintf(&content, "%s=%s\n", key, formatEnvValue(value))
+	}
+	return content.String()
+}
+
+// formatEnvValue quotes the value when needed. The $ are left as is for docker compose interpolation,
+// the values that must be read literally (secrets, results of functions) have them escaped as $$
+func formatEnvValue(value string) string {
+	if unquotedValueRegexp.MatchString(value) {
+		return value
+	}
+	replacer := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+	return `"` + replacer.Replace(value) + `"`
+}
@@ -0,0 +1,136 @@
+package docker
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/elliotchance/orderedmap/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseEnv_Values(t *testing.T) {
+	content := strings.Join([]string{
+		"# comment",
+		"PLAIN=value # inline comment",
+		"TABBED=value\t# inline comment",
+		"HASH=a#b",
+		`ESCAPED_QUOTE='it\'s'`,
+		"export EXPORTED=1",
+		"lower_case=keep",
+		"WITH_EQUALS='a=b=c'",
+		`DOUBLE="line1\nsay \"hi\""`,
+		"MULTI='first",
+		"second'",
+		"EMPTY=",
+		"INHERITED",
+		"  SPACED = value  ",
+	}, "\n") + "\n"
+
+	env, err := parseEnv(content)
+
+	assert.NoError(t, err)
+	values := map[string]string{}
+	var keys []string
+	for _, entry := range env.entries {
+		if entry.key != "" {
+			keys = append(keys, entry.key)
+			values[entry.key] = entry.value
+		}
+	}
+	assert.Equal(t, []string{
+		"PLAIN", "TABBED", "HASH", "ESCAPED_QUOTE", "EXPORTED", "lower_case", "WITH_EQUALS", "DOUBLE", "MULTI", "EMPTY", "INHERITED", "SPACED",
+	}, keys)
+	assert.Equal(t, "value", values["PLAIN"])
+	assert.Equal(t, "value", values["TABBED"])
+	assert.Equal(t, "a#b", values["HASH"])
+	assert.Equal(t, `it\'s`, values["ESCAPED_QUOTE"], "single quoted values keep the backslash")
+	assert.Equal(t, "1", values["EXPORTED"])
+	assert.Equal(t, "keep", values["lower_case"])
+	assert.Equal(t, "a=b=c", values["WITH_EQUALS"])
+	assert.Equal(t, "line1\nsay \"hi\"", values["DOUBLE"])
+	assert.Equal(t, "first\nsecond", values["MULTI"])
+	assert.Equal(t, "", values["EMPTY"])
+	assert.Equal(t, "value", values["SPACED"])
+	assert.True(t, env.has("lower_case"))
+	assert.False(t, env.has("LOWER_CASE"))
+}
+
+func TestParseEnv_Errors(t *testing.T) {
+	_, err := parseEnv("A='unterminated\nB=1")
+	assert.ErrorContains(t, err, "line 1: unterminated quoted value")
+
+	_, err = parseEnv("A=1\nINVALID KEY=1")
+	assert.ErrorContains(t, err, "line 2: invalid variable name")
+}
+
+func TestRender_RoundTrip(t *testing.T) {
+	content := strings.Join([]string{
+		"# keep this comment",
+		"export KEEP=1",
+		"",
+		"PORT=80",
+		"CERT='-----BEGIN-----",
+		"-----END-----'",
+		"port=lowercase is another variable",
+	}, "\n") + "\n"
+	generated := orderedmap.NewOrderedMap[string, string]()
+	generated.Set("PORT", "8080")
+	generated.Set("CERT", "generated")
+
+	env, err := parseEnv(content)
+	assert.NoError(t, err)
+	rendered := env.render(generated)
+
+	assert.Equal(t, strings.Join([]string{
+		"# keep this comment",
+		"export KEEP=1",
+		"",
+		"# PORT=80 # Overridden by AutoNAS ",
+		"# CERT='-----BEGIN----- # Overridden by AutoNAS ",
+		"# -----END-----' # Overridden by AutoNAS ",
+		"port=lowercase is another variable",
+		"# The next values are generated by AutoNAS : ",
+		"PORT=8080",
+		"CERT=generated",
+	}, "\n")+"\n", rendered)
+
+	// generating again from the generated file gives the same result
+	env, err = parseEnv(rendered)
+	assert.NoError(t, err)
+	assert.Equal(t, rendered, env.render(generated))
+
+	// removing a variable from the configuration restores the original line
+	generated.Delete("CERT")
+	env, err = parseEnv(rendered)
+	assert.NoError(t, err)
+	assert.Contains(t, env.render(generated), "\nCERT='-----BEGIN-----\n-----END-----'\n")
+}
+
+func TestFormatEnvValue(t *testing.T) {
+	testCases := []struct {
+		value string
+		want  string
+	}{
+		{value: "simple", want: "simple"},
+		{value: "/data/path:8080", want: "/data/path:8080"},
+		{value: "", want: ""},
+		{value: "with space", want: `"with space"`},
+		{value: "p@$$word#1", want: `"p@$$word#1"`},
+		{value: "it's", want: `"it's"`},
+		{value: "multi\nline", want: `"multi\nline"`},
+		{value: `ends with \`, want: `"ends with \\"`},
+		{value: `it's ${HOME:-/root} "quoted"`, want: `"it's ${HOME:-/root} \"quoted\""`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.value, func(t *testing.T) {
+			formatted := formatEnvValue(tc.value)
+			assert.Equal(t, tc.want, formatted)
+
+			// the value is read back as is, the $ are left to docker compose
+			env, err := parseEnv("KEY=" + formatted)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.value, env.entries[0].value)
+		})
+	}
+}
@@ -12,67 +12,81 @@ import (
 	"github.com/elliotchance/orderedmap/v3"
 )
 
+// secretsDir is the directory, inside the service directory, where secret files are written
+const secretsDir = ".secrets"
+
+// SecretStore gives access to stored secrets
+type SecretStore interface {
+	GetSecret(name string) (string, error)
+	SetSecret(name, value string) (models.Secret, error)
+}
+
 // NewEnvGenerator creates an instance of EnvGenerator
-func NewEnvGenerator() *EnvGenerator {
+func NewEnvGenerator(secrets SecretStore) *EnvGenerator {
 	return &EnvGenerator{
-		writer: files.NewWriter(),
+		writer:  files.NewWriter(),
+		secrets: secrets,
 	}
 }
 
 // EnvGenerator handles generation of .env files for docker compose services
 type EnvGenerator struct {
-	writer files.Writer
+	writer  files.Writer
+	secrets SecretStore
 }
 
 func (g EnvGenerator) generateEnvFile(cfg models.Config, servicesDir, service string) error {
-	serviceCfg := cfg.PerService(service)
+	serviceCfg, err := cfg.PerService(service, g.templateFuncs())
+	if err != nil {
+		return err
+	}
 	envFilePath := filepath.Join(servicesDir, service, ".env")
+	if err := g.resolveSecrets(serviceCfg, filepath.Join(servicesDir, service)); err != nil {
+		return err
+	}
 
-	envMap, err := parseEnvFile(envFilePath)
+	env, err := readEnvFile(envFilePath)
 	if err != nil {
 		return err
 	}
 
-	var content strings.Builder
-	if envMap != nil {
-		for key, rawLine := range envMap.AllFromFront() {
-			if serviceCfg.Has(key) {
-				fmt.Fprintf(&content, "# %s # Overridden by AutoNAS \n", rawLine)
-			} else {
-				fmt.Fprintf(&content, "%s\n", rawLine)
-			}
-		}
-	}
-	fmt.Fprint(&content, "# The next values are generated by AutoNAS : \n")
+	return g.writer.WriteToFile(envFilePath, env.render(serviceCfg))
+}
 
+// resolveSecrets replaces secret references with their values, secret-file:// references
+// are written to a file only readable by the owner and replaced by the path of that file.
+// The $ of the replaced values are escaped, docker compose doesn't interpolate them
+func (g EnvGenerator) resolveSecrets(serviceCfg *orderedmap.OrderedMap[string, string], serviceDir string) error {
 	for key, value := range serviceCfg.AllFromFront() {
-		fmt.Fprintf(&content, "%s=%v\n", key, value)
+		name, asFile, ok := models.ParseSecretReference(value)
+		if !ok {
+			continue
+		}
+		if g.secrets == nil {
+			return fmt.Errorf("can't resolve secret %s for %s : no secret store", name, key)
+		}
+		secret, err := g.secrets.GetSecret(name)
+		if err != nil {
+			return fmt.Errorf("can't resolve secret %s for %s : %w", name, key, err)
+		}
+		if asFile {
+			if secret, err = writeSecretFile(serviceDir, name, secret); err != nil {
+				return err
+			}
+		}
+		serviceCfg.Set(key, strings.ReplaceAll(secret, "$", "$$"))
 	}
-
-	return g.writer.WriteToFile(envFilePath, content.String())
+	return nil
 }
 
-func parseEnvFile(path string) (*orderedmap.OrderedMap[string, string], error) {
-	_, err := os.Stat(path)
-	if err != nil {
-		return nil, nil // file doesn't exists
-	}
-	fileContent, err := os.ReadFile(path)
-	if err != nil {
-		return nil, err
+func writeSecretFile(serviceDir, name, secret string) (string, error) {
+	dir := filepath.Join(serviceDir, secretsDir)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return "", fmt.Errorf("error creating secrets directory %s : %w", dir, err)
 	}
-	content := string(fileContent)
-	lines := strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n")
-
-	envMap := orderedmap.NewOrderedMap[string, string]()
-	for index, rawLine := range lines {
-		line := strings.TrimSpace(rawLine)
-		splitLine := strings.Split(line, "=")
-		if len(splitLine) > 1 {
-			envMap.Set(strings.ToUpper(splitLine[0]), rawLine)
-		} else {
-			envMap.Set(fmt.Sprint(index), rawLine)
-		}
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte(secret), 0o600); err != nil {
+		return "", fmt.Errorf("error writing secret file %s : %w", path, err)
 	}
-	return envMap, nil
+	return path, nil
 }
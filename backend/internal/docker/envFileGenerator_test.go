@@ -0,0 +1,94 @@
+package docker
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"omar-kada/autonas/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type secretsMock map[string]string
+
+func (s secretsMock) GetSecret(name string) (string, error) {
+	value, ok := s[name]
+	if !ok {
+		return "", models.ErrSecretNotFound
+	}
+	return value, nil
+}
+
+func (s secretsMock) SetSecret(name, value string) (models.Secret, error) {
+	s[name] = value
+	return models.Secret{Name: name}, nil
+}
+
+func TestGenerateEnvFile_ResolvesSecrets(t *testing.T) {
+	mocker := &Mocker{}
+	servicesDir := t.TempDir()
+	generator := EnvGenerator{
+		writer:  mocker,
+		secrets: secretsMock{"db": "p@ss$word"},
+	}
+	cfg := models.Config{
+		Services: map[string]models.ServiceConfig{
+			"svc1": {
+				"DB_PASSWORD":      "secret://db",
+				"DB_PASSWORD_FILE": "secret-file://db",
+				"DB_URL":           "postgres://u:${DB_PASSWORD}@db",
+				"LOGS":             "${HOME}/logs",
+			},
+		},
+	}
+	secretFile := filepath.Join(servicesDir, "svc1", ".secrets", "db")
+
+	var content string
+	mocker.On("WriteToFile", filepath.Join(servicesDir, "svc1", ".env"), mock.Anything).
+		Run(func(args mock.Arguments) { content = args.String(1) }).
+		Return(nil)
+
+	err := generator.generateEnvFile(cfg, servicesDir, "svc1")
+
+	assert.NoError(t, err)
+	assert.Contains(t, content, "DB_PASSWORD=\"p@ss$$word\"\n", "the secret isn't interpolated by docker compose")
+	assert.Contains(t, content, "DB_URL=\"postgres://u:p@ss$$word@db\"\n", "the embedded secret is resolved")
+	assert.Contains(t, content, "LOGS=\"${HOME}/logs\"\n", "the user values are interpolated by docker compose")
+	assert.Contains(t, content, "DB_PASSWORD_FILE="+secretFile+"\n")
+	assert.NotContains(t, content, "secret://")
+	written, err := os.ReadFile(secretFile)
+	assert.NoError(t, err)
+	assert.Equal(t, "p@ss$word", string(written))
+	info, err := os.Stat(secretFile)
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+}
+
+func TestGenerateEnvFile_SecretErrors(t *testing.T) {
+	cfg := models.Config{
+		Environment: models.Environment{"DB_PASSWORD": "secret://db"},
+		Services:    map[string]models.ServiceConfig{"svc1": {}},
+	}
+
+	testCases := []struct {
+		name    string
+		secrets SecretStore
+		wantErr string
+	}{
+		{name: "no secret store", secrets: nil, wantErr: "no secret store"},
+		{name: "unknown secret", secrets: secretsMock{}, wantErr: models.ErrSecretNotFound.Error()},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mocker := &Mocker{}
+			generator := EnvGenerator{writer: mocker, secrets: tc.secrets}
+
+			err := generator.generateEnvFile(cfg, t.TempDir(), "svc1")
+
+			assert.ErrorContains(t, err, tc.wantErr)
+			mocker.AssertNotCalled(t, "WriteToFile", mock.Anything, mock.Anything)
+		})
+	}
+}
@@ -1,36 +1,65 @@
 package docker
 
 import (
+	"bufio"
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"log/slog"
 	"path/filepath"
+	"slices"
 	"strings"
+	"sync"
 	"time"
 
 	"omar-kada/autonas/internal/shell"
 	"omar-kada/autonas/models"
 
+	cerrdefs "github.com/containerd/errdefs"
+	"github.com/moby/moby/api/pkg/stdcopy"
+	"github.com/moby/moby/api/types/container"
 	"github.com/moby/moby/client"
 )
 
+// ErrContainerNotManaged is returned for the containers that aren't part of the given stack
+var ErrContainerNotManaged = errors.New("container not found in the stacks managed by autonas")
+
 // Inspector defined operations for info retreival on containers
 type Inspector interface {
 	GetManagedStacks(servicesDir string) (map[string][]models.ContainerSummary, error)
 	GetServiceContainers(serviceName string, servicesDir string) ([]string, error)
+	CheckManagedContainer(ctx context.Context, servicesDir, stack, containerID string) error
+	StreamContainerLogs(ctx context.Context, containerID string,
+		options models.ContainerLogsOptions, onLine func(models.ContainerLogLine)) error
+	ReadContainerStats(ctx context.Context, containerID string) (container.StatsResponse, error)
+	Watch(ctx context.Context, onEvent func(models.ContainerEvent))
+	Invalidate()
 }
 
 // Client defines the methods from the Docker client that are used by the Inspector
 type Client interface {
 	ContainerList(ctx context.Context, options client.ContainerListOptions) (client.ContainerListResult, error)
 	ContainerInspect(ctx context.Context, containerID string, options client.ContainerInspectOptions) (client.ContainerInspectResult, error)
+	ContainerLogs(ctx context.Context, containerID string, options client.ContainerLogsOptions) (client.ContainerLogsResult, error)
+	ContainerStats(ctx context.Context, containerID string, options client.ContainerStatsOptions) (client.ContainerStatsResult, error)
+	Events(ctx context.Context, options client.EventsListOptions) client.EventsResult
 }
 
+// workingDirLabel is set by docker compose on the containers, it identifies the stack
+const workingDirLabel = "com.docker.compose.project.working_dir"
+
 // inspector implements information retrieval about docker stacks
 type inspector struct {
 	log          *slog.Logger
 	executor     shell.Executor
 	dockerClient Client
+
+	// cache is kept current by the docker events, see Watch
+	mu    sync.Mutex
+	cache inspectorCache
 }
 
 // NewInspector creates new inspector given a docker client
@@ -40,53 +69,130 @@ func NewInspector() (Inspector, error) {
 		slog.Error("Failed to create docker client", "error", err)
 		return nil, err
 	}
+	return newInspector(client, shell.NewExecutor()), nil
+}
+
+func newInspector(dockerClient Client, executor shell.Executor) *inspector {
 	return &inspector{
 		log:          slog.Default(),
-		executor:     shell.NewExecutor(),
-		dockerClient: client,
-	}, nil
+		executor:     executor,
+		dockerClient: dockerClient,
+		cache:        newInspectorCache(),
+	}
 }
 
 // GetManagedStacks returns the list of containers (as returned by ContainerList)
 // that are managed by AutoNAS
 func (i *inspector) GetManagedStacks(servicesDir string) (map[string][]models.ContainerSummary, error) {
+	i.mu.Lock()
+	if stacks, ok := i.cache.getStacks(servicesDir); ok {
+		i.mu.Unlock()
+		return stacks, nil
+	}
+	generation := i.cache.generation
+	i.mu.Unlock()
+
+	stacks, err := i.listManagedStacks(servicesDir)
+	if err != nil {
+		return nil, err
+	}
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	if i.cache.generation == generation {
+		i.cache.setStacks(servicesDir, stacks)
+	}
+	return cloneStacks(stacks), nil
+}
+
+func (i *inspector) listManagedStacks(servicesDir string) (map[string][]models.ContainerSummary, error) {
 	ctx := context.Background()
-	summaries, err := i.dockerClient.ContainerList(ctx, client.ContainerListOptions{All: true})
+	summaries, err := i.dockerClient.ContainerList(ctx, client.ContainerListOptions{
+		All:     true,
+		Filters: make(client.Filters).Add("label", workingDirLabel),
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to list containers: %w", err)
 	}
 
 	matches := make(map[string][]models.ContainerSummary)
+	listed := make(map[string]bool, len(summaries.Items))
 	for _, c := range summaries.Items {
-
-		inspect, err := i.dockerClient.ContainerInspect(ctx, c.ID, client.ContainerInspectOptions{})
-		if err != nil {
-			slog.Error("Failed to inspect container",
-				"containerId", c.ID, "names", c.Names, "error", err)
+		serviceName := stackFromLabels(c.Labels, servicesDir)
+		if serviceName == "" {
 			continue
 		}
-		serviceName := getServiceNameFromLabel(inspect, servicesDir)
-		if serviceName != "" {
-			startedAt, err := time.Parse(time.RFC3339Nano, inspect.Container.State.StartedAt)
-			if err != nil {
-				return nil, fmt.Errorf("failed to parse : %w", err)
-			}
-			matches[serviceName] = append(matches[serviceName], models.ContainerSummary{
-				ID:        c.ID,
-				Name:      c.Labels["com.docker.compose.service"],
-				Image:     c.Image,
-				State:     c.State,
-				Health:    inspect.Container.State.Health.Status,
-				StartedAt: startedAt,
-			})
+		listed[c.ID] = true
+		startedAt, ok, err := i.startedAt(ctx, c)
+		if err != nil {
+			return nil, err
 		}
+		if !ok {
+			continue
+		}
+		matches[serviceName] = append(matches[serviceName], models.ContainerSummary{
+			ID:        c.ID,
+			Name:      c.Labels["com.docker.compose.service"],
+			Image:     c.Image,
+			State:     c.State,
+			Health:    summaryHealth(c),
+			StartedAt: startedAt,
+		})
 	}
+	i.mu.Lock()
+	i.cache.keepStartedAt(listed)
+	i.mu.Unlock()
 	return matches, nil
 }
 
+// startedAt returns the start time of the container, it isn't part of the listing
+// so the containers are inspected once and the start time is cached until they restart
+func (i *inspector) startedAt(ctx context.Context, c container.Summary) (time.Time, bool, error) {
+	i.mu.Lock()
+	startedAt, ok := i.cache.startedAt[c.ID]
+	i.mu.Unlock()
+	if ok {
+		return startedAt, true, nil
+	}
+
+	inspect, err := i.dockerClient.ContainerInspect(ctx, c.ID, client.ContainerInspectOptions{})
+	if err != nil {
+		slog.Error("Failed to inspect container",
+			"containerId", c.ID, "names", c.Names, "error", err)
+		return time.Time{}, false, nil
+	}
+	startedAt, err = time.Parse(time.RFC3339Nano, inspect.Container.State.StartedAt)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("failed to parse : %w", err)
+	}
+	i.mu.Lock()
+	i.cache.setStartedAt(c.ID, startedAt)
+	i.mu.Unlock()
+	return startedAt, true, nil
+}
+
+// summaryHealth reads the health from the listing, the older engines only print it in the status
+func summaryHealth(c container.Summary) container.HealthStatus {
+	if c.Health != nil {
+		return c.Health.Status
+	}
+	switch {
+	case strings.Contains(c.Status, "(healthy)"):
+		return container.Healthy
+	case strings.Contains(c.Status, "(unhealthy)"):
+		return container.Unhealthy
+	case strings.Contains(c.Status, "(health: starting)"):
+		return container.Starting
+	}
+	return container.NoHealthcheck
+}
+
 func getServiceNameFromLabel(inspect client.ContainerInspectResult, servicesDir string) string {
-	for key, value := range inspect.Container.Config.Labels {
-		if strings.EqualFold(key, "com.docker.compose.project.working_dir") {
+	return stackFromLabels(inspect.Container.Config.Labels, servicesDir)
+}
+
+func stackFromLabels(labels map[string]string, servicesDir string) string {
+	for key, value := range labels {
+		if strings.EqualFold(key, workingDirLabel) {
 			if after, found := strings.CutPrefix(value, servicesDir); found {
 				return strings.TrimPrefix(after, "/")
 			}
@@ -96,7 +202,164 @@ func getServiceNameFromLabel(inspect client.ContainerInspectResult, servicesDir
 	return ""
 }
 
+// GetServiceContainers returns the services of the compose file of the stack,
+// they are cached until the next Invalidate
 func (i *inspector) GetServiceContainers(serviceName string, servicesDir string) ([]string, error) {
-	result, err := i.executor.Exec("docker", "compose", "--project-directory", filepath.Join(servicesDir, serviceName), "config", "--services")
-	return strings.Fields(string(result)), err
+	projectDir := filepath.Join(servicesDir, serviceName)
+	i.mu.Lock()
+	services, ok := i.cache.services[projectDir]
+	generation := i.cache.servicesGeneration
+	i.mu.Unlock()
+	if ok {
+		return slices.Clone(services), nil
+	}
+
+	result, err := i.executor.Exec("docker", "compose", "--project-directory", projectDir, "config", "--services")
+	services = strings.Fields(string(result))
+	if err != nil {
+		return services, err
+	}
+	i.mu.Lock()
+	if i.cache.servicesGeneration == generation {
+		i.cache.services[projectDir] = services
+	}
+	i.mu.Unlock()
+	return slices.Clone(services), nil
+}
+
+// Invalidate drops the cached stacks and compose services, it's called after
+// the deployments since they change the compose files
+func (i *inspector) Invalidate() {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.cache.invalidateStacks()
+	i.cache.invalidateServices()
+}
+
+// CheckManagedContainer returns ErrContainerNotManaged when the container doesn't exist
+// or doesn't belong to the stack
+func (i *inspector) CheckManagedContainer(ctx context.Context, servicesDir, stack, containerID string) error {
+	inspect, err := i.inspectContainer(ctx, containerID)
+	if err != nil {
+		return err
+	}
+	if stack == "" || getServiceNameFromLabel(inspect, servicesDir) != stack {
+		return ErrContainerNotManaged
+	}
+	return nil
+}
+
+// StreamContainerLogs calls onLine for each line of the logs of the container, which is checked
+// by CheckManagedContainer beforehand. With options.Follow it returns when the container stops or ctx is canceled
+func (i *inspector) StreamContainerLogs(ctx context.Context, containerID string,
+	options models.ContainerLogsOptions, onLine func(models.ContainerLogLine),
+) error {
+	inspect, err := i.inspectContainer(ctx, containerID)
+	if err != nil {
+		return err
+	}
+
+	logsOptions := client.ContainerLogsOptions{
+		ShowStdout: true,
+		ShowStderr: true,
+		Timestamps: true,
+		Follow:     options.Follow,
+		Tail:       options.Tail,
+	}
+	if !options.Since.IsZero() {
+		logsOptions.Since = fmt.Sprintf("%d.%09d", options.Since.Unix(), options.Since.Nanosecond())
+	}
+	logs, err := i.dockerClient.ContainerLogs(ctx, containerID, logsOptions)
+	if err != nil {
+		return fmt.Errorf("failed to read container logs: %w", err)
+	}
+	defer logs.Close()
+
+	if inspect.Container.Config.Tty {
+		// the output of a tty isn't multiplexed, everything is printed on stdout
+		err = scanLogLines(logs, models.LogStreamStdout, onLine)
+	} else {
+		stdout := &logLineWriter{stream: models.LogStreamStdout, onLine: onLine}
+		stderr := &logLineWriter{stream: models.LogStreamStderr, onLine: onLine}
+		_, err = stdcopy.StdCopy(stdout, stderr, logs)
+		stdout.flush()
+		stderr.flush()
+	}
+	if err != nil && ctx.Err() == nil {
+		return fmt.Errorf("failed to read container logs: %w", err)
+	}
+	return nil
+}
+
+func (i *inspector) inspectContainer(ctx context.Context, containerID string) (client.ContainerInspectResult, error) {
+	inspect, err := i.dockerClient.ContainerInspect(ctx, containerID, client.ContainerInspectOptions{})
+	if cerrdefs.IsNotFound(err) {
+		return inspect, ErrContainerNotManaged
+	}
+	if err != nil {
+		return inspect, fmt.Errorf("failed to inspect container: %w", err)
+	}
+	return inspect, nil
+}
+
+func scanLogLines(r io.Reader, stream models.LogStream, onLine func(models.ContainerLogLine)) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		onLine(parseLogLine(stream, scanner.Text()))
+	}
+	return scanner.Err()
+}
+
+// logLineWriter splits the demultiplexed output in lines
+type logLineWriter struct {
+	stream models.LogStream
+	onLine func(models.ContainerLogLine)
+	buf    []byte
+}
+
+func (w *logLineWriter) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+	for {
+		end := bytes.IndexByte(w.buf, '\n')
+		if end < 0 {
+			return len(p), nil
+		}
+		w.onLine(parseLogLine(w.stream, string(bytes.TrimSuffix(w.buf[:end], []byte("\r")))))
+		w.buf = w.buf[end+1:]
+	}
+}
+
+// flush sends the last line when it doesn't end with a new line
+func (w *logLineWriter) flush() {
+	if len(w.buf) > 0 {
+		w.onLine(parseLogLine(w.stream, string(w.buf)))
+		w.buf = nil
+	}
+}
+
+// parseLogLine splits the timestamp added by docker from the line
+func parseLogLine(stream models.LogStream, line string) models.ContainerLogLine {
+	logLine := models.ContainerLogLine{Stream: stream, Line: line}
+	if timestamp, rest, found := strings.Cut(line, " "); found {
+		if t, err := time.Parse(time.RFC3339Nano, timestamp); err == nil {
+			logLine.Time = t
+			logLine.Line = rest
+		}
+	}
+	return logLine
+}
+
+// ReadContainerStats reads a single sample of the resources used by the container
+func (i *inspector) ReadContainerStats(ctx context.Context, containerID string) (container.StatsResponse, error) {
+	var stats container.StatsResponse
+	result, err := i.dockerClient.ContainerStats(ctx, containerID, client.ContainerStatsOptions{})
+	if err != nil {
+		return stats, fmt.Errorf("failed to read container stats: %w", err)
+	}
+	defer result.Body.Close()
+	if err := json.NewDecoder(result.Body).Decode(&stats); err != nil {
+		return stats, fmt.Errorf("failed to decode container stats: %w", err)
+	}
+	return stats, nil
 }
@@ -0,0 +1,186 @@
+package docker
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+	"time"
+
+	"omar-kada/autonas/models"
+
+	"github.com/moby/moby/api/types/events"
+	"github.com/moby/moby/client"
+)
+
+const (
+	// the cached stacks are listed again after this delay, in case an event was missed
+	stacksCacheMaxAge = 5 * time.Minute
+	// delays before subscribing again to the docker events
+	watchMinBackoff = time.Second
+	watchMaxBackoff = time.Minute
+)
+
+// watchedActions are the container events that change the state of the stacks,
+// the exec events sent by the healthchecks are left out
+var watchedActions = []events.Action{
+	events.ActionCreate,
+	events.ActionStart,
+	events.ActionRestart,
+	events.ActionStop,
+	events.ActionDie,
+	events.ActionKill,
+	events.ActionPause,
+	events.ActionUnPause,
+	events.ActionRename,
+	events.ActionDestroy,
+	events.ActionHealthStatus,
+}
+
+// inspectorCache holds the state read from docker, it's guarded by the mutex of the inspector.
+// The stacks and start times are only cached while the docker events are watched,
+// the generations tell the readers whether the cache was invalidated during their call
+type inspectorCache struct {
+	watching   bool
+	generation uint64
+	stacks     map[string][]models.ContainerSummary
+	stacksDir  string
+	stacksTime time.Time
+	startedAt  map[string]time.Time
+
+	servicesGeneration uint64
+	services           map[string][]string
+}
+
+func newInspectorCache() inspectorCache {
+	return inspectorCache{
+		startedAt: make(map[string]time.Time),
+		services:  make(map[string][]string),
+	}
+}
+
+func (c *inspectorCache) getStacks(servicesDir string) (map[string][]models.ContainerSummary, bool) {
+	if c.stacks == nil || c.stacksDir != servicesDir || time.Since(c.stacksTime) > stacksCacheMaxAge {
+		return nil, false
+	}
+	return cloneStacks(c.stacks), true
+}
+
+func (c *inspectorCache) setStacks(servicesDir string, stacks map[string][]models.ContainerSummary) {
+	if c.watching {
+		c.stacks, c.stacksDir, c.stacksTime = stacks, servicesDir, time.Now()
+	}
+}
+
+func (c *inspectorCache) setStartedAt(containerID string, startedAt time.Time) {
+	if c.watching {
+		c.startedAt[containerID] = startedAt
+	}
+}
+
+// keepStartedAt forgets the start times of the containers that aren't listed anymore
+func (c *inspectorCache) keepStartedAt(listed map[string]bool) {
+	maps.DeleteFunc(c.startedAt, func(id string, _ time.Time) bool {
+		return !listed[id]
+	})
+}
+
+func (c *inspectorCache) invalidateStacks() {
+	c.generation++
+	c.stacks = nil
+}
+
+func (c *inspectorCache) invalidateServices() {
+	c.servicesGeneration++
+	clear(c.services)
+}
+
+func (c *inspectorCache) setWatching(watching bool) {
+	c.watching = watching
+	c.invalidateStacks()
+	if !watching {
+		clear(c.startedAt)
+	}
+}
+
+// handleEvent invalidates the stacks, the start time is read again for the started containers
+func (c *inspectorCache) handleEvent(msg events.Message) {
+	c.invalidateStacks()
+	switch msg.Action {
+	case events.ActionStart, events.ActionRestart, events.ActionDestroy:
+		delete(c.startedAt, msg.Actor.ID)
+	}
+}
+
+func cloneStacks(stacks map[string][]models.ContainerSummary) map[string][]models.ContainerSummary {
+	res := make(map[string][]models.ContainerSummary, len(stacks))
+	for stack, containers := range stacks {
+		res[stack] = slices.Clone(containers)
+	}
+	return res
+}
+
+// Watch subscribes to the events of the compose containers to keep the managed stacks cached,
+// without it every call lists the containers. onEvent, when not nil, is called after each event
+// is applied to the cache. It subscribes again when the stream fails and returns when ctx is done
+func (i *inspector) Watch(ctx context.Context, onEvent func(models.ContainerEvent)) {
+	backoff := watchMinBackoff
+	for {
+		subscribed := time.Now()
+		err := i.watchEvents(ctx, onEvent)
+		if ctx.Err() != nil {
+			return
+		}
+		if time.Since(subscribed) > watchMaxBackoff {
+			backoff = watchMinBackoff
+		}
+		i.log.Warn("docker events stream failed, the stacks aren't cached until it's back",
+			"error", err, "retryIn", backoff)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		backoff = min(2*backoff, watchMaxBackoff)
+	}
+}
+
+func (i *inspector) watchEvents(ctx context.Context, onEvent func(models.ContainerEvent)) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	filters := make(client.Filters).
+		Add("type", string(events.ContainerEventType)).
+		Add("label", workingDirLabel)
+	for _, action := range watchedActions {
+		filters.Add("event", string(action))
+	}
+	res := i.dockerClient.Events(ctx, client.EventsListOptions{Filters: filters})
+
+	i.setWatching(true)
+	defer i.setWatching(false)
+	for {
+		select {
+		case msg := <-res.Messages:
+			i.mu.Lock()
+			i.cache.handleEvent(msg)
+			i.mu.Unlock()
+			if onEvent != nil {
+				onEvent(models.ContainerEvent{ContainerID: msg.Actor.ID, Action: string(msg.Action)})
+			}
+		case err := <-res.Err:
+			if err == nil {
+				err = errors.New("events stream closed")
+			}
+			return err
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (i *inspector) setWatching(watching bool) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.cache.setWatching(watching)
+}
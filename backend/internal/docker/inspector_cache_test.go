@@ -0,0 +1,162 @@
+package docker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/moby/moby/api/types/container"
+	"github.com/moby/moby/api/types/events"
+	"github.com/moby/moby/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func listedContainer(id, workingDir string) container.Summary {
+	return container.Summary{
+		ID:     id,
+		State:  "running",
+		Labels: map[string]string{workingDirLabel: workingDir, "com.docker.compose.service": id},
+	}
+}
+
+func startedContainer(startedAt string) client.ContainerInspectResult {
+	return client.ContainerInspectResult{
+		Container: container.InspectResponse{State: &container.State{StartedAt: startedAt}},
+	}
+}
+
+// watchedInspector returns an inspector subscribed to the returned events channel
+func watchedInspector(t *testing.T, mockClient *MockClient) (*inspector, chan events.Message, context.CancelFunc) {
+	messages := make(chan events.Message)
+	mockClient.On("Events", mock.Anything, mock.Anything).Return(client.EventsResult{
+		Messages: messages,
+		Err:      make(chan error),
+	})
+	inspector := newInspectorWithMock(mockClient, new(MockExec))
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		inspector.Watch(ctx, nil)
+		close(done)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+	assert.Eventually(t, func() bool { return inspector.isWatching() }, time.Second, time.Millisecond)
+	return inspector, messages, cancel
+}
+
+func (i *inspector) isWatching() bool {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	return i.cache.watching
+}
+
+// sendEvent returns once the event is handled
+func sendEvent(t *testing.T, inspector *inspector, messages chan<- events.Message, action events.Action) {
+	inspector.mu.Lock()
+	generation := inspector.cache.generation
+	inspector.mu.Unlock()
+	messages <- events.Message{Type: events.ContainerEventType, Action: action, Actor: events.Actor{ID: "web"}}
+	assert.Eventually(t, func() bool {
+		inspector.mu.Lock()
+		defer inspector.mu.Unlock()
+		return inspector.cache.generation != generation
+	}, time.Second, time.Millisecond)
+}
+
+func TestGetManagedStacks_CachedWhileWatching(t *testing.T) {
+	mockClient := new(MockClient)
+	mockClient.On("ContainerList", mock.Anything, mock.Anything).Return(client.ContainerListResult{
+		Items: []container.Summary{listedContainer("web", "/services/web")},
+	}, nil)
+	mockClient.On("ContainerInspect", mock.Anything, "web", mock.Anything).
+		Return(startedContainer("2006-01-02T15:04:05Z"), nil)
+	inspector, messages, cancel := watchedInspector(t, mockClient)
+
+	first, err := inspector.GetManagedStacks("/services")
+	assert.NoError(t, err)
+	first["web"][0].State = "modified"
+	second, err := inspector.GetManagedStacks("/services")
+	assert.NoError(t, err)
+	assert.Equal(t, "running", string(second["web"][0].State))
+	mockClient.AssertNumberOfCalls(t, "ContainerList", 1)
+	mockClient.AssertNumberOfCalls(t, "ContainerInspect", 1)
+
+	// a stop invalidates the listing, the start time is kept
+	sendEvent(t, inspector, messages, events.ActionStop)
+	_, err = inspector.GetManagedStacks("/services")
+	assert.NoError(t, err)
+	mockClient.AssertNumberOfCalls(t, "ContainerList", 2)
+	mockClient.AssertNumberOfCalls(t, "ContainerInspect", 1)
+
+	// a start invalidates the start time too
+	sendEvent(t, inspector, messages, events.ActionStart)
+	_, err = inspector.GetManagedStacks("/services")
+	assert.NoError(t, err)
+	mockClient.AssertNumberOfCalls(t, "ContainerList", 3)
+	mockClient.AssertNumberOfCalls(t, "ContainerInspect", 2)
+
+	// nothing is cached once the subscription is gone
+	cancel()
+	assert.Eventually(t, func() bool { return !inspector.isWatching() }, time.Second, time.Millisecond)
+	_, _ = inspector.GetManagedStacks("/services")
+	_, _ = inspector.GetManagedStacks("/services")
+	mockClient.AssertNumberOfCalls(t, "ContainerList", 5)
+	mockClient.AssertNumberOfCalls(t, "ContainerInspect", 4)
+}
+
+func TestGetManagedStacks_Invalidate(t *testing.T) {
+	mockClient := new(MockClient)
+	mockClient.On("ContainerList", mock.Anything, mock.Anything).Return(client.ContainerListResult{
+		Items: []container.Summary{listedContainer("web", "/services/web")},
+	}, nil)
+	mockClient.On("ContainerInspect", mock.Anything, "web", mock.Anything).
+		Return(startedContainer("2006-01-02T15:04:05Z"), nil)
+	inspector, _, _ := watchedInspector(t, mockClient)
+
+	_, _ = inspector.GetManagedStacks("/services")
+	inspector.Invalidate()
+	_, _ = inspector.GetManagedStacks("/services")
+	mockClient.AssertNumberOfCalls(t, "ContainerList", 2)
+	mockClient.AssertNumberOfCalls(t, "ContainerInspect", 1)
+}
+
+func TestGetServiceContainers_Cached(t *testing.T) {
+	mockExec := new(MockExec)
+	mockExec.On("Exec", "docker", []string{"compose", "--project-directory", "/services/web", "config", "--services"}).
+		Return([]byte("web db"), nil)
+	inspector := newInspectorWithMock(new(MockClient), mockExec)
+
+	services, err := inspector.GetServiceContainers("web", "/services")
+	assert.NoError(t, err)
+	services[0] = "modified"
+	services, err = inspector.GetServiceContainers("web", "/services")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"web", "db"}, services)
+	mockExec.AssertNumberOfCalls(t, "Exec", 1)
+
+	inspector.Invalidate()
+	_, _ = inspector.GetServiceContainers("web", "/services")
+	mockExec.AssertNumberOfCalls(t, "Exec", 2)
+}
+
+func TestSummaryHealth(t *testing.T) {
+	testCases := []struct {
+		summary  container.Summary
+		expected container.HealthStatus
+	}{
+		{container.Summary{Health: &container.HealthSummary{Status: container.Unhealthy}, Status: "Up (healthy)"}, container.Unhealthy},
+		{container.Summary{Status: "Up 2 hours (healthy)"}, container.Healthy},
+		{container.Summary{Status: "Up 2 hours (unhealthy)"}, container.Unhealthy},
+		{container.Summary{Status: "Up 3 seconds (health: starting)"}, container.Starting},
+		{container.Summary{Status: "Up 2 hours"}, container.NoHealthcheck},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.summary.Status, func(t *testing.T) {
+			assert.Equal(t, tc.expected, summaryHealth(tc.summary))
+		})
+	}
+}
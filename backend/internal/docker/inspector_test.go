@@ -1,13 +1,18 @@
 package docker
 
 import (
+	"bytes"
 	"context"
+	"encoding/binary"
 	"errors"
-	"log/slog"
+	"io"
 	"testing"
+	"time"
 
 	"omar-kada/autonas/internal/shell"
+	"omar-kada/autonas/models"
 
+	cerrdefs "github.com/containerd/errdefs"
 	"github.com/moby/moby/api/types/container"
 	"github.com/moby/moby/client"
 	"github.com/stretchr/testify/assert"
@@ -29,6 +34,21 @@ func (m *MockClient) ContainerInspect(ctx context.Context, containerID string, o
 	return args.Get(0).(client.ContainerInspectResult), args.Error(1)
 }
 
+func (m *MockClient) ContainerLogs(ctx context.Context, containerID string, options client.ContainerLogsOptions) (client.ContainerLogsResult, error) {
+	args := m.Called(ctx, containerID, options)
+	return args.Get(0).(client.ContainerLogsResult), args.Error(1)
+}
+
+func (m *MockClient) ContainerStats(ctx context.Context, containerID string, options client.ContainerStatsOptions) (client.ContainerStatsResult, error) {
+	args := m.Called(ctx, containerID, options)
+	return args.Get(0).(client.ContainerStatsResult), args.Error(1)
+}
+
+func (m *MockClient) Events(ctx context.Context, options client.EventsListOptions) client.EventsResult {
+	args := m.Called(ctx, options)
+	return args.Get(0).(client.EventsResult)
+}
+
 type MockExec struct {
 	mock.Mock
 }
@@ -38,12 +58,13 @@ func (m *MockExec) Exec(cmd string, cmdArgs ...string) ([]byte, error) {
 	return args.Get(0).([]byte), args.Error(1)
 }
 
+func (m *MockExec) Stream(_ func(stream models.LogStream, line string), cmd string, cmdArgs ...string) error {
+	args := m.Called(cmd, cmdArgs)
+	return args.Error(0)
+}
+
 func newInspectorWithMock(client Client, mockExec shell.Executor) *inspector {
-	return &inspector{
-		log:          slog.Default(),
-		dockerClient: client,
-		executor:     mockExec,
-	}
+	return newInspector(client, mockExec)
 }
 
 func TestGetManagedStacks(t *testing.T) {
@@ -58,7 +79,8 @@ func TestGetManagedStacks(t *testing.T) {
 				Names:  []string{"/container1"},
 				Image:  "image1",
 				State:  "running",
-				Status: "Up 1 hour",
+				Status: "Up 1 hour (healthy)",
+				Labels: map[string]string{"com.docker.compose.project.working_dir": "/services/service1"},
 			},
 			{
 				ID:     "container2",
@@ -66,6 +88,7 @@ func TestGetManagedStacks(t *testing.T) {
 				Image:  "image2",
 				State:  "exited",
 				Status: "Exited (0) 2 hours ago",
+				Labels: map[string]string{"com.docker.compose.project.working_dir": "/services/service2"},
 			},
 		},
 	}, nil)
@@ -109,6 +132,7 @@ func TestGetManagedStacks(t *testing.T) {
 	assert.Len(t, result, 1)
 	assert.Contains(t, result, "service1")
 	assert.Len(t, result["service1"], 1)
+	assert.Equal(t, container.Healthy, result["service1"][0].Health)
 
 	// Test error case
 	mockClient.On("ContainerList", mock.Anything, mock.Anything).Once().Return(client.ContainerListResult{}, errors.New("failed to list containers"))
@@ -182,3 +206,106 @@ func TestGetServiceContainers(t *testing.T) {
 	assert.Error(t, err)
 	assert.ErrorContains(t, err, "failed to get services")
 }
+
+// multiplexed writes the frames of a container logs stream without tty
+func multiplexed(frames ...string) io.ReadCloser {
+	var buf bytes.Buffer
+	for i := 0; i < len(frames); i += 2 {
+		header := make([]byte, 8)
+		header[0] = 1
+		if frames[i] == "stderr" {
+			header[0] = 2
+		}
+		binary.BigEndian.PutUint32(header[4:], uint32(len(frames[i+1])))
+		buf.Write(header)
+		buf.WriteString(frames[i+1])
+	}
+	return io.NopCloser(&buf)
+}
+
+func managedContainer(workingDir string, tty bool) client.ContainerInspectResult {
+	return client.ContainerInspectResult{
+		Container: container.InspectResponse{
+			Config: &container.Config{
+				Labels: map[string]string{"com.docker.compose.project.working_dir": workingDir},
+				Tty:    tty,
+			},
+		},
+	}
+}
+
+func TestStreamContainerLogs(t *testing.T) {
+	mockClient := new(MockClient)
+	since := time.Date(2025, 3, 10, 10, 0, 0, 0, time.UTC)
+	mockClient.On("ContainerInspect", mock.Anything, "web1", mock.Anything).Return(managedContainer("/services/web", false), nil)
+	mockClient.On("ContainerLogs", mock.Anything, "web1", client.ContainerLogsOptions{
+		ShowStdout: true, ShowStderr: true, Timestamps: true, Tail: "10", Since: "1741600800.000000000",
+	}).Return(multiplexed(
+		"stdout", "2025-03-10T10:00:01.5Z starting\n2025-03-10T10:00:02Z lis",
+		"stderr", "2025-03-10T10:00:03Z warning\n",
+		"stdout", "tening\n",
+	), nil)
+
+	var lines []models.ContainerLogLine
+	err := newInspectorWithMock(mockClient, nil).StreamContainerLogs(context.Background(), "web1",
+		models.ContainerLogsOptions{Tail: "10", Since: since}, func(line models.ContainerLogLine) {
+			lines = append(lines, line)
+		})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []models.ContainerLogLine{
+		{Time: time.Date(2025, 3, 10, 10, 0, 1, 500000000, time.UTC), Stream: models.LogStreamStdout, Line: "starting"},
+		{Time: time.Date(2025, 3, 10, 10, 0, 3, 0, time.UTC), Stream: models.LogStreamStderr, Line: "warning"},
+		{Time: time.Date(2025, 3, 10, 10, 0, 2, 0, time.UTC), Stream: models.LogStreamStdout, Line: "listening"},
+	}, lines)
+}
+
+func TestStreamContainerLogs_Tty(t *testing.T) {
+	mockClient := new(MockClient)
+	mockClient.On("ContainerInspect", mock.Anything, "web1", mock.Anything).Return(managedContainer("/services/web", true), nil)
+	mockClient.On("ContainerLogs", mock.Anything, "web1", mock.Anything).
+		Return(io.NopCloser(bytes.NewBufferString("2025-03-10T10:00:01Z one\r\nno timestamp\n")), nil)
+
+	var lines []string
+	err := newInspectorWithMock(mockClient, nil).StreamContainerLogs(context.Background(), "web1",
+		models.ContainerLogsOptions{}, func(line models.ContainerLogLine) {
+			assert.Equal(t, models.LogStreamStdout, line.Stream)
+			lines = append(lines, line.Line)
+		})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"one", "no timestamp"}, lines)
+}
+
+func TestCheckManagedContainer(t *testing.T) {
+	mockClient := new(MockClient)
+	mockClient.On("ContainerInspect", mock.Anything, "other", mock.Anything).Return(managedContainer("/opt/other", false), nil)
+	mockClient.On("ContainerInspect", mock.Anything, "db1", mock.Anything).Return(managedContainer("/services/db", false), nil)
+	mockClient.On("ContainerInspect", mock.Anything, "missing", mock.Anything).
+		Return(client.ContainerInspectResult{}, cerrdefs.ErrNotFound)
+	mockClient.On("ContainerInspect", mock.Anything, "web1", mock.Anything).Return(managedContainer("/services/web", false), nil)
+	inspector := newInspectorWithMock(mockClient, nil)
+
+	assert.NoError(t, inspector.CheckManagedContainer(context.Background(), "/services", "web", "web1"))
+	for _, id := range []string{"other", "db1", "missing"} {
+		err := inspector.CheckManagedContainer(context.Background(), "/services", "web", id)
+		assert.ErrorIs(t, err, ErrContainerNotManaged, id)
+	}
+}
+
+func TestReadContainerStats(t *testing.T) {
+	mockClient := new(MockClient)
+	mockClient.On("ContainerStats", mock.Anything, "web1", client.ContainerStatsOptions{}).Once().Return(client.ContainerStatsResult{
+		Body: io.NopCloser(bytes.NewBufferString(`{"id":"web1","memory_stats":{"usage":100,"limit":1000},"cpu_stats":{"online_cpus":2}}`)),
+	}, nil)
+	mockClient.On("ContainerStats", mock.Anything, "web1", mock.Anything).Once().Return(client.ContainerStatsResult{}, errors.New("not running"))
+	inspector := newInspectorWithMock(mockClient, nil)
+
+	stats, err := inspector.ReadContainerStats(context.Background(), "web1")
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(100), stats.MemoryStats.Usage)
+	assert.Equal(t, uint32(2), stats.CPUStats.OnlineCPUs)
+
+	_, err = inspector.ReadContainerStats(context.Background(), "web1")
+	assert.ErrorContains(t, err, "not running")
+}
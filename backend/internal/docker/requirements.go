@@ -0,0 +1,211 @@
+package docker
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"omar-kada/autonas/models"
+
+	"go.yaml.in/yaml/v3"
+)
+
+// composeFileNames lists the default compose file names, in the order docker compose looks them up
+var composeFileNames = []string{"compose.yaml", "compose.yml", "docker-compose.yaml", "docker-compose.yml"}
+
+// composeVariable is a variable reference found in a compose file
+type composeVariable struct {
+	name       string
+	hasDefault bool
+	required   bool
+	message    string
+}
+
+// MissingVariables returns the variables referenced by the compose file of the service
+// that are neither defined in the service's .env file nor in the configuration.
+// Services without a compose file have no requirements.
+func MissingVariables(cfg models.Config, servicesDir, service string) ([]models.VariableRequirement, error) {
+	serviceDir := filepath.Join(servicesDir, service)
+	composeFile, err := findComposeFile(serviceDir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	variables, err := parseComposeVariables(composeFile)
+	if err != nil {
+		return nil, err
+	}
+
+	env, err := readEnvFile(filepath.Join(serviceDir, ".env"))
+	if err != nil {
+		return nil, err
+	}
+	serviceKeys := cfg.ServiceKeys(service)
+
+	missing := []models.VariableRequirement{}
+	for _, variable := range variables {
+		if variable.hasDefault || slices.Contains(serviceKeys, variable.name) || env.has(variable.name) {
+			continue
+		}
+		missing = append(missing, models.VariableRequirement{
+			Name:     variable.name,
+			Required: variable.required,
+			Message:  variable.message,
+		})
+	}
+	return missing, nil
+}
+
+func findComposeFile(serviceDir string) (string, error) {
+	for _, name := range composeFileNames {
+		path := filepath.Join(serviceDir, name)
+		if _, err := os.Stat(path); err == nil {
+			return path, nil
+		} else if !errors.Is(err, os.ErrNotExist) {
+			return "", err
+		}
+	}
+	return "", fmt.Errorf("no compose file found in %s : %w", serviceDir, os.ErrNotExist)
+}
+
+// parseComposeVariables returns the variables referenced in the values of the compose file,
+// in order of first appearance
+func parseComposeVariables(composeFile string) ([]composeVariable, error) {
+	content, err := os.ReadFile(composeFile)
+	if err != nil {
+		return nil, err
+	}
+	var root yaml.Node
+	if err := yaml.Unmarshal(content, &root); err != nil {
+		return nil, fmt.Errorf("invalid compose file %s : %w", composeFile, err)
+	}
+
+	var variables []composeVariable
+	indexes := make(map[string]int)
+	for _, value := range scalarValues(&root) {
+		for _, variable := range extractVariables(value) {
+			index, exists := indexes[variable.name]
+			if !exists {
+				indexes[variable.name] = len(variables)
+				variables = append(variables, variable)
+				continue
+			}
+			// a variable is only optional if every reference to it has a default value
+			existing := &variables[index]
+			existing.hasDefault = existing.hasDefault && variable.hasDefault
+			if variable.required && !existing.required {
+				existing.required = true
+				existing.message = variable.message
+			}
+		}
+	}
+	return variables, nil
+}
+
+// scalarValues returns all the scalar values of the document, mapping keys excluded
+func scalarValues(node *yaml.Node) []string {
+	switch node.Kind {
+	case yaml.ScalarNode:
+		return []string{node.Value}
+	case yaml.MappingNode:
+		var values []string
+		for i := 1; i < len(node.Content); i += 2 {
+			values = append(values, scalarValues(node.Content[i])...)
+		}
+		return values
+	case yaml.DocumentNode, yaml.SequenceNode:
+		var values []string
+		for _, child := range node.Content {
+			values = append(values, scalarValues(child)...)
+		}
+		return values
+	}
+	return nil
+}
+
+// extractVariables parses $VAR, ${VAR}, ${VAR:-default}, ${VAR-default},
+// ${VAR:+alt}, ${VAR+alt}, ${VAR:?err} and ${VAR?err} references; $$ is an escaped $
+func extractVariables(value string) []composeVariable {
+	var variables []composeVariable
+	for i := 0; i < len(value); i++ {
+		if value[i] != '$' || i+1 >= len(value) {
+			continue
+		}
+		next := value[i+1]
+		switch {
+		case next == '$':
+			i++
+		case next == '{':
+			end := closingBrace(value, i+2)
+			if end < 0 {
+				return variables
+			}
+			if variable, ok := parseBracedVariable(value[i+2 : end]); ok {
+				variables = append(variables, variable)
+			}
+			i = end
+		case isNameStart(next):
+			end := i + 1
+			for end < len(value) && isNameChar(value[end]) {
+				end++
+			}
+			variables = append(variables, composeVariable{name: value[i+1 : end]})
+			i = end - 1
+		}
+	}
+	return variables
+}
+
+// closingBrace returns the index of the brace closing the expression starting at start,
+// taking nested expressions like ${A:-${B}} into account
+func closingBrace(value string, start int) int {
+	depth := 1
+	for i := start; i < len(value); i++ {
+		switch value[i] {
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+func parseBracedVariable(expr string) (composeVariable, bool) {
+	end := 0
+	for end < len(expr) && isNameChar(expr[end]) {
+		end++
+	}
+	if end == 0 || !isNameStart(expr[0]) {
+		return composeVariable{}, false
+	}
+	variable := composeVariable{name: expr[:end]}
+	modifier := strings.TrimPrefix(expr[end:], ":")
+	if modifier == "" {
+		return variable, true
+	}
+	switch modifier[0] {
+	case '-', '+':
+		variable.hasDefault = true
+	case '?':
+		variable.required = true
+		variable.message = modifier[1:]
+	}
+	return variable, true
+}
+
+func isNameStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isNameChar(c byte) bool {
+	return isNameStart(c) || (c >= '0' && c <= '9')
+}
@@ -0,0 +1,114 @@
+package docker
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"omar-kada/autonas/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtractVariables(t *testing.T) {
+	testCases := []struct {
+		name  string
+		value string
+		want  []composeVariable
+	}{
+		{
+			name:  "braced and unbraced",
+			value: "${HOST}:$PORT",
+			want:  []composeVariable{{name: "HOST"}, {name: "PORT"}},
+		},
+		{
+			name:  "defaults",
+			value: "${A:-x} ${B-y} ${C:+z} ${D+w}",
+			want: []composeVariable{
+				{name: "A", hasDefault: true},
+				{name: "B", hasDefault: true},
+				{name: "C", hasDefault: true},
+				{name: "D", hasDefault: true},
+			},
+		},
+		{
+			name:  "required with message",
+			value: "${DB_PASSWORD:?db password is required} ${TOKEN?}",
+			want: []composeVariable{
+				{name: "DB_PASSWORD", required: true, message: "db password is required"},
+				{name: "TOKEN", required: true},
+			},
+		},
+		{
+			name:  "escaped dollar",
+			value: "$$HOME $${NOT_A_VAR}",
+			want:  nil,
+		},
+		{
+			name:  "nested default",
+			value: "${DATA:-${ROOT}/data}/db",
+			want:  []composeVariable{{name: "DATA", hasDefault: true}},
+		},
+		{
+			name:  "unterminated",
+			value: "${DATA",
+			want:  nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, extractVariables(tc.value))
+		})
+	}
+}
+
+func TestMissingVariables(t *testing.T) {
+	servicesDir := t.TempDir()
+	serviceDir := filepath.Join(servicesDir, "svc1")
+	assert.NoError(t, os.Mkdir(serviceDir, 0o750))
+	assert.NoError(t, os.WriteFile(filepath.Join(serviceDir, "compose.yaml"), []byte(`
+# ${COMMENTED} is ignored
+services:
+  app:
+    image: app:${VERSION:-latest}
+    ports:
+      - "${PORT}:80"
+    environment:
+      DB_PASSWORD: ${DB_PASSWORD:?db password is required}
+      HOST: ${AUTONAS_HOST}
+      FROM_ENV: $FROM_ENV_FILE
+      OPTIONAL: ${DB_PASSWORD}
+`), 0o600))
+	assert.NoError(t, os.WriteFile(filepath.Join(serviceDir, ".env"), []byte("FROM_ENV_FILE=1\n"), 0o600))
+
+	cfg := models.Config{
+		Environment: models.Environment{"AUTONAS_HOST": "localhost"},
+		Services:    map[string]models.ServiceConfig{"svc1": {}},
+	}
+	missing, err := MissingVariables(cfg, servicesDir, "svc1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []models.VariableRequirement{
+		{Name: "PORT"},
+		{Name: "DB_PASSWORD", Required: true, Message: "db password is required"},
+	}, missing)
+}
+
+func TestMissingVariables_NoComposeFile(t *testing.T) {
+	missing, err := MissingVariables(models.Config{}, t.TempDir(), "svc1")
+
+	assert.NoError(t, err)
+	assert.Empty(t, missing)
+}
+
+func TestMissingVariables_InvalidComposeFile(t *testing.T) {
+	servicesDir := t.TempDir()
+	serviceDir := filepath.Join(servicesDir, "svc1")
+	assert.NoError(t, os.Mkdir(serviceDir, 0o750))
+	assert.NoError(t, os.WriteFile(filepath.Join(serviceDir, "docker-compose.yml"), []byte("services: [\n"), 0o600))
+
+	_, err := MissingVariables(models.Config{}, servicesDir, "svc1")
+
+	assert.ErrorContains(t, err, "invalid compose file")
+}
@@ -0,0 +1,172 @@
+package docker
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"omar-kada/autonas/models"
+
+	"github.com/moby/moby/api/types/container"
+)
+
+// StatsCollector samples the resources used by the running containers of the managed stacks,
+// it keeps the last sample of each container and the history of each stack
+type StatsCollector struct {
+	inspector   Inspector
+	servicesDir string
+	historySize int
+
+	mu         sync.RWMutex
+	previous   map[string]container.CPUStats
+	containers map[string]models.ResourceUsage
+	history    map[string][]models.ResourceUsage
+}
+
+// NewStatsCollector creates a collector of the stacks of servicesDir, historySize samples are
+// kept for each stack
+func NewStatsCollector(inspector Inspector, servicesDir string, historySize int) *StatsCollector {
+	return &StatsCollector{
+		inspector:   inspector,
+		servicesDir: servicesDir,
+		historySize: historySize,
+		previous:    make(map[string]container.CPUStats),
+		containers:  make(map[string]models.ResourceUsage),
+		history:     make(map[string][]models.ResourceUsage),
+	}
+}
+
+// Run samples the containers every interval until ctx is done
+func (c *StatsCollector) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		c.Collect(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Collect samples the running containers, the containers and stacks that are gone are forgotten
+func (c *StatsCollector) Collect(ctx context.Context) {
+	stacks, err := c.inspector.GetManagedStacks(c.servicesDir)
+	if err != nil {
+		slog.Warn("couldn't list the containers to sample", "error", err)
+		return
+	}
+
+	// the maps are replaced by each collect, the previous one can be read without lock
+	c.mu.RLock()
+	lastCPUStats := c.previous
+	c.mu.RUnlock()
+
+	previous := make(map[string]container.CPUStats)
+	containers := make(map[string]models.ResourceUsage)
+	stackUsages := make(map[string]models.ResourceUsage)
+	for stack, summaries := range stacks {
+		stackUsage := models.ResourceUsage{Time: time.Now()}
+		for _, summary := range summaries {
+			if summary.State != container.StateRunning {
+				continue
+			}
+			stats, err := c.inspector.ReadContainerStats(ctx, summary.ID)
+			if err != nil {
+				slog.Debug("couldn't sample container", "container", summary.ID, "error", err)
+				continue
+			}
+			usage := usageFromStats(stats, lastCPUStats[summary.ID])
+			previous[summary.ID] = stats.CPUStats
+			containers[summary.ID] = usage
+			stackUsage = stackUsage.Add(usage)
+		}
+		stackUsages[stack] = stackUsage
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.previous = previous
+	c.containers = containers
+	for stack := range c.history {
+		if _, found := stackUsages[stack]; !found {
+			delete(c.history, stack)
+		}
+	}
+	for stack, usage := range stackUsages {
+		history := append(c.history[stack], usage)
+		if len(history) > c.historySize {
+			history = slices.Clone(history[len(history)-c.historySize:])
+		}
+		c.history[stack] = history
+	}
+}
+
+// ContainerUsage returns the last sample of a container
+func (c *StatsCollector) ContainerUsage(containerID string) (models.ResourceUsage, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	usage, found := c.containers[containerID]
+	return usage, found
+}
+
+// StackHistory returns the samples of a stack, the oldest first
+func (c *StatsCollector) StackHistory(stack string) []models.ResourceUsage {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return slices.Clone(c.history[stack])
+}
+
+// usageFromStats computes the usage the same way as docker stats, the CPU is measured
+// since the previous sample of the container
+func usageFromStats(stats container.StatsResponse, previous container.CPUStats) models.ResourceUsage {
+	usage := models.ResourceUsage{
+		Time:        stats.Read,
+		MemoryUsage: memoryUsage(stats.MemoryStats),
+		MemoryLimit: stats.MemoryStats.Limit,
+	}
+	if usage.Time.IsZero() {
+		usage.Time = time.Now()
+	}
+
+	cpuDelta := float64(stats.CPUStats.CPUUsage.TotalUsage) - float64(previous.CPUUsage.TotalUsage)
+	systemDelta := float64(stats.CPUStats.SystemUsage) - float64(previous.SystemUsage)
+	onlineCPUs := float64(stats.CPUStats.OnlineCPUs)
+	if onlineCPUs == 0 {
+		onlineCPUs = float64(len(stats.CPUStats.CPUUsage.PercpuUsage))
+	}
+	if previous.SystemUsage > 0 && cpuDelta > 0 && systemDelta > 0 {
+		usage.CPUPercent = cpuDelta / systemDelta * onlineCPUs * 100
+	}
+
+	for _, network := range stats.Networks {
+		usage.NetworkRx += network.RxBytes
+		usage.NetworkTx += network.TxBytes
+	}
+	for _, entry := range stats.BlkioStats.IoServiceBytesRecursive {
+		switch strings.ToLower(entry.Op) {
+		case "read":
+			usage.BlockRead += entry.Value
+		case "write":
+			usage.BlockWrite += entry.Value
+		}
+	}
+	return usage
+}
+
+// memoryUsage excludes the page cache, as docker stats
+func memoryUsage(stats container.MemoryStats) uint64 {
+	// cgroup v1
+	if inactive, found := stats.Stats["total_inactive_file"]; found && inactive < stats.Usage {
+		return stats.Usage - inactive
+	}
+	// cgroup v2
+	if inactive, found := stats.Stats["inactive_file"]; found && inactive < stats.Usage {
+		return stats.Usage - inactive
+	}
+	return stats.Usage
+}
@@ -0,0 +1,111 @@
+package docker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"omar-kada/autonas/models"
+
+	"github.com/moby/moby/api/types/container"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeStatsInspector struct {
+	Inspector
+	stacks map[string][]models.ContainerSummary
+	stats  map[string]container.StatsResponse
+}
+
+func (i *fakeStatsInspector) GetManagedStacks(_ string) (map[string][]models.ContainerSummary, error) {
+	return i.stacks, nil
+}
+
+func (i *fakeStatsInspector) ReadContainerStats(_ context.Context, containerID string) (container.StatsResponse, error) {
+	stats, found := i.stats[containerID]
+	if !found {
+		return stats, errors.New("no such container")
+	}
+	return stats, nil
+}
+
+func cpuStats(total, system uint64) container.CPUStats {
+	return container.CPUStats{CPUUsage: container.CPUUsage{TotalUsage: total}, SystemUsage: system, OnlineCPUs: 4}
+}
+
+func TestStatsCollector_Collect(t *testing.T) {
+	inspector := &fakeStatsInspector{
+		stacks: map[string][]models.ContainerSummary{
+			"web": {
+				{ID: "nginx", State: container.StateRunning},
+				{ID: "php", State: container.StateRunning},
+				{ID: "stopped", State: container.StateExited},
+			},
+		},
+		stats: map[string]container.StatsResponse{
+			"nginx": {CPUStats: cpuStats(1000, 10000), MemoryStats: container.MemoryStats{Usage: 100, Limit: 1000}},
+			"php":   {CPUStats: cpuStats(500, 10000), MemoryStats: container.MemoryStats{Usage: 50, Limit: 1000}},
+		},
+	}
+	collector := NewStatsCollector(inspector, "/services", 2)
+
+	collector.Collect(context.Background())
+	usage, found := collector.ContainerUsage("nginx")
+	assert.True(t, found)
+	assert.Zero(t, usage.CPUPercent, "the CPU is measured from the second sample")
+	assert.Equal(t, uint64(100), usage.MemoryUsage)
+	_, found = collector.ContainerUsage("stopped")
+	assert.False(t, found)
+
+	inspector.stats["nginx"] = container.StatsResponse{CPUStats: cpuStats(2000, 20000), MemoryStats: container.MemoryStats{Usage: 120, Limit: 1000}}
+	inspector.stats["php"] = container.StatsResponse{CPUStats: cpuStats(1000, 20000), MemoryStats: container.MemoryStats{Usage: 60, Limit: 1000}}
+	collector.Collect(context.Background())
+	usage, _ = collector.ContainerUsage("nginx")
+	assert.InDelta(t, 40, usage.CPUPercent, 0.001)
+
+	history := collector.StackHistory("web")
+	assert.Len(t, history, 2)
+	assert.Equal(t, uint64(150), history[0].MemoryUsage)
+	assert.Equal(t, uint64(180), history[1].MemoryUsage)
+	assert.Equal(t, uint64(1000), history[1].MemoryLimit)
+	assert.InDelta(t, 60, history[1].CPUPercent, 0.001)
+
+	collector.Collect(context.Background())
+	assert.Len(t, collector.StackHistory("web"), 2, "the history is limited")
+
+	// removed stacks are forgotten
+	inspector.stacks = map[string][]models.ContainerSummary{}
+	collector.Collect(context.Background())
+	assert.Empty(t, collector.StackHistory("web"))
+	_, found = collector.ContainerUsage("nginx")
+	assert.False(t, found)
+}
+
+func TestUsageFromStats(t *testing.T) {
+	read := time.Date(2025, 3, 10, 10, 0, 0, 0, time.UTC)
+	usage := usageFromStats(container.StatsResponse{
+		Read: read,
+		MemoryStats: container.MemoryStats{
+			Usage: 1000, Limit: 4000,
+			Stats: map[string]uint64{"inactive_file": 300},
+		},
+		Networks: map[string]container.NetworkStats{
+			"eth0": {RxBytes: 10, TxBytes: 20},
+			"eth1": {RxBytes: 1, TxBytes: 2},
+		},
+		BlkioStats: container.BlkioStats{IoServiceBytesRecursive: []container.BlkioStatEntry{
+			{Op: "Read", Value: 100}, {Op: "write", Value: 50}, {Op: "read", Value: 5}, {Op: "sync", Value: 7},
+		}},
+	}, container.CPUStats{})
+
+	assert.Equal(t, models.ResourceUsage{
+		Time:        read,
+		MemoryUsage: 700,
+		MemoryLimit: 4000,
+		NetworkRx:   11,
+		NetworkTx:   22,
+		BlockRead:   105,
+		BlockWrite:  50,
+	}, usage)
+}
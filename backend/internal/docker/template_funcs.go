@@ -0,0 +1,100 @@
+package docker
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"strconv"
+
+	"omar-kada/autonas/models"
+)
+
+const (
+	passwordAlphabet      = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+	defaultPasswordLength = 24
+)
+
+// templateFuncs returns the functions usable in configuration values
+func (g EnvGenerator) templateFuncs() models.TemplateFuncs {
+	return models.TemplateFuncs{
+		"hostIP":          hostIP,
+		"randomPassword":  g.randomPassword,
+		models.SecretFunc: g.secret,
+	}
+}
+
+// secret returns the value of the stored secret named by the argument
+func (g EnvGenerator) secret(args ...string) (string, error) {
+	if len(args) != 1 {
+		return "", errors.New("secret(name) : expects 1 argument")
+	}
+	if g.secrets == nil {
+		return "", errors.New("secret() : no secret store")
+	}
+	value, err := g.secrets.GetSecret(args[0])
+	if err != nil {
+		return "", fmt.Errorf("secret() : %s : %w", args[0], err)
+	}
+	return value, nil
+}
+
+// hostIP returns the first non loopback IPv4 address of the host
+func hostIP(_ ...string) (string, error) {
+	addrs, err := net.InterfaceAddrs()
+	if err != nil {
+		return "", err
+	}
+	for _, addr := range addrs {
+		if ipNet, ok := addr.(*net.IPNet); ok && !ipNet.IP.IsLoopback() && ipNet.IP.To4() != nil {
+			return ipNet.IP.String(), nil
+		}
+	}
+	return "", errors.New("hostIP() : no IPv4 address found")
+}
+
+// randomPassword returns the password stored in the secret named by the first argument,
+// it is generated and stored on first use, the second optional argument is its length
+func (g EnvGenerator) randomPassword(args ...string) (string, error) {
+	if len(args) == 0 || len(args) > 2 {
+		return "", errors.New("randomPassword(name, [length]) : expects 1 or 2 arguments")
+	}
+	if g.secrets == nil {
+		return "", errors.New("randomPassword() : no secret store")
+	}
+	length := defaultPasswordLength
+	if len(args) == 2 {
+		var err error
+		if length, err = strconv.Atoi(args[1]); err != nil || length <= 0 {
+			return "", fmt.Errorf("randomPassword() : invalid length %q", args[1])
+		}
+	}
+
+	password, err := g.secrets.GetSecret(args[0])
+	if err == nil {
+		return password, nil
+	} else if !errors.Is(err, models.ErrSecretNotFound) {
+		return "", err
+	}
+	if password, err = generatePassword(length); err != nil {
+		return "", err
+	}
+	if _, err := g.secrets.SetSecret(args[0], password); err != nil {
+		return "", err
+	}
+	return password, nil
+}
+
+func generatePassword(length int) (string, error) {
+	password := make([]byte, length)
+	max := big.NewInt(int64(len(passwordAlphabet)))
+	for i := range password {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		password[i] = passwordAlphabet[n.Int64()]
+	}
+	return string(password), nil
+}
@@ -0,0 +1,49 @@
+package docker
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRandomPassword_GeneratedOnce(t *testing.T) {
+	secrets := secretsMock{}
+	generator := EnvGenerator{secrets: secrets}
+
+	password, err := generator.randomPassword("db")
+	assert.NoError(t, err)
+	assert.Len(t, password, defaultPasswordLength)
+	assert.Equal(t, password, secrets["db"])
+
+	again, err := generator.randomPassword("db", "12")
+	assert.NoError(t, err)
+	assert.Equal(t, password, again)
+
+	short, err := generator.randomPassword("other", "12")
+	assert.NoError(t, err)
+	assert.Len(t, short, 12)
+	assert.Regexp(t, "^[A-Za-z0-9]+$", short)
+}
+
+func TestRandomPassword_Errors(t *testing.T) {
+	generator := EnvGenerator{secrets: secretsMock{}}
+
+	_, err := generator.randomPassword()
+	assert.ErrorContains(t, err, "expects 1 or 2 arguments")
+	_, err = generator.randomPassword("db", "abc")
+	assert.ErrorContains(t, err, "invalid length")
+
+	_, err = EnvGenerator{}.randomPassword("db")
+	assert.ErrorContains(t, err, "no secret store")
+}
+
+func TestHostIP(t *testing.T) {
+	ip, err := hostIP()
+	if err != nil {
+		t.Skipf("no IPv4 address available : %v", err)
+	}
+	parsed := net.ParseIP(ip)
+	assert.NotNil(t, parsed)
+	assert.False(t, parsed.IsLoopback())
+}
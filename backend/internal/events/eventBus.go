@@ -0,0 +1,195 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"omar-kada/autonas/models"
+)
+
+const (
+	// TopicDeployments receives the start and the end of the deployments
+	TopicDeployments = "deployments"
+	// TopicNotifications receives the events sent as notifications
+	TopicNotifications = "notifications"
+	// TopicStacks receives the status changes of the stacks and the watchdog events
+	TopicStacks = "stacks"
+
+	deploymentTopicPrefix = "deployment:"
+)
+
+var deploymentEventTypes = []models.EventType{
+	models.EventDeploymentStarted,
+	models.EventDeploymentSuccess,
+	models.EventDeploymentError,
+}
+
+var stackEventTypes = []models.EventType{
+	models.EventStackStatusChanged,
+	models.EventStackUnhealthy,
+	models.EventStackRecovered,
+}
+
+// DeploymentTopic returns the topic receiving all the events of a deployment
+func DeploymentTopic(deploymentID uint64) string {
+	return deploymentTopicPrefix + strconv.FormatUint(deploymentID, 10)
+}
+
+// ValidateTopic returns an error when the topic isn't known
+func ValidateTopic(topic string) error {
+	switch topic {
+	case TopicDeployments, TopicNotifications, TopicStacks:
+		return nil
+	}
+	if id, ok := strings.CutPrefix(topic, deploymentTopicPrefix); ok {
+		if _, err := strconv.ParseUint(id, 10, 64); err == nil {
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown topic %q", topic)
+}
+
+// BusEvent is an event with the subscribed topics it was published to
+type BusEvent struct {
+	Topics []string
+	Event  models.Event
+}
+
+// Subscription receives the events of its topics, its channel is closed when it's
+// unsubscribed or when it doesn't keep up with the events
+type Subscription struct {
+	events chan BusEvent
+	mu     sync.Mutex
+	topics map[string]bool
+}
+
+// Events returns the channel of the events
+func (s *Subscription) Events() <-chan BusEvent {
+	return s.events
+}
+
+// Subscribe adds topics to the subscription
+func (s *Subscription) Subscribe(topics ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, topic := range topics {
+		s.topics[topic] = true
+	}
+}
+
+// Unsubscribe removes topics from the subscription
+func (s *Subscription) Unsubscribe(topics ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, topic := range topics {
+		delete(s.topics, topic)
+	}
+}
+
+// Topics returns the subscribed topics, sorted
+func (s *Subscription) Topics() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	topics := make([]string, 0, len(s.topics))
+	for topic := range s.topics {
+		topics = append(topics, topic)
+	}
+	slices.Sort(topics)
+	return topics
+}
+
+func (s *Subscription) match(topics []string) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var res []string
+	for _, topic := range topics {
+		if s.topics[topic] {
+			res = append(res, topic)
+		}
+	}
+	return res
+}
+
+// Bus is an event handler publishing the events to the subscriptions of their topics,
+// the notifications are the events flagged by the dispatcher
+type Bus struct {
+	mu            sync.RWMutex
+	subscriptions map[*Subscription]struct{}
+}
+
+// NewBus creates an event bus
+func NewBus() *Bus {
+	return &Bus{
+		subscriptions: make(map[*Subscription]struct{}),
+	}
+}
+
+// Subscribe creates a subscription to topics, buffer is the number of events that can
+// wait to be received before the subscription is dropped
+func (b *Bus) Subscribe(buffer int, topics ...string) *Subscription {
+	sub := &Subscription{
+		events: make(chan BusEvent, buffer),
+		topics: make(map[string]bool),
+	}
+	sub.Subscribe(topics...)
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.subscriptions[sub] = struct{}{}
+	return sub
+}
+
+// Unsubscribe removes the subscription and closes its channel
+func (b *Bus) Unsubscribe(sub *Subscription) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if _, ok := b.subscriptions[sub]; ok {
+		delete(b.subscriptions, sub)
+		close(sub.events)
+	}
+}
+
+// HandleEvent publishes the event without waiting for the subscribers, the subscriptions
+// with a full buffer are dropped
+func (b *Bus) HandleEvent(_ context.Context, event models.Event) {
+	topics := b.topicsOf(event)
+	var slow []*Subscription
+	b.mu.RLock()
+	for sub := range b.subscriptions {
+		matched := sub.match(topics)
+		if len(matched) == 0 {
+			continue
+		}
+		select {
+		case sub.events <- BusEvent{Topics: matched, Event: event}:
+		default:
+			slow = append(slow, sub)
+		}
+	}
+	b.mu.RUnlock()
+	for _, sub := range slow {
+		slog.Warn("dropping a slow event subscriber", "topics", sub.Topics())
+		b.Unsubscribe(sub)
+	}
+}
+
+func (b *Bus) topicsOf(event models.Event) []string {
+	var topics []string
+	if event.ObjectID != 0 {
+		topics = append(topics, DeploymentTopic(event.ObjectID))
+	}
+	if slices.Contains(deploymentEventTypes, event.Type) {
+		topics = append(topics, TopicDeployments)
+	}
+	if slices.Contains(stackEventTypes, event.Type) {
+		topics = append(topics, TopicStacks)
+	}
+	if event.IsNotification {
+		topics = append(topics, TopicNotifications)
+	}
+	return topics
+}
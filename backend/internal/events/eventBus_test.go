@@ -0,0 +1,80 @@
+package events
+
+import (
+	"context"
+	"testing"
+
+	"omar-kada/autonas/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestBus() *Bus {
+	return NewBus()
+}
+
+func TestBus_PublishesToTopics(t *testing.T) {
+	bus := newTestBus()
+	deployment := bus.Subscribe(10, DeploymentTopic(3))
+	notifications := bus.Subscribe(10, TopicNotifications, TopicDeployments)
+	stacks := bus.Subscribe(10, TopicStacks)
+
+	bus.HandleEvent(context.Background(), models.Event{Type: models.EventMisc, Msg: "pulling", ObjectID: 3})
+	bus.HandleEvent(context.Background(), models.Event{Type: models.EventDeploymentError, ObjectID: 3, IsNotification: true})
+	bus.HandleEvent(context.Background(), models.Event{Type: models.EventStackStatusChanged, ObjectName: "web"})
+	bus.HandleEvent(context.Background(), models.Event{Type: models.EventStackUnhealthy, ObjectName: "db"})
+
+	assert.Len(t, deployment.Events(), 2)
+	assert.Equal(t, "pulling", (<-deployment.Events()).Event.Msg)
+	assert.Len(t, notifications.Events(), 1)
+	assert.Equal(t, []string{TopicDeployments, TopicNotifications}, (<-notifications.Events()).Topics)
+	assert.Len(t, stacks.Events(), 2)
+	assert.Equal(t, "web", (<-stacks.Events()).Event.ObjectName)
+	assert.Equal(t, models.EventStackUnhealthy, (<-stacks.Events()).Event.Type)
+}
+
+func TestBus_ChangeTopics(t *testing.T) {
+	bus := newTestBus()
+	sub := bus.Subscribe(10)
+
+	bus.HandleEvent(context.Background(), models.Event{Type: models.EventStackStatusChanged})
+	assert.Empty(t, sub.Events())
+
+	sub.Subscribe(TopicStacks, DeploymentTopic(1))
+	assert.Equal(t, []string{"deployment:1", TopicStacks}, sub.Topics())
+	bus.HandleEvent(context.Background(), models.Event{Type: models.EventStackStatusChanged})
+	assert.Len(t, sub.Events(), 1)
+
+	sub.Unsubscribe(TopicStacks)
+	bus.HandleEvent(context.Background(), models.Event{Type: models.EventStackStatusChanged})
+	assert.Len(t, sub.Events(), 1)
+}
+
+func TestBus_DropsSlowSubscriptions(t *testing.T) {
+	bus := newTestBus()
+	slow := bus.Subscribe(1, TopicStacks)
+	fast := bus.Subscribe(10, TopicStacks)
+
+	bus.HandleEvent(context.Background(), models.Event{Type: models.EventStackStatusChanged})
+	bus.HandleEvent(context.Background(), models.Event{Type: models.EventStackStatusChanged})
+
+	<-slow.Events()
+	_, ok := <-slow.Events()
+	assert.False(t, ok, "the slow subscription is closed")
+	assert.Len(t, fast.Events(), 2)
+
+	// unsubscribing a dropped subscription is a no-op
+	bus.Unsubscribe(slow)
+	bus.Unsubscribe(fast)
+	_, ok = <-fast.Events()
+	assert.True(t, ok)
+}
+
+func TestValidateTopic(t *testing.T) {
+	for _, topic := range []string{TopicDeployments, TopicNotifications, TopicStacks, "deployment:12"} {
+		assert.NoError(t, ValidateTopic(topic), topic)
+	}
+	for _, topic := range []string{"", "deployment:", "deployment:abc", "other"} {
+		assert.Error(t, ValidateTopic(topic), topic)
+	}
+}
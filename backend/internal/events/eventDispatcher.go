@@ -3,8 +3,10 @@ package events
 
 import (
 	"context"
+	"log/slog"
 	"time"
 
+	"omar-kada/autonas/internal/storage"
 	"omar-kada/autonas/models"
 )
 
@@ -14,6 +16,15 @@ const objectIDCtxKey models.ContextKey = "OBJECT_ID"
 // objectNameCtxKey represents a context key for object name
 const objectNameCtxKey models.ContextKey = "OBJECT_NAME"
 
+// serviceCtxKey represents a context key for the service printing logs
+const serviceCtxKey models.ContextKey = "SERVICE"
+
+// streamCtxKey represents a context key for the stream of logs
+const streamCtxKey models.ContextKey = "STREAM"
+
+// configCtxKey represents a context key for the config read by the dispatcher for an event
+const configCtxKey models.ContextKey = "CONFIG"
+
 // Dispatcher is responsible of processing deployment events
 type Dispatcher interface {
 	Dispatch(ctx context.Context, eventType models.EventType, msg string)
@@ -26,6 +37,7 @@ type EventHandler interface {
 
 type dispatcher struct {
 	eventHandlers []EventHandler
+	configStore   storage.ConfigStore
 }
 
 // NewDefaultDispatcher creates a new event dispatcher
@@ -35,6 +47,15 @@ func NewDefaultDispatcher(eventHandlers []EventHandler) Dispatcher {
 	}
 }
 
+// NewConfiguredDispatcher creates an event dispatcher that reads the config once per event,
+// except for the logs, to tell the handlers whether the event is a notification
+func NewConfiguredDispatcher(configStore storage.ConfigStore, eventHandlers []EventHandler) Dispatcher {
+	return &dispatcher{
+		eventHandlers: eventHandlers,
+		configStore:   configStore,
+	}
+}
+
 // NewVoidDispatcher creates a new dispatcher that discards all events
 // without storing or logging them.
 func NewVoidDispatcher() Dispatcher {
@@ -51,6 +72,16 @@ func (d *dispatcher) Dispatch(ctx context.Context, eventType models.EventType, m
 		ObjectID:   objectID,
 		ObjectName: objectName,
 	}
+	event.Service, _ = ctx.Value(serviceCtxKey).(string)
+	event.Stream, _ = ctx.Value(streamCtxKey).(models.LogStream)
+	if d.configStore != nil && eventType != models.EventLog {
+		if cfg, err := d.configStore.Get(); err == nil {
+			event.IsNotification = cfg.IsEventNotificationEnabled(eventType)
+			ctx = context.WithValue(ctx, configCtxKey, cfg)
+		} else {
+			slog.Error("can't retrieve config", "error", err)
+		}
+	}
 
 	for _, handler := range d.eventHandlers {
 		handler.HandleEvent(ctx, event)
@@ -72,9 +103,27 @@ func GetObjectFromContext(ctx context.Context) (uint64, string) {
 	return objectID, objectName
 }
 
+// getConfigFromContext returns the config read by the dispatcher for the event, if any
+func getConfigFromContext(ctx context.Context) (models.Config, bool) {
+	cfg, ok := ctx.Value(configCtxKey).(models.Config)
+	return cfg, ok
+}
+
 // GetDeploymentContext adds deployment ID and title to the context.
 func GetDeploymentContext(ctx context.Context, deployment models.Deployment) context.Context {
 	ctx = context.WithValue(ctx, objectIDCtxKey, deployment.ID)
 	ctx = context.WithValue(ctx, objectNameCtxKey, deployment.Title)
 	return ctx
 }
+
+// GetStackContext adds the stack name to the context.
+func GetStackContext(ctx context.Context, stack string) context.Context {
+	return context.WithValue(ctx, objectNameCtxKey, stack)
+}
+
+// GetLogContext adds the service and the stream of the logs to the context.
+func GetLogContext(ctx context.Context, service string, stream models.LogStream) context.Context {
+	ctx = context.WithValue(ctx, serviceCtxKey, service)
+	ctx = context.WithValue(ctx, streamCtxKey, stream)
+	return ctx
+}
@@ -46,6 +46,32 @@ func TestHandleEvent(t *testing.T) {
 	}))
 }
 
+func TestConfiguredDispatcher_ReadsConfigOncePerEvent(t *testing.T) {
+	configStore := new(MockConfigStore)
+	configStore.On("Get").Return(models.Config{
+		Settings: models.Settings{NotificationTypes: []models.EventType{models.EventDeploymentError}},
+	}, nil)
+	eventStore := new(MockEventStore)
+	eventStore.On("StoreEvent", mock.Anything).Return(nil)
+	bus := NewBus()
+	sub := bus.Subscribe(10, TopicNotifications)
+	dispatcher := NewConfiguredDispatcher(configStore, []EventHandler{
+		NewNotificationEventHandler(configStore, eventStore),
+		bus,
+	})
+
+	dispatcher.Dispatch(context.Background(), models.EventDeploymentError, "failed")
+	dispatcher.Dispatch(context.Background(), models.EventMisc, "pulled")
+	dispatcher.Dispatch(context.Background(), models.EventLog, "line")
+
+	configStore.AssertNumberOfCalls(t, "Get", 2)
+	assert.Len(t, sub.Events(), 1)
+	assert.Equal(t, models.EventDeploymentError, (<-sub.Events()).Event.Type)
+	eventStore.AssertCalled(t, "StoreEvent", mock.MatchedBy(func(e models.Event) bool {
+		return e.Type == models.EventDeploymentError && e.IsNotification
+	}))
+}
+
 func TestGetDeploymentContext(t *testing.T) {
 	deployment := models.Deployment{
 		ID:    1,
@@ -0,0 +1,36 @@
+package events
+
+import (
+	"context"
+	"log/slog"
+
+	"omar-kada/autonas/internal/storage"
+	"omar-kada/autonas/models"
+)
+
+// LogStoreEventHandler is an event handler that stores the logs of the deployments
+type LogStoreEventHandler struct {
+	logStore storage.DeploymentLogStorage
+}
+
+// NewLogStoreEventHandler creates a new event handler storing the logs in logStore
+func NewLogStoreEventHandler(logStore storage.DeploymentLogStorage) EventHandler {
+	return &LogStoreEventHandler{logStore: logStore}
+}
+
+// HandleEvent stores the log events dispatched during a deployment
+func (h *LogStoreEventHandler) HandleEvent(_ context.Context, event models.Event) {
+	if event.Type != models.EventLog || event.ObjectID == 0 {
+		return
+	}
+	err := h.logStore.StoreLog(models.DeploymentLog{
+		DeploymentID: event.ObjectID,
+		Time:         event.Time,
+		Service:      event.Service,
+		Stream:       event.Stream,
+		Line:         event.Msg,
+	})
+	if err != nil {
+		slog.Error("can't store deployment log", "error", err)
+	}
+}
@@ -0,0 +1,42 @@
+package events
+
+import (
+	"context"
+	"testing"
+
+	"omar-kada/autonas/internal/storage"
+	"omar-kada/autonas/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockLogStore struct {
+	mock.Mock
+	storage.DeploymentLogStorage
+}
+
+func (m *MockLogStore) StoreLog(log models.DeploymentLog) error {
+	args := m.Called(log)
+	return args.Error(0)
+}
+
+func TestLogStoreEventHandler_HandleEvent(t *testing.T) {
+	logStore := new(MockLogStore)
+	logStore.On("StoreLog", mock.Anything).Return(nil)
+	dispatcher := NewDefaultDispatcher([]EventHandler{NewLogStoreEventHandler(logStore)})
+
+	ctx := GetDeploymentContext(context.Background(), models.Deployment{ID: 7, Title: "deploy"})
+	dispatcher.Dispatch(GetLogContext(ctx, "web", models.LogStreamStderr), models.EventLog, "pulling")
+	// other events and logs outside of a deployment aren't stored
+	dispatcher.Dispatch(ctx, models.EventMisc, "deploying")
+	dispatcher.Dispatch(GetLogContext(context.Background(), "web", models.LogStreamStdout), models.EventLog, "up")
+
+	logStore.AssertNumberOfCalls(t, "StoreLog", 1)
+	stored := logStore.Calls[0].Arguments.Get(0).(models.DeploymentLog)
+	assert.Equal(t, uint64(7), stored.DeploymentID)
+	assert.Equal(t, "web", stored.Service)
+	assert.Equal(t, models.LogStreamStderr, stored.Stream)
+	assert.Equal(t, "pulling", stored.Line)
+	assert.False(t, stored.Time.IsZero())
+}
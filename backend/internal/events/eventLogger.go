@@ -18,5 +18,9 @@ func NewLoggingEventHandler() EventHandler {
 
 // HandleEvent logs the event
 func (*LoggingEventHandler) HandleEvent(ctx context.Context, event models.Event) {
+	if event.Type == models.EventLog {
+		slog.Log(ctx, slog.LevelDebug, fmt.Sprintf("[%v - %v/%v] %v", event.ObjectID, event.Service, event.Stream, event.Msg))
+		return
+	}
 	slog.Log(ctx, slog.LevelInfo, fmt.Sprintf("[%v - %v] %v : %v", event.Type, event.ObjectID, event.ObjectName, event.Msg))
 }
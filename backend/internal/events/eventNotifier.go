@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log/slog"
 
+	"omar-kada/autonas/internal/metrics"
 	"omar-kada/autonas/internal/storage"
 	"omar-kada/autonas/models"
 
@@ -28,13 +29,20 @@ func NewNotificationEventHandler(configStore storage.ConfigStore, eventStore sto
 	}
 }
 
-// HandleEvent sends a notification for the event
-func (h *NotificationEventHandler) HandleEvent(_ context.Context, event models.Event) {
-	cfg, err := h.configStore.Get()
-	if err != nil {
-		slog.Error("can't retrieve config", "error", err)
+// HandleEvent sends a notification for the event, the logs are stored separately.
+// The config read by the dispatcher is used when there is one
+func (h *NotificationEventHandler) HandleEvent(ctx context.Context, event models.Event) {
+	if event.Type == models.EventLog {
 		return
 	}
+	cfg, ok := getConfigFromContext(ctx)
+	if !ok {
+		var err error
+		if cfg, err = h.configStore.Get(); err != nil {
+			slog.Error("can't retrieve config", "error", err)
+			return
+		}
+	}
 	event.IsNotification = h.sendNotification(cfg, event)
 	h.storeNotification(event)
 }
@@ -55,6 +63,7 @@ func (h *NotificationEventHandler) sendNotification(cfg models.Config, event mod
 
 		err := h.Send(cfg.Settings.NotificationURL, message)
 		if err != nil {
+			metrics.NotificationFailed()
 			slog.Error("can't send notification", "error", err)
 		}
 	}
@@ -211,3 +211,14 @@ func TestNotificationEventHandler_HandleEvent_NotificationFlag_Disabled(t *testi
 
 	mockEventStore.AssertExpectations(t)
 }
+
+func TestNotificationEventHandler_IgnoresLogs(t *testing.T) {
+	mockConfigStore := new(MockConfigStore)
+	mockEventStore := new(MockEventStore)
+	handler := NewNotificationEventHandler(mockConfigStore, mockEventStore)
+
+	handler.HandleEvent(context.Background(), models.Event{Type: models.EventLog, ObjectID: 1, Msg: "pulling"})
+
+	mockConfigStore.AssertNotCalled(t, "Get")
+	mockEventStore.AssertNotCalled(t, "StoreEvent", mock.Anything)
+}
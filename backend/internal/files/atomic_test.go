@@ -0,0 +1,60 @@
+package files
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteFileAtomic_NewFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+
+	err := WriteFileAtomic(path, []byte("cron: 0\n"), 0o600)
+	assert.NoError(t, err)
+
+	content, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, "cron: 0\n", string(content))
+	info, err := os.Stat(path)
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+}
+
+func TestWriteFileAtomic_ReplacesAndKeepsMode(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.yaml")
+	assert.NoError(t, os.WriteFile(path, []byte("a longer old content\n"), 0o640))
+
+	err := WriteFileAtomic(path, []byte("new\n"), 0o600)
+	assert.NoError(t, err)
+
+	content, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, "new\n", string(content))
+	info, err := os.Stat(path)
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o640), info.Mode().Perm())
+
+	// the temporary file is removed
+	entries, err := os.ReadDir(dir)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
+
+func TestWriteFileAtomic_Error(t *testing.T) {
+	err := WriteFileAtomic(filepath.Join(t.TempDir(), "missing", "config.yaml"), []byte("new\n"), 0o600)
+	assert.ErrorContains(t, err, "error creating temporary file")
+}
+
+func TestWriteInPlace(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	assert.NoError(t, os.WriteFile(path, []byte("a longer old content\n"), 0o600))
+
+	assert.NoError(t, writeInPlace(path, []byte("new\n"), 0o600))
+
+	content, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, "new\n", string(content))
+}
@@ -2,12 +2,15 @@
 package git
 
 import (
+	"errors"
 	"fmt"
 	"log/slog"
 	"os"
 	"path/filepath"
+	"time"
 
 	"omar-kada/autonas/internal/events"
+	"omar-kada/autonas/internal/metrics"
 	"omar-kada/autonas/models"
 
 	"github.com/go-git/go-git/v6"
@@ -19,6 +22,9 @@ import (
 // NoErrAlreadyUpToDate is returned when the repository is already up to date.
 var NoErrAlreadyUpToDate = git.NoErrAlreadyUpToDate
 
+// ErrFileNotFound is returned when a file isn't in the remote branch
+var ErrFileNotFound = errors.New("file not found in the repo")
+
 // Patch represent the difference between two commits
 type Patch struct {
 	Diff       string
@@ -35,6 +41,8 @@ type Fetcher interface {
 	PullBranch(branch string, commitSHA string) error
 	WithConfig(cfg models.Config) Fetcher
 	DiffWithRemote() (Patch, error)
+	CommitFile(path string, patch FilePatch, author, message string) (string, error)
+	ReadRemoteFile(path string) ([]byte, error)
 }
 
 // Syncer is responsible for syncing files from repo
@@ -109,6 +117,7 @@ func (f *fetcher) PullBranch(branch string, commitHash string) error {
 }
 
 func (f *fetcher) openRepo(branch string) (repo *git.Repository, err error) {
+	start := time.Now()
 	if !repoExists(f.repoPath) {
 		repo, err = git.PlainClone(f.repoPath, &git.CloneOptions{
 			URL:           f.cfg.Settings.Repo,
@@ -121,13 +130,18 @@ func (f *fetcher) openRepo(branch string) (repo *git.Repository, err error) {
 		repo, err = git.PlainOpen(f.repoPath)
 	}
 	if err != nil {
+		metrics.ObserveGitFetch(time.Since(start), err)
 		return repo, fmt.Errorf("error while opening repo : %w, %v", err, *f)
 	}
 	err = repo.Fetch(&git.FetchOptions{
 		Auth: f.auth,
 	})
+	if err == NoErrAlreadyUpToDate {
+		err = nil
+	}
+	metrics.ObserveGitFetch(time.Since(start), err)
 
-	if err != nil && err != NoErrAlreadyUpToDate {
+	if err != nil {
 		return repo, fmt.Errorf("error while fetching repo : %w, %v", err, *f)
 	}
 
@@ -150,6 +164,30 @@ func (f *fetcher) DiffWithRemote() (Patch, error) {
 	return f.getPatch(repo)
 }
 
+// ReadRemoteFile returns the content of a file on the remote branch as of the last fetch,
+// ErrFileNotFound is returned when the branch doesn't have it
+func (f *fetcher) ReadRemoteFile(path string) ([]byte, error) {
+	repo, err := git.PlainOpen(f.repoPath)
+	if err != nil {
+		return nil, fmt.Errorf("error while opening repo : %w", err)
+	}
+	commit, err := f.getRemoteCommit(repo)
+	if err != nil {
+		return nil, err
+	}
+	file, err := commit.File(path)
+	if errors.Is(err, gitObject.ErrFileNotFound) {
+		return nil, fmt.Errorf("%w : %s", ErrFileNotFound, path)
+	} else if err != nil {
+		return nil, fmt.Errorf("error while getting '%v' : %w", path, err)
+	}
+	content, err := file.Contents()
+	if err != nil {
+		return nil, fmt.Errorf("error while reading '%v' : %w", path, err)
+	}
+	return []byte(content), nil
+}
+
 func (f *fetcher) reset(repo *git.Repository, branch string, hash string) error {
 	wt, err := repo.Worktree()
 	if err != nil {
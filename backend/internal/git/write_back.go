@@ -0,0 +1,125 @@
+package git
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"omar-kada/autonas/models"
+
+	"github.com/go-git/go-billy/v6/memfs"
+	"github.com/go-git/go-git/v6"
+	"github.com/go-git/go-git/v6/config"
+	"github.com/go-git/go-git/v6/plumbing"
+	gitObject "github.com/go-git/go-git/v6/plumbing/object"
+	"github.com/go-git/go-git/v6/storage/memory"
+)
+
+// ConfigFile is the path of the configuration file written back to the repo
+const ConfigFile = "config.yaml"
+
+// WriteBackBranchPrefix prefixes the branches created by the branch write back mode
+const WriteBackBranchPrefix = "autonas/config-"
+
+// FilePatch returns the new content of a file given its content in the repo, empty when it's missing
+type FilePatch func(content []byte) ([]byte, error)
+
+// CommitFile commits the file at path patched by patch on the configured branch, or on a new branch
+// with the branch write back mode, and pushes it. The repo is cloned in memory so the
+// deployed files aren't modified. It returns the pushed branch, or an empty string when the
+// file didn't change.
+func (f *fetcher) CommitFile(path string, patch FilePatch, author, message string) (string, error) {
+	branch := f.cfg.GetBranch()
+	repo, err := git.Clone(memory.NewStorage(), memfs.New(), &git.CloneOptions{
+		URL:           f.cfg.Settings.Repo,
+		ReferenceName: plumbing.NewBranchReferenceName(branch),
+		SingleBranch:  true,
+		Auth:          f.auth,
+	})
+	if err != nil {
+		return "", fmt.Errorf("error while cloning repo : %w", err)
+	}
+	wt, err := repo.Worktree()
+	if err != nil {
+		return "", fmt.Errorf("error while getting worktree : %w", err)
+	}
+	if f.cfg.Settings.WriteBack == models.WriteBackBranch {
+		branch = WriteBackBranchPrefix + time.Now().UTC().Format("20060102-150405")
+		err = wt.Checkout(&git.CheckoutOptions{
+			Branch: plumbing.NewBranchReferenceName(branch),
+			Create: true,
+		})
+		if err != nil {
+			return "", fmt.Errorf("error while creating branch '%v' : %w", branch, err)
+		}
+	}
+
+	content, err := readFile(wt, path)
+	if err != nil {
+		return "", err
+	}
+	if content, err = patch(content); err != nil {
+		return "", fmt.Errorf("error while patching '%v' : %w", path, err)
+	}
+	if err := writeFile(wt, path, content); err != nil {
+		return "", err
+	}
+	status, err := wt.Status()
+	if err != nil {
+		return "", fmt.Errorf("error while getting status : %w", err)
+	}
+	if status.IsClean() {
+		return "", nil
+	}
+
+	if author == "" {
+		author = "autonas"
+	}
+	_, err = wt.Commit(message, &git.CommitOptions{
+		Author: &gitObject.Signature{Name: author, When: time.Now()},
+	})
+	if err != nil {
+		return "", fmt.Errorf("error while committing '%v' : %w", path, err)
+	}
+	ref := plumbing.NewBranchReferenceName(branch)
+	err = repo.Push(&git.PushOptions{
+		RefSpecs: []config.RefSpec{config.RefSpec(ref + ":" + ref)},
+		Auth:     f.auth,
+	})
+	if err != nil {
+		return "", fmt.Errorf("error while pushing branch '%v' : %w", branch, err)
+	}
+	return branch, nil
+}
+
+// readFile returns the content of the file of the worktree, missing files are empty
+func readFile(wt *git.Worktree, path string) ([]byte, error) {
+	file, err := wt.Filesystem.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("error while opening '%v' : %w", path, err)
+	}
+	content, err := io.ReadAll(file)
+	if err = errors.Join(err, file.Close()); err != nil {
+		return nil, fmt.Errorf("error while reading '%v' : %w", path, err)
+	}
+	return content, nil
+}
+
+func writeFile(wt *git.Worktree, path string, content []byte) error {
+	file, err := wt.Filesystem.Create(path)
+	if err != nil {
+		return fmt.Errorf("error while creating '%v' : %w", path, err)
+	}
+	_, err = file.Write(content)
+	if err = errors.Join(err, file.Close()); err != nil {
+		return fmt.Errorf("error while writing '%v' : %w", path, err)
+	}
+	if _, err := wt.Add(path); err != nil {
+		return fmt.Errorf("error while adding '%v' : %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+package git
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"omar-kada/autonas/models"
+	"omar-kada/autonas/testutil"
+
+	"github.com/go-git/go-git/v6"
+	"github.com/go-git/go-git/v6/plumbing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func assertCommittedFile(t *testing.T, repoPath, branch, path, wantContent, wantAuthor string) {
+	t.Helper()
+	r, err := git.PlainOpen(repoPath)
+	require.NoError(t, err)
+	ref, err := r.Reference(plumbing.NewBranchReferenceName(branch), true)
+	require.NoError(t, err)
+	commit, err := r.CommitObject(ref.Hash())
+	require.NoError(t, err)
+	file, err := commit.File(path)
+	require.NoError(t, err)
+	content, err := file.Contents()
+	require.NoError(t, err)
+
+	assert.Equal(t, wantContent, content)
+	assert.Equal(t, wantAuthor, commit.Author.Name)
+}
+
+func replaceWith(content string) FilePatch {
+	return func([]byte) ([]byte, error) {
+		return []byte(content), nil
+	}
+}
+
+func TestCommitFile(t *testing.T) {
+	remoteRepoPath := testutil.SetupRemoteRepo(t)
+	cfg := models.Config{Settings: models.Settings{Repo: remoteRepoPath, Branch: "main", WriteBack: models.WriteBackCommit}}
+	clonePath := t.TempDir() + "/clone-repo"
+	fetcher := NewFetcher(os.FileMode(0o000), clonePath).WithConfig(cfg)
+
+	branch, err := fetcher.CommitFile(ConfigFile, replaceWith("environment:\n  A: \"1\"\n"), "admin", "configuration updated")
+	assert.NoError(t, err)
+	assert.Equal(t, "main", branch)
+	assertCommittedFile(t, remoteRepoPath, "main", ConfigFile, "environment:\n  A: \"1\"\n", "admin")
+
+	// the deployed clone isn't created by the commit
+	_, err = os.Stat(clonePath)
+	assert.True(t, os.IsNotExist(err))
+
+	// unchanged content isn't committed
+	branch, err = fetcher.CommitFile(ConfigFile, replaceWith("environment:\n  A: \"1\"\n"), "admin", "configuration updated")
+	assert.NoError(t, err)
+	assert.Empty(t, branch)
+}
+
+func TestCommitFile_Branch(t *testing.T) {
+	remoteRepoPath := testutil.SetupRemoteRepo(t)
+	cfg := models.Config{Settings: models.Settings{Repo: remoteRepoPath, Branch: "main", WriteBack: models.WriteBackBranch}}
+	fetcher := NewFetcher(os.FileMode(0o000), t.TempDir()+"/clone-repo").WithConfig(cfg)
+
+	branch, err := fetcher.CommitFile(ConfigFile, replaceWith("services: {}\n"), "", "settings updated")
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(branch, WriteBackBranchPrefix), branch)
+	assertCommittedFile(t, remoteRepoPath, branch, ConfigFile, "services: {}\n", "autonas")
+
+	// the configured branch is left untouched
+	r, err := git.PlainOpen(remoteRepoPath)
+	require.NoError(t, err)
+	ref, err := r.Reference(plumbing.NewBranchReferenceName("main"), true)
+	require.NoError(t, err)
+	commit, err := r.CommitObject(ref.Hash())
+	require.NoError(t, err)
+	_, err = commit.File(ConfigFile)
+	assert.Error(t, err)
+}
+
+func TestCommitFile_NonExistentRepo(t *testing.T) {
+	cfg := models.Config{Settings: models.Settings{Repo: t.TempDir() + "/missing", WriteBack: models.WriteBackCommit}}
+	fetcher := NewFetcher(os.FileMode(0o000), t.TempDir()+"/clone-repo").WithConfig(cfg)
+
+	_, err := fetcher.CommitFile(ConfigFile, replaceWith("services: {}\n"), "admin", "configuration updated")
+	assert.ErrorContains(t, err, "error while cloning repo")
+}
+
+func TestCommitFile_PatchesTheCommittedFile(t *testing.T) {
+	remoteRepoPath := testutil.SetupRemoteRepo(t)
+	testutil.AddCommitToRepo(t, remoteRepoPath, ConfigFile, []byte("# managed by autonas\nenvironment: {}\n"))
+	cfg := models.Config{Settings: models.Settings{Repo: remoteRepoPath, Branch: "main", WriteBack: models.WriteBackCommit}}
+	fetcher := NewFetcher(os.FileMode(0o000), t.TempDir()+"/clone-repo").WithConfig(cfg)
+
+	var patched []byte
+	_, err := fetcher.CommitFile(ConfigFile, func(content []byte) ([]byte, error) {
+		patched = content
+		return append(content, "services: {}\n"...), nil
+	}, "admin", "configuration updated")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "# managed by autonas\nenvironment: {}\n", string(patched))
+	assertCommittedFile(t, remoteRepoPath, "main", ConfigFile, "# managed by autonas\nenvironment: {}\nservices: {}\n", "admin")
+
+	_, err = fetcher.CommitFile(ConfigFile, func([]byte) ([]byte, error) {
+		return nil, errors.New("invalid yaml")
+	}, "admin", "configuration updated")
+	assert.ErrorContains(t, err, "invalid yaml")
+}
+
+func TestReadRemoteFile(t *testing.T) {
+	remoteRepoPath := testutil.SetupRemoteRepo(t)
+	testutil.AddCommitToRepo(t, remoteRepoPath, ConfigFile, []byte("environment: {}\n"))
+	cfg := models.Config{Settings: models.Settings{Repo: remoteRepoPath, Branch: "main"}}
+	fetcher := NewFetcher(os.FileMode(0o000), t.TempDir()+"/clone-repo").WithConfig(cfg)
+	_, err := fetcher.DiffWithRemote()
+	require.NoError(t, err)
+
+	content, err := fetcher.ReadRemoteFile(ConfigFile)
+	assert.NoError(t, err)
+	assert.Equal(t, "environment: {}\n", string(content))
+
+	_, err = fetcher.ReadRemoteFile("missing.yaml")
+	assert.ErrorIs(t, err, ErrFileNotFound)
+}
@@ -0,0 +1,112 @@
+// Package metrics exposes the state of autonas in the Prometheus text format
+package metrics
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+	"time"
+
+	"omar-kada/autonas/models"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "autonas"
+
+var (
+	deployments = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "deployments_total",
+		Help:      "Number of finished deployments by status.",
+	}, []string{"status"})
+	deploymentDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "deployment_duration_seconds",
+		Help:      "Duration of the finished deployments by status.",
+		Buckets:   []float64{1, 5, 15, 30, 60, 120, 300, 600, 1800},
+	}, []string{"status"})
+	lastSuccessfulDeployment = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "last_successful_deployment_timestamp_seconds",
+		Help:      "Unix time of the end of the last successful deployment.",
+	})
+	gitFetchDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "git_fetch_duration_seconds",
+		Help:      "Duration of the clones and fetches of the config repo.",
+		Buckets:   prometheus.ExponentialBuckets(0.1, 2, 10),
+	})
+	gitFetchErrors = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "git_fetch_errors_total",
+		Help:      "Number of failed clones and fetches of the config repo.",
+	})
+	notificationFailures = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "notification_failures_total",
+		Help:      "Number of notifications that couldn't be sent.",
+	})
+)
+
+// ObserveDeployment records a finished deployment
+func ObserveDeployment(status models.DeploymentStatus, duration time.Duration, end time.Time) {
+	deployments.WithLabelValues(string(status)).Inc()
+	deploymentDuration.WithLabelValues(string(status)).Observe(duration.Seconds())
+	if status == models.DeploymentStatusSuccess {
+		lastSuccessfulDeployment.Set(float64(end.Unix()))
+	}
+}
+
+// SeedLastDeployment sets the time of the last successful deployment on startup from the
+// last stored successful deployment, the gauge would stay at 0 until the next deployment otherwise
+func SeedLastDeployment(last models.Deployment) {
+	if last.Status == models.DeploymentStatusSuccess && !last.EndTime.IsZero() {
+		lastSuccessfulDeployment.Set(float64(last.EndTime.Unix()))
+	}
+}
+
+// ObserveGitFetch records the latency of a clone or fetch of the config repo
+func ObserveGitFetch(duration time.Duration, err error) {
+	gitFetchDuration.Observe(duration.Seconds())
+	if err != nil {
+		gitFetchErrors.Inc()
+	}
+}
+
+// NotificationFailed records a notification that couldn't be sent
+func NotificationFailed() {
+	notificationFailures.Inc()
+}
+
+// NewHandler returns the handler of the metrics endpoint, the state of the stacks is read on
+// each scrape. Requests must send token as a bearer token, the metrics are disabled when it's empty.
+func NewHandler(token string, stacks StacksSource) http.Handler {
+	if token == "" {
+		return http.NotFoundHandler()
+	}
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		deployments,
+		deploymentDuration,
+		lastSuccessfulDeployment,
+		gitFetchDuration,
+		gitFetchErrors,
+		notificationFailures,
+		newStacksCollector(stacks),
+	)
+	handler := promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		bearer, _ := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if subtle.ConstantTimeCompare([]byte(bearer), []byte(token)) != 1 {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			http.Error(w, "invalid metrics token", http.StatusUnauthorized)
+			return
+		}
+		handler.ServeHTTP(w, r)
+	})
+}
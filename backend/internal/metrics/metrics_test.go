@@ -0,0 +1,103 @@
+package metrics
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"omar-kada/autonas/models"
+
+	"github.com/moby/moby/api/types/container"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeStacks struct {
+	state models.StacksState
+	err   error
+}
+
+func (f fakeStacks) GetStacksState() (models.StacksState, error) {
+	return f.state, f.err
+}
+
+func (f fakeStacks) GetManagedStacks() (map[string][]models.ContainerSummary, error) {
+	return map[string][]models.ContainerSummary{
+		"web": {{Name: "web-1", State: container.StateRunning, Health: container.Healthy}},
+	}, f.err
+}
+
+func scrape(t *testing.T, handler http.Handler, token string) (int, string) {
+	req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	body, err := io.ReadAll(rec.Body)
+	assert.NoError(t, err)
+	return rec.Code, string(body)
+}
+
+func TestHandler(t *testing.T) {
+	state := models.NewStacksState()
+	state.ProgressiveUpdateServiceStatus("web", models.StackStatusHealthy)
+	end := time.Unix(1700000000, 0)
+	ObserveDeployment(models.DeploymentStatusSuccess, 12*time.Second, end)
+	ObserveDeployment(models.DeploymentStatusError, 3*time.Second, end.Add(time.Hour))
+	ObserveGitFetch(time.Second, errors.New("unreachable"))
+	NotificationFailed()
+
+	code, body := scrape(t, NewHandler("s3cret", fakeStacks{state: state}), "s3cret")
+
+	assert.Equal(t, http.StatusOK, code)
+	assert.Contains(t, body, `autonas_deployments_total{status="success"} 1`)
+	assert.Contains(t, body, `autonas_deployments_total{status="error"} 1`)
+	assert.Contains(t, body, `autonas_deployment_duration_seconds_sum{status="success"} 12`)
+	assert.Contains(t, body, `autonas_last_successful_deployment_timestamp_seconds 1.7e+09`)
+	assert.Contains(t, body, `autonas_git_fetch_errors_total 1`)
+	assert.Contains(t, body, `autonas_git_fetch_duration_seconds_count 1`)
+	assert.Contains(t, body, `autonas_notification_failures_total 1`)
+	assert.Contains(t, body, `autonas_health{status="healthy"} 1`)
+	assert.Contains(t, body, `autonas_health{status="unhealthy"} 0`)
+	assert.Contains(t, body, `autonas_stack_health{stack="web",status="healthy"} 1`)
+	assert.Contains(t, body, `autonas_container_state{container="web-1",stack="web",state="running"} 1`)
+	assert.Contains(t, body, `autonas_container_health{container="web-1",health="healthy",stack="web"} 1`)
+}
+
+func TestHandler_DockerUnavailable(t *testing.T) {
+	code, body := scrape(t, NewHandler("s3cret", fakeStacks{err: errors.New("no docker")}), "s3cret")
+
+	assert.Equal(t, http.StatusOK, code)
+	assert.NotContains(t, body, "autonas_stack_health")
+	assert.Contains(t, body, "go_goroutines")
+}
+
+func TestHandler_Token(t *testing.T) {
+	handler := NewHandler("s3cret", fakeStacks{state: models.NewStacksState()})
+
+	code, _ := scrape(t, handler, "")
+	assert.Equal(t, http.StatusUnauthorized, code)
+	code, _ = scrape(t, handler, "wrong")
+	assert.Equal(t, http.StatusUnauthorized, code)
+	code, _ = scrape(t, handler, "s3cret")
+	assert.Equal(t, http.StatusOK, code)
+}
+
+func TestHandler_DisabledWithoutToken(t *testing.T) {
+	code, _ := scrape(t, NewHandler("", fakeStacks{state: models.NewStacksState()}), "")
+	assert.Equal(t, http.StatusNotFound, code)
+}
+
+func TestSeedLastDeployment(t *testing.T) {
+	handler := NewHandler("s3cret", fakeStacks{state: models.NewStacksState()})
+	end := time.Unix(1800000000, 0)
+
+	SeedLastDeployment(models.Deployment{Status: models.DeploymentStatusError, EndTime: end.Add(time.Hour)})
+	SeedLastDeployment(models.Deployment{Status: models.DeploymentStatusSuccess, EndTime: end})
+
+	_, body := scrape(t, handler, "s3cret")
+	assert.Contains(t, body, `autonas_last_successful_deployment_timestamp_seconds 1.8e+09`)
+}
@@ -0,0 +1,89 @@
+package metrics
+
+import (
+	"log/slog"
+
+	"omar-kada/autonas/models"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// StacksSource gives the state of the managed stacks and their containers
+type StacksSource interface {
+	GetStacksState() (models.StacksState, error)
+	GetManagedStacks() (map[string][]models.ContainerSummary, error)
+}
+
+var stackStatuses = []models.StackStatus{
+	models.StackStatusUnknown,
+	models.StackStatusUnhealthy,
+	models.StackStatusStarting,
+	models.StackStatusHealthy,
+}
+
+var (
+	healthDesc = prometheus.NewDesc(namespace+"_health",
+		"Global health of the enabled stacks, 1 for the current status.", []string{"status"}, nil)
+	stackHealthDesc = prometheus.NewDesc(namespace+"_stack_health",
+		"Health of each enabled stack, 1 for the current status.", []string{"stack", "status"}, nil)
+	containerStateDesc = prometheus.NewDesc(namespace+"_container_state",
+		"State of each managed container, 1 for the current state.", []string{"stack", "container", "state"}, nil)
+	containerHealthDesc = prometheus.NewDesc(namespace+"_container_health",
+		"Health check status of each managed container, 1 for the current status.", []string{"stack", "container", "health"}, nil)
+)
+
+// stacksCollector reads the state of the stacks when metrics are scraped
+type stacksCollector struct {
+	source StacksSource
+}
+
+func newStacksCollector(source StacksSource) prometheus.Collector {
+	return stacksCollector{source: source}
+}
+
+func (stacksCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- healthDesc
+	ch <- stackHealthDesc
+	ch <- containerStateDesc
+	ch <- containerHealthDesc
+}
+
+func (c stacksCollector) Collect(ch chan<- prometheus.Metric) {
+	state, err := c.source.GetStacksState()
+	if err != nil {
+		slog.Warn("couldn't collect the state of the stacks", "error", err)
+	} else {
+		global := state.GetGlobalHealth()
+		for _, status := range stackStatuses {
+			ch <- prometheus.MustNewConstMetric(healthDesc, prometheus.GaugeValue, boolValue(status == global), string(status))
+		}
+		for _, stack := range state.Services() {
+			current := state.ForService(stack)
+			for _, status := range stackStatuses {
+				ch <- prometheus.MustNewConstMetric(stackHealthDesc, prometheus.GaugeValue,
+					boolValue(status == current), stack, string(status))
+			}
+		}
+	}
+
+	stacks, err := c.source.GetManagedStacks()
+	if err != nil {
+		slog.Warn("couldn't collect the managed containers", "error", err)
+		return
+	}
+	for stack, containers := range stacks {
+		for _, ctr := range containers {
+			ch <- prometheus.MustNewConstMetric(containerStateDesc, prometheus.GaugeValue, 1, stack, ctr.Name, string(ctr.State))
+			if ctr.Health != "" {
+				ch <- prometheus.MustNewConstMetric(containerHealthDesc, prometheus.GaugeValue, 1, stack, ctr.Name, string(ctr.Health))
+			}
+		}
+	}
+}
+
+func boolValue(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
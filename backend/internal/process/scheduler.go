@@ -3,86 +3,311 @@ package process
 import (
 	"fmt"
 	"log/slog"
+	"math/rand/v2"
 	"sync"
 	"time"
 
 	"omar-kada/autonas/internal/storage"
+	"omar-kada/autonas/models"
 
 	"github.com/robfig/cron/v3"
 )
 
-// ConfigScheduler is responsible for cron running a sheduled job with updated config
+// ConfigScheduler is responsible for cron running the sheduled jobs with updated config
 type ConfigScheduler interface {
 	Schedule(fn func()) (*cron.Cron, error)
 	ReSchedule() (*cron.Cron, error)
+	SetJob(name models.ScheduleName, fn func())
 	GetNext() time.Time
+	GetSchedules() (models.Schedules, error)
 }
 
-// NewConfigScheduler creates a new ConfigScheduler that ensures only one cron job runs at a time.
+// NewConfigScheduler creates a new ConfigScheduler that ensures only one cron runs at a time.
 func NewConfigScheduler(configStore storage.ConfigStore) ConfigScheduler {
 	return &AtomicConfigScheduler{
 		configStore: configStore,
+		jobs:        make(map[models.ScheduleName]func()),
 	}
 }
 
-// AtomicConfigScheduler runs only a single cron job at a time
+// AtomicConfigScheduler runs only a single cron at a time, with an entry per scheduled job
 type AtomicConfigScheduler struct {
 	configStore storage.ConfigStore
-	fn          func()
+	jobs        map[models.ScheduleName]func()
 	cron        *cron.Cron
+	entries     map[models.ScheduleName]cron.EntryID
+	run         *scheduleRun
 	mu          sync.Mutex
 }
 
-// Schedule stops the old cron when it exists, and runs a new cron job
+// SetJob registers the function of a scheduled job, it is used from the next (re)schedule
+func (a *AtomicConfigScheduler) SetJob(name models.ScheduleName, fn func()) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.jobs[name] = fn
+}
+
+// Schedule stops the old cron when it exists, and runs a new cron with fn as the sync job
 func (a *AtomicConfigScheduler) Schedule(fn func()) (*cron.Cron, error) {
 	// make sure only one sync job is running at a time
 	a.mu.Lock()
 	defer a.mu.Unlock()
-	a.fn = fn
+	a.jobs[models.ScheduleSync] = fn
 
 	if a.cron != nil {
 		a.cron.Stop()
 		a.cron = nil
+		a.entries = nil
+	}
+	if a.run != nil {
+		close(a.run.stop)
+		a.run = nil
 	}
 
 	cfg, err := a.configStore.Get()
 	if err != nil {
 		return nil, err
 	}
-	if cfg.Settings.Cron == "1" {
-		slog.Debug("running job for a single time")
-		fn()
-		return nil, nil
-	} else if cfg.Settings.Cron != "" && cfg.Settings.Cron != "0" {
+	location, err := cfg.Settings.GetLocation()
+	if err != nil {
+		return nil, err
+	}
+	windows, err := parseMaintenanceWindows(cfg.Settings.MaintenanceWindows)
+	if err != nil {
+		return nil, err
+	}
 
-		slog.Debug("scheduling a new cron job")
-		c := cron.New()
-		_, err := c.AddFunc(cfg.Settings.Cron, fn)
+	run := newScheduleRun(windows, location)
+	c := cron.New(cron.WithLocation(location))
+	entries := make(map[models.ScheduleName]cron.EntryID)
+	ranOnce := false
+	for _, name := range models.ScheduleNames {
+		job := a.jobs[name]
+		schedule := cfg.Settings.GetSchedule(name)
+		if job == nil || !schedule.IsEnabled() {
+			continue
+		}
+		if name == models.ScheduleSync && schedule.Cron == "1" {
+			slog.Debug("running job for a single time")
+			job()
+			ranOnce = true
+			continue
+		}
+		jitter, err := schedule.GetJitter()
+		if err != nil {
+			return nil, fmt.Errorf("invalid jitter of %s : %w", name, err)
+		}
+		id, err := c.AddFunc(schedule.Cron, run.wrap(name, job, jitter))
 		if err != nil {
 			return nil, err
 		}
-		c.Start()
-		a.cron = c
-		return c, nil
+		entries[name] = id
 	}
 
-	return nil, fmt.Errorf("couldn't schedule job, no cron period is defined")
+	if len(entries) == 0 {
+		if ranOnce {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("couldn't schedule job, no cron period is defined")
+	}
+	slog.Debug("scheduling a new cron", "jobs", len(entries), "location", location)
+	c.Start()
+	a.cron = c
+	a.entries = entries
+	a.run = run
+	return c, nil
 }
 
-// ReSchedule stops the current cron job and schedules a new one with the same function.
+// ReSchedule stops the current cron and schedules a new one with the same functions.
 func (a *AtomicConfigScheduler) ReSchedule() (*cron.Cron, error) {
-	return a.Schedule(a.fn)
+	a.mu.Lock()
+	fn := a.jobs[models.ScheduleSync]
+	a.mu.Unlock()
+	return a.Schedule(fn)
 }
 
-// GetNext returns the next scheduled time of the cron job.
-// If no cron job is scheduled or no entries are present, it returns the zero time.
+// GetNext returns the next scheduled time of the sync job.
+// If the sync job is not scheduled, it returns the zero time.
 func (a *AtomicConfigScheduler) GetNext() time.Time {
-	if a.cron == nil {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.getNext(models.ScheduleSync)
+}
+
+func (a *AtomicConfigScheduler) getNext(name models.ScheduleName) time.Time {
+	id, ok := a.entries[name]
+	if a.cron == nil || !ok {
 		return time.Time{}
 	}
-	entries := a.cron.Entries()
-	if len(entries) == 0 {
-		return time.Time{}
+	return a.cron.Entry(id).Next
+}
+
+// GetSchedules returns the configured jobs with their next run, and the maintenance windows
+func (a *AtomicConfigScheduler) GetSchedules() (models.Schedules, error) {
+	cfg, err := a.configStore.Get()
+	if err != nil {
+		return models.Schedules{}, err
 	}
-	return entries[0].Next
+	location, err := cfg.Settings.GetLocation()
+	if err != nil {
+		return models.Schedules{}, err
+	}
+	windows, err := parseMaintenanceWindows(cfg.Settings.MaintenanceWindows)
+	if err != nil {
+		return models.Schedules{}, err
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	res := models.Schedules{Timezone: location.String()}
+	for _, name := range models.ScheduleNames {
+		schedule := cfg.Settings.GetSchedule(name)
+		state := models.Schedule{
+			Name:       name,
+			Cron:       schedule.Cron,
+			Jitter:     schedule.Jitter,
+			Disruptive: name.IsDisruptive(),
+			Next:       a.getNext(name),
+		}
+		if a.run != nil {
+			state.DeferredUntil = a.run.deferredUntil(name)
+		}
+		res.Jobs = append(res.Jobs, state)
+	}
+	now := time.Now().In(location)
+	for _, window := range windows {
+		start, end, active := window.bounds(now)
+		res.MaintenanceWindows = append(res.MaintenanceWindows, models.MaintenanceWindowState{
+			Cron:     window.Cron,
+			Duration: window.Duration,
+			Active:   active,
+			Start:    start,
+			End:      end,
+		})
+	}
+	return res, nil
+}
+
+// scheduleRun holds the state of the jobs scheduled from one version of the settings
+type scheduleRun struct {
+	// stop is closed when the cron is replaced, it cancels the delayed runs
+	stop     chan struct{}
+	windows  []maintenanceWindow
+	location *time.Location
+
+	mu       sync.Mutex
+	deferred map[models.ScheduleName]time.Time
+}
+
+func newScheduleRun(windows []maintenanceWindow, location *time.Location) *scheduleRun {
+	return &scheduleRun{
+		stop:     make(chan struct{}),
+		windows:  windows,
+		location: location,
+		deferred: make(map[models.ScheduleName]time.Time),
+	}
+}
+
+// wrap delays the job by a random jitter, and defers disruptive jobs to the end of
+// the maintenance windows. Runs fired while a job is deferred are dropped.
+func (r *scheduleRun) wrap(name models.ScheduleName, job func(), jitter time.Duration) func() {
+	return func() {
+		if jitter > 0 && !r.sleep(rand.N(jitter)) {
+			return
+		}
+		if name.IsDisruptive() {
+			for {
+				end, active := r.activeWindowEnd(time.Now())
+				if !active {
+					break
+				}
+				if !r.setDeferred(name, end) {
+					return
+				}
+				slog.Info("job deferred until the end of the maintenance window", "job", name, "until", end)
+				ok := r.sleep(time.Until(end))
+				r.setDeferred(name, time.Time{})
+				if !ok {
+					return
+				}
+			}
+		}
+		slog.Debug("running scheduled job", "job", name)
+		job()
+	}
+}
+
+// sleep waits for d, it returns false when the run was stopped meanwhile
+func (r *scheduleRun) sleep(d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-r.stop:
+		return false
+	}
+}
+
+// setDeferred records until when the job is deferred, a zero time clears it.
+// It returns false when the job is already deferred by another run.
+func (r *scheduleRun) setDeferred(name models.ScheduleName, until time.Time) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if until.IsZero() {
+		delete(r.deferred, name)
+		return true
+	}
+	if current, ok := r.deferred[name]; ok && current.After(time.Now()) {
+		return false
+	}
+	r.deferred[name] = until
+	return true
+}
+
+func (r *scheduleRun) deferredUntil(name models.ScheduleName) time.Time {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.deferred[name]
+}
+
+// activeWindowEnd returns the end of the maintenance windows containing t
+func (r *scheduleRun) activeWindowEnd(t time.Time) (time.Time, bool) {
+	var end time.Time
+	for _, window := range r.windows {
+		if _, windowEnd, active := window.bounds(t.In(r.location)); active && windowEnd.After(end) {
+			end = windowEnd
+		}
+	}
+	return end, !end.IsZero()
+}
+
+type maintenanceWindow struct {
+	models.MaintenanceWindow
+	schedule cron.Schedule
+	duration time.Duration
+}
+
+func parseMaintenanceWindows(windows []models.MaintenanceWindow) ([]maintenanceWindow, error) {
+	res := make([]maintenanceWindow, 0, len(windows))
+	for _, window := range windows {
+		schedule, err := cron.ParseStandard(window.Cron)
+		if err != nil {
+			return nil, fmt.Errorf("invalid maintenance window cron %q : %w", window.Cron, err)
+		}
+		duration, err := window.GetDuration()
+		if err != nil {
+			return nil, fmt.Errorf("invalid maintenance window duration %q : %w", window.Duration, err)
+		}
+		res = append(res, maintenanceWindow{MaintenanceWindow: window, schedule: schedule, duration: duration})
+	}
+	return res, nil
+}
+
+// bounds returns the window containing t when active, else the next one.
+// The cron is evaluated in the location of t.
+func (w maintenanceWindow) bounds(t time.Time) (start, end time.Time, active bool) {
+	start = w.schedule.Next(t.Add(-w.duration))
+	end = start.Add(w.duration)
+	return start, end, !start.After(t)
 }
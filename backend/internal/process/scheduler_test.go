@@ -296,3 +296,120 @@ func TestReSchedule(t *testing.T) {
 	// Stop the cron
 	c.Stop()
 }
+
+func TestSchedule_NamedJobs(t *testing.T) {
+	configStore := createTestConfigStore(t)
+	scheduler := NewConfigScheduler(configStore).(*AtomicConfigScheduler)
+
+	testConfig := models.Config{Settings: models.Settings{
+		Timezone: "Europe/Paris",
+		Schedules: map[models.ScheduleName]models.ScheduleSettings{
+			models.SchedulePrune: {Cron: "@every 1s", Jitter: "10ms"},
+		},
+	}}
+	assert.NoError(t, configStore.Update(testConfig))
+
+	pruneCalled := make(chan bool, 1)
+	scheduler.SetJob(models.SchedulePrune, func() {
+		pruneCalled <- true
+	})
+	c, err := scheduler.Schedule(func() {})
+	assert.NoError(t, err)
+	assert.NotNil(t, c)
+	defer c.Stop()
+
+	assert.Equal(t, "Europe/Paris", c.Location().String())
+	assert.Zero(t, scheduler.GetNext(), "sync isn't scheduled")
+	select {
+	case <-pruneCalled:
+	case <-time.After(2 * time.Second):
+		t.Error("Prune job was not called within expected time")
+	}
+}
+
+func TestGetSchedules(t *testing.T) {
+	configStore := createTestConfigStore(t)
+	scheduler := NewConfigScheduler(configStore).(*AtomicConfigScheduler)
+
+	testConfig := models.Config{Settings: models.Settings{
+		Cron:     "@every 1m",
+		Timezone: "UTC",
+		Schedules: map[models.ScheduleName]models.ScheduleSettings{
+			models.ScheduleImageUpdate: {Cron: "0 4 * * *", Jitter: "1h"},
+		},
+		MaintenanceWindows: []models.MaintenanceWindow{{Cron: "0 8 * * *", Duration: "10h"}},
+	}}
+	assert.NoError(t, configStore.Update(testConfig))
+	scheduler.SetJob(models.ScheduleImageUpdate, func() {})
+	c, err := scheduler.Schedule(func() {})
+	assert.NoError(t, err)
+	defer c.Stop()
+
+	schedules, err := scheduler.GetSchedules()
+	assert.NoError(t, err)
+	assert.Equal(t, "UTC", schedules.Timezone)
+	assert.Len(t, schedules.Jobs, 3)
+
+	sync, imageUpdate, prune := schedules.Jobs[0], schedules.Jobs[1], schedules.Jobs[2]
+	assert.Equal(t, models.ScheduleSync, sync.Name)
+	assert.Equal(t, "@every 1m", sync.Cron)
+	assert.Equal(t, scheduler.GetNext(), sync.Next)
+	assert.True(t, sync.Disruptive)
+	assert.Equal(t, "1h", imageUpdate.Jitter)
+	assert.Equal(t, 4, imageUpdate.Next.Hour())
+	assert.Empty(t, prune.Cron)
+	assert.Zero(t, prune.Next)
+	assert.False(t, prune.Disruptive)
+
+	assert.Len(t, schedules.MaintenanceWindows, 1)
+	window := schedules.MaintenanceWindows[0]
+	assert.Equal(t, 8, window.Start.Hour())
+	assert.Equal(t, 10*time.Hour, window.End.Sub(window.Start))
+}
+
+func TestMaintenanceWindowBounds(t *testing.T) {
+	windows, err := parseMaintenanceWindows([]models.MaintenanceWindow{{Cron: "0 2 * * *", Duration: "2h"}})
+	assert.NoError(t, err)
+	window := windows[0]
+
+	start, end, active := window.bounds(time.Date(2025, 1, 10, 3, 0, 0, 0, time.UTC))
+	assert.True(t, active)
+	assert.Equal(t, time.Date(2025, 1, 10, 2, 0, 0, 0, time.UTC), start)
+	assert.Equal(t, time.Date(2025, 1, 10, 4, 0, 0, 0, time.UTC), end)
+
+	start, _, active = window.bounds(time.Date(2025, 1, 10, 4, 0, 0, 0, time.UTC))
+	assert.False(t, active, "the end of the window is excluded")
+	assert.Equal(t, time.Date(2025, 1, 11, 2, 0, 0, 0, time.UTC), start)
+
+	_, err = parseMaintenanceWindows([]models.MaintenanceWindow{{Cron: "0 2 * * *", Duration: "0s"}})
+	assert.Error(t, err)
+}
+
+func TestScheduleRun_DefersDisruptiveJobs(t *testing.T) {
+	windows, err := parseMaintenanceWindows([]models.MaintenanceWindow{{Cron: "* * * * *", Duration: "1h"}})
+	assert.NoError(t, err)
+	run := newScheduleRun(windows, time.UTC)
+
+	syncCalled := make(chan bool, 2)
+	sync := run.wrap(models.ScheduleSync, func() { syncCalled <- true }, 0)
+	done := make(chan bool)
+	go func() {
+		sync()
+		done <- true
+	}()
+	assert.Eventually(t, func() bool {
+		return !run.deferredUntil(models.ScheduleSync).IsZero()
+	}, time.Second, 10*time.Millisecond)
+
+	// a run fired while the job is deferred is dropped
+	sync()
+
+	pruneCalled := false
+	run.wrap(models.SchedulePrune, func() { pruneCalled = true }, 0)()
+	assert.True(t, pruneCalled, "prune isn't disruptive")
+
+	close(run.stop)
+	<-done
+	assert.Empty(t, syncCalled, "deferred job is cancelled when the cron is replaced")
+	assert.Zero(t, run.deferredUntil(models.ScheduleSync))
+}
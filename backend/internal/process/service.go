@@ -3,15 +3,19 @@ package process
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"path/filepath"
 	"reflect"
 	"slices"
 	"sync"
+	"time"
 
 	"omar-kada/autonas/internal/docker"
 	"omar-kada/autonas/internal/events"
 	"omar-kada/autonas/internal/git"
+	"omar-kada/autonas/internal/metrics"
 	"omar-kada/autonas/internal/storage"
 	"omar-kada/autonas/models"
 )
@@ -19,17 +23,41 @@ import (
 const (
 	// WorkingBranch is the branch used for temporary deployment changes
 	WorkingBranch = "to_be_deployed"
+
+	defaultStatsDays = 30
+	// MaxStatsDays is the longest window of the statistics, the daily statistics have an entry per day
+	MaxStatsDays = 365
+	// writeBackQueueSize is the number of write backs that can wait before WriteBackConfig blocks
+	writeBackQueueSize = 16
 )
 
 // Service abstracts service deployment operations
 type Service interface {
 	SyncDeployment() (models.Deployment, error)
+	UpdateImages() (models.Deployment, error)
+	PruneImages() error
+	RunStackAction(stack string, action models.StackAction, author string) (models.Deployment, error)
+	RestartContainer(stack, containerID, author string) (models.Deployment, error)
+	IsStackStopped(stack string) bool
+	GetSchedules() (models.Schedules, error)
 	GetCurrentStats(days int) (models.Stats, error)
+	GetDailyStats(days int) ([]models.DailyStats, error)
 	GetDiff() ([]models.FileDiff, error)
 	GetManagedStacks() (map[string][]models.ContainerSummary, error)
+	GetStacksState() (models.StacksState, error)
+	CheckManagedContainer(ctx context.Context, stack, containerID string) error
+	StreamContainerLogs(ctx context.Context, containerID string,
+		options models.ContainerLogsOptions, onLine func(models.ContainerLogLine)) error
 	GetDeployments(limit int, offset uint64) ([]models.Deployment, error)
 	GetDeployment(id uint64) (models.Deployment, error)
+	GetDeploymentLogs(id uint64, limit int, offset uint64) ([]models.DeploymentLog, error)
 	GetNotifications(limit int, offset uint64) ([]models.Event, error)
+	GetConfigRequirements() (map[string][]models.VariableRequirement, error)
+	ValidateConfig(cfg models.Config) error
+	WriteBackConfig(author, message string)
+	ListSecrets() ([]models.Secret, error)
+	SetSecret(name, value string) (models.Secret, error)
+	DeleteSecret(name string) (bool, error)
 }
 
 // NewService creates a new process Service instance
@@ -40,6 +68,8 @@ func NewService(
 	fetcher git.Fetcher,
 	store storage.DeploymentStorage,
 	eventStore storage.EventStorage,
+	logStore storage.DeploymentLogStorage,
+	secretStore storage.SecretStorage,
 	configStore storage.ConfigStore,
 	dispatcher events.Dispatcher,
 	scheduler ConfigScheduler,
@@ -51,11 +81,15 @@ func NewService(
 		fetcher:             fetcher,
 		store:               store,
 		eventStore:          eventStore,
+		logStore:            logStore,
+		secretStore:         secretStore,
 		configStore:         configStore,
 		dispatcher:          dispatcher,
 		params:              deployParams,
 		scheduler:           scheduler,
 		currentCfg:          cfg,
+		stoppedStacks:       make(map[string]bool),
+		writeBacks:          make(chan writeBackRequest, writeBackQueueSize),
 	}
 }
 
@@ -66,6 +100,8 @@ type service struct {
 	fetcher             git.Fetcher
 	store               storage.DeploymentStorage
 	eventStore          storage.EventStorage
+	logStore            storage.DeploymentLogStorage
+	secretStore         storage.SecretStorage
 	configStore         storage.ConfigStore
 	dispatcher          events.Dispatcher
 	scheduler           ConfigScheduler
@@ -73,6 +109,22 @@ type service struct {
 
 	currentCfg models.Config
 	mu         sync.Mutex
+	// deployMu serializes the deployments and the stack actions once they are started,
+	// so that they don't run docker compose on the same stacks at the same time
+	deployMu sync.Mutex
+
+	// stoppedStacks are the stacks stopped or removed by an action, until they are started
+	// again or deployed. It has its own mutex as it's read while the actions run
+	stoppedStacks map[string]bool
+	stoppedMu     sync.Mutex
+
+	writeBacks    chan writeBackRequest
+	writeBackOnce sync.Once
+}
+
+type writeBackRequest struct {
+	author  string
+	message string
 }
 
 func (s *service) SyncDeployment() (models.Deployment, error) {
@@ -93,6 +145,12 @@ func (s *service) SyncDeployment() (models.Deployment, error) {
 	if syncErr != nil && syncErr != git.NoErrAlreadyUpToDate {
 		return models.Deployment{}, fmt.Errorf("error getting config repo:  %w", syncErr)
 	}
+	if cfg.Settings.WriteBack != "" {
+		if cfg, err = s.applyRepoConfig(fetcher, cfg); err != nil {
+			return models.Deployment{}, err
+		}
+		s.currentCfg = cfg
+	}
 
 	// check if the config changed from last run
 	configChanged := !reflect.DeepEqual(oldCfg, cfg)
@@ -120,6 +178,9 @@ func (s *service) SyncDeployment() (models.Deployment, error) {
 		return deployment, err
 	}
 	go func() {
+		s.deployMu.Lock()
+		defer s.deployMu.Unlock()
+
 		err := fetcher.PullBranch(WorkingBranch, "")
 		if err != nil {
 			s.updateDeploymentStatus(ctx, deployment, err)
@@ -132,6 +193,7 @@ func (s *service) SyncDeployment() (models.Deployment, error) {
 			s.updateDeploymentStatus(ctx, deployment, err)
 			return
 		}
+		s.clearStoppedStacks()
 
 		err = fetcher.PullBranch(cfg.GetBranch(), patch.CommitHash)
 		s.updateDeploymentStatus(ctx, deployment, err)
@@ -140,6 +202,46 @@ func (s *service) SyncDeployment() (models.Deployment, error) {
 	return deployment, nil
 }
 
+// UpdateImages pulls the images of the enabled services and recreates the containers
+// whose image changed, the update is recorded as a deployment.
+func (s *service) UpdateImages() (models.Deployment, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	services := s.currentCfg.GetEnabledServices()
+	if len(services) == 0 {
+		slog.Info("No deployed services, skipping the image update")
+		return models.Deployment{}, nil
+	}
+	deployment, err := s.store.InitDeployment("Image update", "", "", []models.FileDiff{})
+	ctx := events.GetDeploymentContext(context.Background(), deployment)
+	s.dispatcher.Dispatch(ctx, models.EventDeploymentStarted, "")
+	if err != nil {
+		return deployment, err
+	}
+	s.deployMu.Lock()
+	defer s.deployMu.Unlock()
+	if errs := s.containersDeployer.WithCtx(ctx).UpdateImages(services, s.params.ServicesDir); len(errs) > 0 {
+		err = fmt.Errorf("error(s) while updating images : %v", errs)
+	}
+	s.updateDeploymentStatus(ctx, deployment, err)
+	return deployment, err
+}
+
+// PruneImages removes the dangling images left by the updates
+func (s *service) PruneImages() error {
+	err := s.containersDeployer.PruneImages()
+	if err != nil {
+		s.dispatcher.Dispatch(context.Background(), models.EventError, err.Error())
+	}
+	return err
+}
+
+// GetSchedules returns the scheduled jobs and the maintenance windows
+func (s *service) GetSchedules() (models.Schedules, error) {
+	return s.scheduler.GetSchedules()
+}
+
 func (s *service) areStacksHealthy(cfg models.Config) bool {
 	state, err := s.getStacksState(cfg)
 	if err != nil {
@@ -186,13 +288,33 @@ enabledServiceLoop:
 }
 
 func (s *service) updateDeploymentStatus(ctx context.Context, deployment models.Deployment, err error) {
+	status := models.DeploymentStatusSuccess
 	if err != nil {
+		status = models.DeploymentStatusError
 		s.dispatcher.Dispatch(ctx, models.EventDeploymentError, err.Error())
-		s.store.EndDeployment(deployment.ID, models.DeploymentStatusError)
 	} else {
 		s.dispatcher.Dispatch(ctx, models.EventDeploymentSuccess, "")
-		s.store.EndDeployment(deployment.ID, models.DeploymentStatusSuccess)
 	}
+	s.store.EndDeployment(deployment.ID, status)
+	s.containersInspector.Invalidate()
+	metrics.ObserveDeployment(status, time.Since(deployment.Time), time.Now())
+}
+
+// GetStacksState returns the health of the enabled stacks
+func (s *service) GetStacksState() (models.StacksState, error) {
+	return s.getStacksState(s.currentCfg)
+}
+
+// CheckManagedContainer returns docker.ErrContainerNotManaged when the container doesn't belong to the stack
+func (s *service) CheckManagedContainer(ctx context.Context, stack, containerID string) error {
+	return s.containersInspector.CheckManagedContainer(ctx, s.params.ServicesDir, stack, containerID)
+}
+
+// StreamContainerLogs calls onLine with the logs of a container, checked with CheckManagedContainer
+func (s *service) StreamContainerLogs(ctx context.Context, containerID string,
+	options models.ContainerLogsOptions, onLine func(models.ContainerLogLine),
+) error {
+	return s.containersInspector.StreamContainerLogs(ctx, containerID, options, onLine)
 }
 
 // GetManagedStacks returns a map of all containers managed by the tool
@@ -201,20 +323,16 @@ func (s *service) GetManagedStacks() (map[string][]models.ContainerSummary, erro
 }
 
 // GetCurrentStats returns the statistics of deployments for the last N days
-func (s *service) GetCurrentStats(_ int) (models.Stats, error) {
-	// TODO change this to either take number of deployment or implement days into it
-	deps, err := s.store.GetDeployments(storage.NewIDCursor(100, 0))
+func (s *service) GetCurrentStats(days int) (models.Stats, error) {
+	from, to := statsWindow(days)
+	deploymentStats, err := s.store.GetStats(from, to)
 	if err != nil {
 		return models.Stats{}, err
 	}
-	var stats models.Stats
-	for _, d := range deps {
-		switch d.Status {
-		case models.DeploymentStatusSuccess:
-			stats.Success++
-		case models.DeploymentStatusError:
-			stats.Error++
-		}
+	stats := models.Stats{DeploymentStats: deploymentStats}
+	deps, err := s.store.GetDeployments(storage.NewIDCursor(1, 0))
+	if err != nil {
+		return models.Stats{}, err
 	}
 	if len(deps) > 0 {
 		last := deps[0]
@@ -229,6 +347,24 @@ func (s *service) GetCurrentStats(_ int) (models.Stats, error) {
 	return stats, nil
 }
 
+// GetDailyStats returns the number of deployments per day for the last N days
+func (s *service) GetDailyStats(days int) ([]models.DailyStats, error) {
+	from, to := statsWindow(days)
+	return s.store.GetDailyStats(from, to)
+}
+
+// statsWindow returns the time window of the last N days, including today, of at most MaxStatsDays
+func statsWindow(days int) (from, to time.Time) {
+	if days <= 0 {
+		days = defaultStatsDays
+	}
+	days = min(days, MaxStatsDays)
+	to = time.Now()
+	now := to.UTC()
+	from = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC).AddDate(0, 0, 1-days)
+	return from, to
+}
+
 // GetDiff returns the changed files between what's deployed and the repo
 func (s *service) GetDiff() ([]models.FileDiff, error) {
 	cfg, err := s.getConfig()
@@ -263,3 +399,120 @@ func (s *service) GetNotifications(limit int, offset uint64) ([]models.Event, er
 func (s *service) GetDeployment(id uint64) (models.Deployment, error) {
 	return s.store.GetDeployment(id)
 }
+
+// GetDeploymentLogs returns a paginated list of the logs of a deployment, the latest first.
+func (s *service) GetDeploymentLogs(id uint64, limit int, offset uint64) ([]models.DeploymentLog, error) {
+	return s.logStore.GetLogs(id, storage.NewIDCursor(limit, offset))
+}
+
+// GetConfigRequirements returns, per enabled service, the variables referenced by
+// its compose file in the repo that have no value.
+func (s *service) GetConfigRequirements() (map[string][]models.VariableRequirement, error) {
+	cfg, err := s.configStore.Get()
+	if err != nil {
+		return nil, err
+	}
+	repoServicesDir := filepath.Join(s.params.GetRepoDir(), "services")
+	requirements := make(map[string][]models.VariableRequirement)
+	for _, service := range cfg.GetEnabledServices() {
+		missing, err := docker.MissingVariables(cfg, repoServicesDir, service)
+		if err != nil {
+			return nil, fmt.Errorf("error checking variables of %s : %w", service, err)
+		}
+		requirements[service] = missing
+	}
+	return requirements, nil
+}
+
+// ValidateConfig checks the configuration, services must have a directory in the repo once it is fetched.
+func (s *service) ValidateConfig(cfg models.Config) error {
+	return storage.ValidateConfig(cfg, filepath.Join(s.params.GetRepoDir(), "services"))
+}
+
+// WriteBackConfig queues the commit of the environment and services of the config file to the repo,
+// the write backs run one at a time in the background and their failures are dispatched as errors
+func (s *service) WriteBackConfig(author, message string) {
+	s.writeBackOnce.Do(func() {
+		go s.runWriteBacks()
+	})
+	s.writeBacks <- writeBackRequest{author: author, message: message}
+}
+
+func (s *service) runWriteBacks() {
+	for request := range s.writeBacks {
+		if err := s.writeBackConfig(request.author, request.message); err != nil {
+			slog.Warn(err.Error())
+			s.dispatcher.Dispatch(context.Background(), models.EventError, err.Error())
+		}
+	}
+}
+
+// writeBackConfig patches the environment and services of the config file of the repo
+// when the write back is enabled, settings are never committed since they hold credentials,
+// and neither are the values of the sensitive variables.
+func (s *service) writeBackConfig(author, message string) error {
+	cfg, err := s.configStore.Get()
+	if err != nil {
+		return err
+	}
+	if cfg.Settings.WriteBack == "" || cfg.Settings.Repo == "" {
+		return nil
+	}
+	base, err := s.configStore.GetBase()
+	if err != nil {
+		return err
+	}
+	branch, err := s.fetcher.WithConfig(cfg).CommitFile(git.ConfigFile, func(content []byte) ([]byte, error) {
+		return storage.PatchRepoConfig(content, base)
+	}, author, message)
+	if err != nil {
+		return fmt.Errorf("couldn't write back the configuration to the repo : %w", err)
+	}
+	if branch != "" {
+		s.dispatcher.Dispatch(context.Background(), models.EventMisc,
+			fmt.Sprintf("Configuration committed to %s on branch %s", git.ConfigFile, branch))
+	}
+	return nil
+}
+
+// applyRepoConfig updates the environment and services of the config file with the config file
+// of the repo when the write back is enabled, since the repo is the source of these values.
+// The configuration to deploy is returned.
+func (s *service) applyRepoConfig(fetcher git.Fetcher, cfg models.Config) (models.Config, error) {
+	content, err := fetcher.ReadRemoteFile(git.ConfigFile)
+	if errors.Is(err, git.ErrFileNotFound) {
+		return cfg, nil
+	} else if err != nil {
+		return cfg, fmt.Errorf("couldn't read the configuration of the repo : %w", err)
+	}
+	base, err := s.configStore.GetBase()
+	if err != nil {
+		return cfg, err
+	}
+	merged, err := storage.MergeRepoConfig(base, content)
+	if err != nil {
+		return cfg, fmt.Errorf("invalid configuration in the repo : %w", err)
+	}
+	if reflect.DeepEqual(base, merged) {
+		return cfg, nil
+	}
+	if _, err := s.configStore.UpdateAs(merged, "", "Configuration synced from the repo", ""); err != nil {
+		return cfg, fmt.Errorf("couldn't apply the configuration of the repo : %w", err)
+	}
+	return s.configStore.Get()
+}
+
+// ListSecrets returns the stored secrets, without their values.
+func (s *service) ListSecrets() ([]models.Secret, error) {
+	return s.secretStore.ListSecrets()
+}
+
+// SetSecret creates or updates a secret.
+func (s *service) SetSecret(name, value string) (models.Secret, error) {
+	return s.secretStore.SetSecret(name, value)
+}
+
+// DeleteSecret deletes a secret.
+func (s *service) DeleteSecret(name string) (bool, error) {
+	return s.secretStore.DeleteSecret(name)
+}
@@ -3,6 +3,9 @@ package process
 import (
 	"context"
 	"errors"
+	"math"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -42,6 +45,26 @@ func (m *Mocker) RemoveAndDeployStacks(oldCfg, cfg models.Config, params models.
 	return args.Error(0)
 }
 
+func (m *Mocker) UpdateImages(services []string, servicesDir string) map[string]error {
+	args := m.Called(services, servicesDir)
+	return args.Get(0).(map[string]error)
+}
+
+func (m *Mocker) PruneImages() error {
+	args := m.Called()
+	return args.Error(0)
+}
+
+func (m *Mocker) RunStackAction(stack string, action models.StackAction, servicesDir string) error {
+	args := m.Called(stack, action, servicesDir)
+	return args.Error(0)
+}
+
+func (m *Mocker) RestartContainer(stack, containerID string) error {
+	args := m.Called(stack, containerID)
+	return args.Error(0)
+}
+
 func (m *Mocker) GetManagedStacks(servicesDir string) (map[string][]models.ContainerSummary, error) {
 	args := m.Called(servicesDir)
 	return args.Get(0).(map[string][]models.ContainerSummary), args.Error(1)
@@ -52,6 +75,31 @@ func (m *Mocker) GetServiceContainers(serviceName string, servicesDir string) ([
 	return args.Get(0).([]string), args.Error(1)
 }
 
+func (m *Mocker) CheckManagedContainer(ctx context.Context, servicesDir, stack, containerID string) error {
+	args := m.Called(ctx, servicesDir, stack, containerID)
+	return args.Error(0)
+}
+
+func (m *Mocker) StreamContainerLogs(ctx context.Context, containerID string,
+	options models.ContainerLogsOptions, onLine func(models.ContainerLogLine),
+) error {
+	args := m.Called(ctx, containerID, options, onLine)
+	return args.Error(0)
+}
+
+func (m *Mocker) Watch(ctx context.Context, onEvent func(models.ContainerEvent)) {
+	m.Called(ctx, onEvent)
+}
+
+func (m *Mocker) Invalidate() {
+	m.Called()
+}
+
+func (m *Mocker) ReadContainerStats(ctx context.Context, containerID string) (container.StatsResponse, error) {
+	args := m.Called(ctx, containerID)
+	return args.Get(0).(container.StatsResponse), args.Error(1)
+}
+
 func (m *Mocker) GetNext() time.Time {
 	args := m.Called()
 	return args.Get(0).(time.Time)
@@ -67,6 +115,15 @@ func (m *Mocker) ReSchedule() (*cron.Cron, error) {
 	return args.Get(0).(*cron.Cron), args.Error(1)
 }
 
+func (m *Mocker) SetJob(name models.ScheduleName, fn func()) {
+	m.Called(name, fn)
+}
+
+func (m *Mocker) GetSchedules() (models.Schedules, error) {
+	args := m.Called()
+	return args.Get(0).(models.Schedules), args.Error(1)
+}
+
 func (m *Mocker) ClearRepo() error {
 	args := m.Called()
 	return args.Error(0)
@@ -92,6 +149,21 @@ func (m *Mocker) DiffWithRemote() (git.Patch, error) {
 	return args.Get(0).(git.Patch), args.Error(1)
 }
 
+// CommitFile is called with the content of the patched file, missing from the repo
+func (m *Mocker) CommitFile(path string, patch git.FilePatch, author, message string) (string, error) {
+	content, err := patch(nil)
+	if err != nil {
+		return "", err
+	}
+	args := m.Called(path, string(content), author, message)
+	return args.String(0), args.Error(1)
+}
+
+func (m *Mocker) ReadRemoteFile(path string) ([]byte, error) {
+	args := m.Called(path)
+	return args.Get(0).([]byte), args.Error(1)
+}
+
 var (
 	mockConfigOld = models.Config{
 		Settings: models.Settings{
@@ -139,12 +211,17 @@ func newServiceWithCurrentConfig(t *testing.T, mocker *Mocker, params models.Dep
 	configStore := storage.NewConfigStore(t.TempDir() + "/config.yaml")
 	configStore.Update(currentCfg)
 	depStore, eventStore := initStores(t)
+	secretStore, err := storage.NewSecretStorage(testutil.NewMemoryStorage(), []byte("master"))
+	if err != nil {
+		t.Fatalf("error creating secret storage : %v", err)
+	}
+	mocker.On("Invalidate").Maybe()
 	svc := NewService(
 		params,
 		mocker,
 		mocker,
 		mocker,
-		depStore, eventStore,
+		depStore, eventStore, nil, secretStore,
 		configStore,
 		events.NewVoidDispatcher(),
 		mocker,
@@ -425,6 +502,165 @@ func TestGetDiff_ErrorDiffWithRemote(t *testing.T) {
 	mocker.AssertExpectations(t)
 }
 
+func TestWriteBackConfig(t *testing.T) {
+	mocker := &Mocker{}
+	cfg := models.Config{
+		Settings: models.Settings{
+			Repo:      "https://example.com/repo.git",
+			Token:     "secret-token",
+			WriteBack: models.WriteBackCommit,
+		},
+		Environment: models.Environment{"A": "1", "DB_PASSWORD": "plain", "DB_URL": "secret://db-url"},
+		Services:    map[string]models.ServiceConfig{"svc1": {"PORT": "8080", "API_TOKEN": "plain"}},
+	}
+	service := newServiceWithCurrentConfig(t, mocker, models.DeploymentParams{}, cfg)
+
+	// the sensitive values are left out, only their references are committed
+	mocker.On("WithConfig", mock.Anything).Return(service.fetcher)
+	mocker.On("CommitFile", git.ConfigFile,
+		"environment:\n  A: \"1\"\n  DB_URL: secret://db-url\nservices:\n  svc1:\n    PORT: \"8080\"\n",
+		"admin", "configuration updated").Return("main", nil)
+
+	assert.NoError(t, service.writeBackConfig("admin", "configuration updated"))
+	mocker.AssertExpectations(t)
+}
+
+func TestWriteBackConfig_Disabled(t *testing.T) {
+	mocker := &Mocker{}
+	cfg := models.Config{Settings: models.Settings{Repo: "https://example.com/repo.git"}}
+	service := newServiceWithCurrentConfig(t, mocker, models.DeploymentParams{}, cfg)
+
+	assert.NoError(t, service.writeBackConfig("admin", "configuration updated"))
+	mocker.AssertNotCalled(t, "CommitFile", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestWriteBackConfig_Error(t *testing.T) {
+	mocker := &Mocker{}
+	cfg := models.Config{Settings: models.Settings{Repo: "https://example.com/repo.git", WriteBack: models.WriteBackBranch}}
+	service := newServiceWithCurrentConfig(t, mocker, models.DeploymentParams{}, cfg)
+
+	mocker.On("WithConfig", mock.Anything).Return(service.fetcher)
+	mocker.On("CommitFile", git.ConfigFile, mock.Anything, "admin", "settings updated").Return("", ErrFetch)
+
+	err := service.writeBackConfig("admin", "settings updated")
+	assert.ErrorIs(t, err, ErrFetch)
+	assert.ErrorContains(t, err, "couldn't write back the configuration")
+}
+
+func TestWriteBackConfig_SyncDeploysTheRepoConfig(t *testing.T) {
+	mocker := &Mocker{}
+	params := models.DeploymentParams{WorkingDir: t.TempDir(), ServicesDir: "/services"}
+	cfg := models.Config{
+		Settings: models.Settings{
+			Repo:      testutil.SetupRemoteRepo(t),
+			Branch:    "main",
+			WriteBack: models.WriteBackCommit,
+		},
+		Environment: models.Environment{"TZ": "UTC", "DB_PASSWORD": "plain"},
+		Services:    map[string]models.ServiceConfig{"web": {"PORT": "80"}},
+	}
+	service := newServiceWithCurrentConfig(t, mocker, params, cfg)
+	service.fetcher = git.NewFetcher(os.FileMode(0o000), params.GetRepoDir())
+	assert.NoError(t, service.writeBackConfig("admin", "configuration updated"))
+
+	// the local config drifts from the one written back
+	drifted := cfg
+	drifted.Environment = models.Environment{"TZ": "Europe/Paris", "DB_PASSWORD": "plain"}
+	drifted.Services = map[string]models.ServiceConfig{"web": {"PORT": "81"}, "db": {}}
+	assert.NoError(t, service.configStore.Update(drifted))
+	service.currentCfg = drifted
+
+	deployed := make(chan models.Config, 1)
+	mocker.On("GetManagedStacks", mock.Anything).Return(map[string][]models.ContainerSummary{}, nil)
+	mocker.On("GetServiceContainers", mock.Anything, mock.Anything).Return([]string{"container"}, nil)
+	mocker.On("RemoveAndDeployStacks", drifted, mock.Anything, params).Return(nil).
+		Run(func(args mock.Arguments) { deployed <- args.Get(1).(models.Config) })
+
+	_, err := service.SyncDeployment()
+	assert.NoError(t, err)
+
+	got, _ := testutil.WaitForChannel(t, deployed, time.Second, "timeout waiting for the deployment")
+	assert.Equal(t, cfg.Environment, got.Environment, "the sensitive value is kept from the local config")
+	assert.Equal(t, cfg.Services, got.Services)
+	base, err := service.configStore.GetBase()
+	assert.NoError(t, err)
+	assert.Equal(t, cfg.Environment, base.Environment)
+	assert.Equal(t, cfg.Services, base.Services)
+}
+
+type channelHandler chan models.Event
+
+func (h channelHandler) HandleEvent(_ context.Context, event models.Event) {
+	h <- event
+}
+
+func TestWriteBackConfig_Queued(t *testing.T) {
+	mocker := &Mocker{}
+	cfg := models.Config{Settings: models.Settings{Repo: "https://example.com/repo.git", WriteBack: models.WriteBackBranch}}
+	service := newServiceWithCurrentConfig(t, mocker, models.DeploymentParams{}, cfg)
+	handler := make(channelHandler, 10)
+	service.dispatcher = events.NewDefaultDispatcher([]events.EventHandler{handler})
+
+	started, release := make(chan struct{}), make(chan struct{})
+	mocker.On("WithConfig", mock.Anything).Return(service.fetcher)
+	mocker.On("CommitFile", git.ConfigFile, mock.Anything, "admin", "first").Run(func(_ mock.Arguments) {
+		close(started)
+		<-release
+	}).Return("autonas-1", nil).Once()
+	mocker.On("CommitFile", git.ConfigFile, mock.Anything, "admin", "second").Return("", ErrFetch).Once()
+
+	// the write backs don't wait for the commits, which run one at a time
+	service.WriteBackConfig("admin", "first")
+	service.WriteBackConfig("admin", "second")
+	<-started
+	assert.Empty(t, handler)
+
+	close(release)
+	committed := <-handler
+	assert.Equal(t, models.EventMisc, committed.Type)
+	assert.Contains(t, committed.Msg, "autonas-1")
+	failed := <-handler
+	assert.Equal(t, models.EventError, failed.Type)
+	assert.Contains(t, failed.Msg, "couldn't write back the configuration")
+}
+
+func TestUpdateImages(t *testing.T) {
+	mocker := &Mocker{}
+	cfg := models.Config{Services: map[string]models.ServiceConfig{"svc1": {}}}
+	service := newServiceWithCurrentConfig(t, mocker, models.DeploymentParams{ServicesDir: "/services"}, cfg)
+
+	mocker.On("UpdateImages", []string{"svc1"}, "/services").Return(map[string]error{}).Once()
+	deployment, err := service.UpdateImages()
+	assert.NoError(t, err)
+	assert.Equal(t, "Image update", deployment.Title)
+	stored, _ := service.GetDeployment(deployment.ID)
+	assert.Equal(t, models.DeploymentStatusSuccess, stored.Status)
+
+	mocker.On("UpdateImages", []string{"svc1"}, "/services").Return(map[string]error{"svc1": ErrFetch}).Once()
+	deployment, err = service.UpdateImages()
+	assert.ErrorContains(t, err, "error(s) while updating images")
+	stored, _ = service.GetDeployment(deployment.ID)
+	assert.Equal(t, models.DeploymentStatusError, stored.Status)
+}
+
+func TestUpdateImages_NoServices(t *testing.T) {
+	mocker := &Mocker{}
+	service := newServiceWithMocks(t, mocker, models.DeploymentParams{})
+
+	deployment, err := service.UpdateImages()
+	assert.NoError(t, err)
+	assert.Zero(t, deployment.ID)
+	mocker.AssertNotCalled(t, "UpdateImages", mock.Anything, mock.Anything)
+}
+
+func TestPruneImages(t *testing.T) {
+	mocker := &Mocker{}
+	service := newServiceWithMocks(t, mocker, models.DeploymentParams{})
+	mocker.On("PruneImages").Return(ErrFetch)
+
+	assert.ErrorIs(t, service.PruneImages(), ErrFetch)
+}
+
 // Edge case tests
 
 func TestSync_ErrorGettingConfig(t *testing.T) {
@@ -441,7 +677,7 @@ func TestSync_ErrorGettingConfig(t *testing.T) {
 		mocker,
 		mocker,
 		mocker,
-		depStore, eventStore,
+		depStore, eventStore, nil, nil,
 		configStore,
 		events.NewVoidDispatcher(),
 		mocker,
@@ -606,8 +842,9 @@ func TestGetCurrentStats_ErrorGettingStacks(t *testing.T) {
 
 	assert.NoError(t, err)
 	assert.Equal(t, models.Stats{
-		NextDeploy: next,
-		Health:     models.StackStatusUnknown,
+		DeploymentStats: models.DeploymentStats{Services: []models.ServiceStats{}},
+		NextDeploy:      next,
+		Health:          models.StackStatusUnknown,
 	}, stats)
 }
 
@@ -642,6 +879,45 @@ func TestGetCurrentStats_MultipleDeploymentsVariousStatuses(t *testing.T) {
 	assert.Equal(t, next, stats.NextDeploy)
 }
 
+func TestGetCurrentStats_PerService(t *testing.T) {
+	mocker := &Mocker{}
+	service := newServiceWithMocks(t, mocker, models.DeploymentParams{})
+	mocker.On("GetNext").Return(time.Time{})
+	mocker.On("GetManagedStacks", mock.Anything).Return(map[string][]models.ContainerSummary{}, nil)
+
+	dep1, _ := service.store.InitDeployment("first", "alice", "", []models.FileDiff{{OldFile: "services/web/compose.yaml", NewFile: "services/web/compose.yaml"}})
+	service.store.EndDeployment(dep1.ID, models.DeploymentStatusError)
+	dep2, _ := service.store.InitDeployment("second", "alice", "", []models.FileDiff{{OldFile: "services/web/compose.yaml", NewFile: "services/web/compose.yaml"}})
+	service.store.EndDeployment(dep2.ID, models.DeploymentStatusSuccess)
+
+	stats, err := service.GetCurrentStats(0)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []models.ServiceStats{{Service: "web", Deployments: 2, Errors: 1}}, stats.Services)
+	assert.Equal(t, 0.5, stats.Services[0].FailureRate())
+	assert.Positive(t, stats.MeanTimeToRecovery)
+}
+
+func TestGetDailyStats(t *testing.T) {
+	mocker := &Mocker{}
+	service := newServiceWithMocks(t, mocker, models.DeploymentParams{})
+
+	dep, _ := service.store.InitDeployment("first", "alice", "", nil)
+	service.store.EndDeployment(dep.ID, models.DeploymentStatusSuccess)
+
+	days, err := service.GetDailyStats(7)
+
+	assert.NoError(t, err)
+	assert.Len(t, days, 7)
+	today := days[len(days)-1]
+	assert.Equal(t, time.Now().UTC().Format(time.DateOnly), today.Day.Format(time.DateOnly))
+	assert.Equal(t, int32(1), today.Success)
+
+	days, err = service.GetDailyStats(math.MaxInt32)
+	assert.NoError(t, err)
+	assert.Len(t, days, MaxStatsDays, "the window is bounded")
+}
+
 func TestGetDiff_NoCurrentConfigUsingConfigStore(t *testing.T) {
 	mocker := &Mocker{}
 	configStore := storage.NewConfigStore(t.TempDir() + "/config.yaml")
@@ -653,7 +929,7 @@ func TestGetDiff_NoCurrentConfigUsingConfigStore(t *testing.T) {
 		mocker,
 		mocker,
 		mocker,
-		depStore, eventStore,
+		depStore, eventStore, nil, nil,
 		configStore,
 		events.NewVoidDispatcher(),
 		mocker,
@@ -682,7 +958,7 @@ func TestGetDiff_ErrorGettingConfigFromStore(t *testing.T) {
 		mocker,
 		mocker,
 		mocker,
-		depStore, eventStore,
+		depStore, eventStore, nil, nil,
 		configStore,
 		events.NewVoidDispatcher(),
 		mocker,
@@ -768,3 +1044,40 @@ func TestSync_RepositoryAlreadyUpToDate(t *testing.T) {
 	assert.Equal(t, models.Deployment{}, dep)
 	mocker.AssertExpectations(t)
 }
+
+func TestGetConfigRequirements(t *testing.T) {
+	mocker := &Mocker{}
+	workingDir := t.TempDir()
+	service := newServiceWithMocks(t, mocker, models.DeploymentParams{WorkingDir: workingDir})
+	service.configStore.Update(mockConfigOld)
+
+	serviceDir := filepath.Join(workingDir, "repo", "services", "svc1")
+	assert.NoError(t, os.MkdirAll(serviceDir, 0o750))
+	assert.NoError(t, os.WriteFile(filepath.Join(serviceDir, "compose.yaml"),
+		[]byte("services:\n  app:\n    image: app:${VERSION}\n    ports: ['${PORT}:80', '${MISSING:?}:81']\n"), 0o600))
+
+	requirements, err := service.GetConfigRequirements()
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[string][]models.VariableRequirement{
+		"svc1": {{Name: "MISSING", Required: true}},
+		"svc2": nil,
+	}, requirements)
+}
+
+func TestSecrets(t *testing.T) {
+	mocker := &Mocker{}
+	service := newServiceWithMocks(t, mocker, models.DeploymentParams{})
+
+	_, err := service.SetSecret("db_password", "value")
+	assert.NoError(t, err)
+
+	secrets, err := service.ListSecrets()
+	assert.NoError(t, err)
+	assert.Len(t, secrets, 1)
+	assert.Equal(t, "db_password", secrets[0].Name)
+
+	deleted, err := service.DeleteSecret("db_password")
+	assert.NoError(t, err)
+	assert.True(t, deleted)
+}
@@ -0,0 +1,124 @@
+package process
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"omar-kada/autonas/internal/docker"
+	"omar-kada/autonas/internal/events"
+	"omar-kada/autonas/models"
+)
+
+// ErrStackNotFound is returned for the stacks that aren't enabled in the configuration
+var ErrStackNotFound = errors.New("stack not found")
+
+// ErrInvalidStackAction is returned for the unknown actions
+var ErrInvalidStackAction = errors.New("invalid stack action")
+
+// RunStackAction runs an action on an enabled stack, the action is recorded as a deployment
+// started by author. The started deployment is returned, the action runs in the background
+func (s *service) RunStackAction(stack string, action models.StackAction, author string) (models.Deployment, error) {
+	if !action.IsValid() {
+		return models.Deployment{}, fmt.Errorf("%w %q", ErrInvalidStackAction, action)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !slices.Contains(s.currentCfg.GetEnabledServices(), stack) {
+		return models.Deployment{}, fmt.Errorf("%w : %s", ErrStackNotFound, stack)
+	}
+	if action == models.StackActionStop || action == models.StackActionDown {
+		// set before the action, so that the stopping stack isn't restarted meanwhile
+		s.setStackStopped(stack, true)
+	}
+	title := fmt.Sprintf("%s %s", actionTitle(action), stack)
+	return s.runAction(title, author, func(deployer docker.Deployer) error {
+		if err := deployer.RunStackAction(stack, action, s.params.ServicesDir); err != nil {
+			return err
+		}
+		if action == models.StackActionStart || action == models.StackActionPullAndRecreate {
+			s.setStackStopped(stack, false)
+		}
+		return nil
+	})
+}
+
+// IsStackStopped returns true when the stack was stopped or removed by an action,
+// and wasn't started or deployed since
+func (s *service) IsStackStopped(stack string) bool {
+	s.stoppedMu.Lock()
+	defer s.stoppedMu.Unlock()
+	return s.stoppedStacks[stack]
+}
+
+func (s *service) setStackStopped(stack string, stopped bool) {
+	s.stoppedMu.Lock()
+	defer s.stoppedMu.Unlock()
+	if stopped {
+		s.stoppedStacks[stack] = true
+	} else {
+		delete(s.stoppedStacks, stack)
+	}
+}
+
+// clearStoppedStacks is called once the stacks are deployed, which starts them all
+func (s *service) clearStoppedStacks() {
+	s.stoppedMu.Lock()
+	defer s.stoppedMu.Unlock()
+	clear(s.stoppedStacks)
+}
+
+// RestartContainer restarts a container of an enabled stack, given its ID or its compose
+// service name, the restart is recorded as a deployment started by author and runs in the background
+func (s *service) RestartContainer(stack, containerID, author string) (models.Deployment, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !slices.Contains(s.currentCfg.GetEnabledServices(), stack) {
+		return models.Deployment{}, fmt.Errorf("%w : %s", ErrStackNotFound, stack)
+	}
+	stacks, err := s.containersInspector.GetManagedStacks(s.params.ServicesDir)
+	if err != nil {
+		return models.Deployment{}, err
+	}
+	i := slices.IndexFunc(stacks[stack], func(ctr models.ContainerSummary) bool {
+		return ctr.ID == containerID || ctr.Name == containerID
+	})
+	if i < 0 {
+		return models.Deployment{}, docker.ErrContainerNotManaged
+	}
+	ctr := stacks[stack][i]
+	title := fmt.Sprintf("Restart %s/%s", stack, ctr.Name)
+	return s.runAction(title, author, func(deployer docker.Deployer) error {
+		return deployer.RestartContainer(stack, ctr.ID)
+	})
+}
+
+// runAction records the action as a deployment and runs it in the background once the
+// running deployments ended, the error of the action is recorded as the status of the deployment
+func (s *service) runAction(title, author string, action func(deployer docker.Deployer) error) (models.Deployment, error) {
+	deployment, err := s.store.InitDeployment(title, author, "", []models.FileDiff{})
+	ctx := events.GetDeploymentContext(context.Background(), deployment)
+	s.dispatcher.Dispatch(ctx, models.EventDeploymentStarted, "")
+	if err != nil {
+		return deployment, err
+	}
+	go func() {
+		s.deployMu.Lock()
+		defer s.deployMu.Unlock()
+
+		err := action(s.containersDeployer.WithCtx(ctx))
+		s.updateDeploymentStatus(ctx, deployment, err)
+	}()
+	return deployment, nil
+}
+
+func actionTitle(action models.StackAction) string {
+	if action == models.StackActionPullAndRecreate {
+		return "Pull and recreate"
+	}
+	return strings.ToUpper(string(action[:1])) + string(action[1:])
+}
@@ -0,0 +1,119 @@
+package process
+
+import (
+	"testing"
+	"time"
+
+	"omar-kada/autonas/internal/docker"
+	"omar-kada/autonas/internal/git"
+	"omar-kada/autonas/models"
+	"omar-kada/autonas/testutil"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var stackActionsConfig = models.Config{Services: map[string]models.ServiceConfig{"web": {}}}
+
+func TestRunStackAction(t *testing.T) {
+	mocker := &Mocker{}
+	service := newServiceWithCurrentConfig(t, mocker, models.DeploymentParams{ServicesDir: "/services"}, stackActionsConfig)
+
+	mocker.On("RunStackAction", "web", models.StackActionRestart, "/services").Return(nil).Once()
+	deployment, err := service.RunStackAction("web", models.StackActionRestart, "admin")
+	assert.NoError(t, err)
+	assert.Equal(t, "Restart web", deployment.Title)
+	assert.Equal(t, "admin", deployment.Author)
+	assert.Equal(t, models.DeploymentStatusRunning, deployment.Status, "the started deployment is returned")
+	assert.Equal(t, models.DeploymentStatusSuccess, waitDeploymentEnded(t, service, deployment.ID).Status)
+
+	mocker.On("RunStackAction", "web", models.StackActionPullAndRecreate, "/services").Return(ErrFetch).Once()
+	deployment, err = service.RunStackAction("web", models.StackActionPullAndRecreate, "admin")
+	assert.NoError(t, err, "the error of the action is recorded in the deployment")
+	assert.Equal(t, "Pull and recreate web", deployment.Title)
+	assert.Equal(t, models.DeploymentStatusError, waitDeploymentEnded(t, service, deployment.ID).Status)
+}
+
+func waitDeploymentEnded(t *testing.T, service *service, id uint64) models.Deployment {
+	t.Helper()
+	var deployment models.Deployment
+	assert.Eventually(t, func() bool {
+		dep, err := service.store.GetDeployment(id)
+		deployment = dep
+		return err == nil && dep.Status != models.DeploymentStatusRunning
+	}, time.Second, 5*time.Millisecond)
+	return deployment
+}
+
+func TestRunStackAction_WaitsForTheSync(t *testing.T) {
+	mocker := &Mocker{}
+	service := newServiceWithMocks(t, mocker, models.DeploymentParams{ServicesDir: "/services"})
+	cfg := stackActionsConfig
+	cfg.Settings = models.Settings{Repo: "https://example.com/repo.git", Branch: "main"}
+	service.configStore.Update(cfg)
+	mocker.On("WithConfig", mock.Anything).Return(service.fetcher)
+	mocker.On("DiffWithRemote").Return(git.Patch{Diff: "test"}, nil)
+	mocker.On("PullBranch", mock.Anything, mock.Anything).Return(nil)
+	mocker.On("GetManagedStacks", mock.Anything).Return(map[string][]models.ContainerSummary{}, nil)
+	mocker.On("GetServiceContainers", mock.Anything, mock.Anything).Return([]string{"container"}, nil)
+	deploying, release := make(chan struct{}), make(chan struct{})
+	mocker.On("RemoveAndDeployStacks", mock.Anything, mock.Anything, service.params).Return(nil).
+		Run(func(mock.Arguments) {
+			close(deploying)
+			<-release
+		})
+	actionDone := make(chan struct{})
+	mocker.On("RunStackAction", "web", models.StackActionRestart, "/services").Return(nil).
+		Run(func(mock.Arguments) {
+			select {
+			case <-release:
+			default:
+				t.Error("the action ran during the sync")
+			}
+			close(actionDone)
+		})
+
+	_, err := service.SyncDeployment()
+	assert.NoError(t, err)
+	testutil.WaitForChannel(t, deploying, time.Second, "timeout waiting for the sync")
+	_, err = service.RunStackAction("web", models.StackActionRestart, "admin")
+	assert.NoError(t, err)
+
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	testutil.WaitForChannel(t, actionDone, time.Second, "timeout waiting for the action")
+}
+
+func TestRunStackAction_Rejected(t *testing.T) {
+	mocker := &Mocker{}
+	service := newServiceWithCurrentConfig(t, mocker, models.DeploymentParams{ServicesDir: "/services"}, stackActionsConfig)
+
+	_, err := service.RunStackAction("other", models.StackActionStop, "admin")
+	assert.ErrorIs(t, err, ErrStackNotFound)
+	_, err = service.RunStackAction("web", "kill", "admin")
+	assert.ErrorIs(t, err, ErrInvalidStackAction)
+	mocker.AssertNotCalled(t, "RunStackAction", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestRestartContainer(t *testing.T) {
+	mocker := &Mocker{}
+	service := newServiceWithCurrentConfig(t, mocker, models.DeploymentParams{ServicesDir: "/services"}, stackActionsConfig)
+	mocker.On("GetManagedStacks", "/services").Return(map[string][]models.ContainerSummary{
+		"web":   {{ID: "abc123", Name: "nginx", StartedAt: time.Now()}},
+		"other": {{ID: "def456", Name: "db"}},
+	}, nil)
+	mocker.On("RestartContainer", "web", "abc123").Return(nil)
+
+	for _, id := range []string{"abc123", "nginx"} {
+		deployment, err := service.RestartContainer("web", id, "admin")
+		assert.NoError(t, err)
+		assert.Equal(t, "Restart web/nginx", deployment.Title)
+		assert.Equal(t, models.DeploymentStatusSuccess, waitDeploymentEnded(t, service, deployment.ID).Status)
+	}
+
+	_, err := service.RestartContainer("web", "def456", "admin")
+	assert.ErrorIs(t, err, docker.ErrContainerNotManaged)
+	_, err = service.RestartContainer("other", "def456", "admin")
+	assert.ErrorIs(t, err, ErrStackNotFound)
+	mocker.AssertNumberOfCalls(t, "RestartContainer", 2)
+}
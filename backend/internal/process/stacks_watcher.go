@@ -0,0 +1,68 @@
+package process
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"omar-kada/autonas/internal/events"
+	"omar-kada/autonas/models"
+)
+
+// StacksStateSource gives the state of the enabled stacks
+type StacksStateSource interface {
+	GetStacksState() (models.StacksState, error)
+}
+
+// StacksWatcher dispatches an event when the status of a stack changes
+type StacksWatcher struct {
+	source     StacksStateSource
+	dispatcher events.Dispatcher
+	statuses   map[string]models.StackStatus
+}
+
+// NewStacksWatcher creates a watcher of the stacks of source
+func NewStacksWatcher(source StacksStateSource, dispatcher events.Dispatcher) *StacksWatcher {
+	return &StacksWatcher{
+		source:     source,
+		dispatcher: dispatcher,
+	}
+}
+
+// Run checks the stacks every interval until ctx is done
+func (w *StacksWatcher) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		w.Check(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Check dispatches an event for each stack with a different status than the previous check,
+// the first check only records the statuses
+func (w *StacksWatcher) Check(ctx context.Context) {
+	state, err := w.source.GetStacksState()
+	if err != nil {
+		slog.Warn("couldn't get the state of the stacks", "error", err)
+		return
+	}
+	statuses := make(map[string]models.StackStatus)
+	for _, stack := range state.Services() {
+		status := state.ForService(stack)
+		statuses[stack] = status
+		if w.statuses == nil {
+			continue
+		}
+		if old, ok := w.statuses[stack]; ok && old != status {
+			w.dispatcher.Dispatch(events.GetStackContext(ctx, stack), models.EventStackStatusChanged,
+				fmt.Sprintf("%s -> %s", old, status))
+		}
+	}
+	w.statuses = statuses
+}
@@ -0,0 +1,62 @@
+package process
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"omar-kada/autonas/internal/events"
+	"omar-kada/autonas/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeStacksSource struct {
+	statuses map[string]models.StackStatus
+	err      error
+}
+
+func (s *fakeStacksSource) GetStacksState() (models.StacksState, error) {
+	state := models.NewStacksState()
+	for stack, status := range s.statuses {
+		state.ProgressiveUpdateServiceStatus(stack, status)
+	}
+	return state, s.err
+}
+
+type recordingHandler struct {
+	events []models.Event
+}
+
+func (h *recordingHandler) HandleEvent(_ context.Context, event models.Event) {
+	h.events = append(h.events, event)
+}
+
+func TestStacksWatcher_Check(t *testing.T) {
+	source := &fakeStacksSource{statuses: map[string]models.StackStatus{
+		"web": models.StackStatusStarting,
+		"db":  models.StackStatusHealthy,
+	}}
+	handler := &recordingHandler{}
+	watcher := NewStacksWatcher(source, events.NewDefaultDispatcher([]events.EventHandler{handler}))
+
+	watcher.Check(context.Background())
+	assert.Empty(t, handler.events, "the first check records the statuses")
+
+	source.statuses["web"] = models.StackStatusHealthy
+	source.statuses["new"] = models.StackStatusHealthy
+	watcher.Check(context.Background())
+	assert.Len(t, handler.events, 1)
+	assert.Equal(t, models.EventStackStatusChanged, handler.events[0].Type)
+	assert.Equal(t, "web", handler.events[0].ObjectName)
+	assert.Equal(t, "starting -> healthy", handler.events[0].Msg)
+
+	// errors keep the previous statuses
+	source.err = errors.New("docker is down")
+	watcher.Check(context.Background())
+	source.err = nil
+	source.statuses["db"] = models.StackStatusUnhealthy
+	watcher.Check(context.Background())
+	assert.Len(t, handler.events, 2)
+	assert.Equal(t, "db", handler.events[1].ObjectName)
+}
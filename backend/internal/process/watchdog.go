@@ -0,0 +1,184 @@
+package process
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"omar-kada/autonas/internal/events"
+	"omar-kada/autonas/models"
+
+	dockerevents "github.com/moby/moby/api/types/events"
+)
+
+// WatchdogAuthor is the author of the restarts of the watchdog
+const WatchdogAuthor = "watchdog"
+
+// WatchdogTarget gives the state of the stacks and restarts them
+type WatchdogTarget interface {
+	StacksStateSource
+	RunStackAction(stack string, action models.StackAction, author string) (models.Deployment, error)
+	IsStackStopped(stack string) bool
+}
+
+// Watchdog reports the stacks that stay unhealthy and restarts them when their policy allows it.
+// The stacks are checked at every interval and when a container dies or changes health, only the
+// checks of the intervals are counted so a crash loop doesn't reach the threshold sooner
+type Watchdog struct {
+	target     WatchdogTarget
+	dispatcher events.Dispatcher
+	stacks     map[string]*watchedStack
+	wake       chan struct{}
+	now        func() time.Time
+}
+
+type watchedStack struct {
+	// unhealthyChecks is the number of consecutive unhealthy interval checks since the last restart
+	unhealthyChecks int
+	// reported is set once STACK_UNHEALTHY is dispatched, until the stack recovers
+	reported    bool
+	restarts    int
+	nextRestart time.Time
+}
+
+// NewWatchdog creates a watchdog of the stacks of target
+func NewWatchdog(target WatchdogTarget, dispatcher events.Dispatcher) *Watchdog {
+	return &Watchdog{
+		target:     target,
+		dispatcher: dispatcher,
+		stacks:     make(map[string]*watchedStack),
+		wake:       make(chan struct{}, 1),
+		now:        time.Now,
+	}
+}
+
+// HandleContainerEvent triggers a check when a container dies or changes health,
+// it doesn't block the caller
+func (w *Watchdog) HandleContainerEvent(event models.ContainerEvent) {
+	if event.Action != string(dockerevents.ActionDie) &&
+		!strings.HasPrefix(event.Action, string(dockerevents.ActionHealthStatus)) {
+		return
+	}
+	select {
+	case w.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Run checks the stacks every interval and after the container events, with the settings
+// returned by getSettings, until ctx is done
+func (w *Watchdog) Run(ctx context.Context, interval time.Duration, getSettings func() (models.Watchdog, error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		var counted bool
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			counted = true
+		case <-w.wake:
+		}
+		settings, err := getSettings()
+		if err != nil {
+			slog.Warn("couldn't get the watchdog settings", "error", err)
+			continue
+		}
+		w.check(ctx, settings, counted)
+	}
+}
+
+// Check counts the consecutive unhealthy checks of each stack, the stacks reaching the threshold
+// of their policy are reported then restarted after the backoff. Starting stacks aren't counted,
+// the stacks stopped by an action are left alone until they are started again
+func (w *Watchdog) Check(ctx context.Context, settings models.Watchdog) {
+	w.check(ctx, settings, true)
+}
+
+// check checks the stacks, the unhealthy checks are only counted when counted is true. The other
+// checks report the recovered stacks and restart the ones that already reached the threshold
+func (w *Watchdog) check(ctx context.Context, settings models.Watchdog, counted bool) {
+	state, err := w.target.GetStacksState()
+	if err != nil {
+		slog.Warn("couldn't get the state of the stacks", "error", err)
+		return
+	}
+	checked := make(map[string]bool)
+	for _, stack := range state.Services() {
+		policy := settings.PolicyFor(stack)
+		if policy.Disabled || w.target.IsStackStopped(stack) {
+			continue
+		}
+		checked[stack] = true
+		ws, ok := w.stacks[stack]
+		if !ok {
+			ws = &watchedStack{}
+			w.stacks[stack] = ws
+		}
+		switch state.ForService(stack) {
+		case models.StackStatusHealthy:
+			if ws.reported {
+				w.dispatcher.Dispatch(events.GetStackContext(ctx, stack), models.EventStackRecovered,
+					recoveredMessage(ws.restarts))
+			}
+			ws.unhealthyChecks, ws.reported = 0, false
+			if !w.now().Before(ws.nextRestart) {
+				// the stack stayed healthy during the backoff, the next restart isn't delayed
+				ws.restarts = 0
+			}
+		case models.StackStatusUnhealthy:
+			if counted {
+				ws.unhealthyChecks++
+			}
+			if ws.unhealthyChecks >= policy.GetUnhealthyChecks() {
+				w.handleUnhealthy(ctx, stack, ws, policy)
+			}
+		}
+	}
+	for stack := range w.stacks {
+		if !checked[stack] {
+			delete(w.stacks, stack)
+		}
+	}
+}
+
+func (w *Watchdog) handleUnhealthy(ctx context.Context, stack string, ws *watchedStack, policy models.WatchdogPolicy) {
+	stackCtx := events.GetStackContext(ctx, stack)
+	if !ws.reported {
+		ws.reported = true
+		msg := fmt.Sprintf("unhealthy for %d checks", ws.unhealthyChecks)
+		if policy.Restart {
+			msg += ", restarting it"
+		}
+		w.dispatcher.Dispatch(stackCtx, models.EventStackUnhealthy, msg)
+	}
+	if !policy.Restart || w.now().Before(ws.nextRestart) {
+		return
+	}
+	ws.restarts++
+	ws.unhealthyChecks = 0
+	backoff, err := policy.GetBackoff(ws.restarts)
+	if err != nil {
+		slog.Warn("invalid watchdog backoff", "stack", stack, "error", err)
+		backoff = models.DefaultRestartBackoff
+	}
+	ws.nextRestart = w.now().Add(backoff)
+
+	slog.Info("restarting an unhealthy stack", "stack", stack, "restart", ws.restarts, "nextRestartIn", backoff)
+	if _, err := w.target.RunStackAction(stack, models.StackActionRestart, WatchdogAuthor); err != nil {
+		slog.Error("watchdog couldn't restart the stack", "stack", stack, "error", err)
+	}
+}
+
+func recoveredMessage(restarts int) string {
+	switch restarts {
+	case 0:
+		return "healthy again"
+	case 1:
+		return "healthy again after 1 restart"
+	default:
+		return fmt.Sprintf("healthy again after %d restarts", restarts)
+	}
+}
@@ -0,0 +1,189 @@
+package process
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"omar-kada/autonas/internal/events"
+	"omar-kada/autonas/models"
+	"omar-kada/autonas/testutil"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type fakeWatchdogTarget struct {
+	fakeStacksSource
+	restarts []string
+}
+
+func (t *fakeWatchdogTarget) RunStackAction(stack string, action models.StackAction, author string) (models.Deployment, error) {
+	t.restarts = append(t.restarts, stack+" "+string(action)+" "+author)
+	return models.Deployment{}, nil
+}
+
+func (t *fakeWatchdogTarget) IsStackStopped(_ string) bool {
+	return false
+}
+
+func newTestWatchdog(statuses map[string]models.StackStatus) (*Watchdog, *fakeWatchdogTarget, *recordingHandler, *time.Time) {
+	target := &fakeWatchdogTarget{fakeStacksSource: fakeStacksSource{statuses: statuses}}
+	handler := &recordingHandler{}
+	watchdog := NewWatchdog(target, events.NewDefaultDispatcher([]events.EventHandler{handler}))
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	watchdog.now = func() time.Time { return now }
+	return watchdog, target, handler, &now
+}
+
+func eventTypes(events []models.Event) []models.EventType {
+	var types []models.EventType
+	for _, event := range events {
+		types = append(types, event.Type)
+	}
+	return types
+}
+
+func TestWatchdog_ReportsUnhealthyStacks(t *testing.T) {
+	watchdog, target, handler, _ := newTestWatchdog(map[string]models.StackStatus{
+		"web": models.StackStatusUnhealthy,
+		"db":  models.StackStatusHealthy,
+	})
+	settings := models.Watchdog{WatchdogPolicy: models.WatchdogPolicy{UnhealthyChecks: 2}}
+
+	watchdog.Check(context.Background(), settings)
+	assert.Empty(t, handler.events)
+	watchdog.Check(context.Background(), settings)
+	watchdog.Check(context.Background(), settings)
+	assert.Equal(t, []models.EventType{models.EventStackUnhealthy}, eventTypes(handler.events))
+	assert.Equal(t, "web", handler.events[0].ObjectName)
+	assert.Equal(t, "unhealthy for 2 checks", handler.events[0].Msg)
+	assert.Empty(t, target.restarts, "restarts are disabled by default")
+
+	// starting doesn't reset the checks
+	target.statuses["web"] = models.StackStatusStarting
+	watchdog.Check(context.Background(), settings)
+	target.statuses["web"] = models.StackStatusHealthy
+	watchdog.Check(context.Background(), settings)
+	assert.Equal(t, []models.EventType{models.EventStackUnhealthy, models.EventStackRecovered}, eventTypes(handler.events))
+	assert.Equal(t, "healthy again", handler.events[1].Msg)
+}
+
+func TestWatchdog_RestartsWithBackoff(t *testing.T) {
+	watchdog, target, handler, now := newTestWatchdog(map[string]models.StackStatus{"web": models.StackStatusUnhealthy})
+	settings := models.Watchdog{WatchdogPolicy: models.WatchdogPolicy{Restart: true, UnhealthyChecks: 1, Backoff: "1m"}}
+
+	watchdog.Check(context.Background(), settings)
+	assert.Equal(t, []string{"web restart watchdog"}, target.restarts)
+	assert.Equal(t, "unhealthy for 1 checks, restarting it", handler.events[0].Msg)
+
+	// still unhealthy, the next restart waits for the backoff
+	*now = now.Add(30 * time.Second)
+	watchdog.Check(context.Background(), settings)
+	assert.Len(t, target.restarts, 1)
+	*now = now.Add(31 * time.Second)
+	watchdog.Check(context.Background(), settings)
+	assert.Len(t, target.restarts, 2)
+
+	// the backoff doubled
+	*now = now.Add(90 * time.Second)
+	watchdog.Check(context.Background(), settings)
+	assert.Len(t, target.restarts, 2)
+	*now = now.Add(31 * time.Second)
+	watchdog.Check(context.Background(), settings)
+	assert.Len(t, target.restarts, 3)
+
+	target.statuses["web"] = models.StackStatusHealthy
+	watchdog.Check(context.Background(), settings)
+	assert.Equal(t, models.EventStackRecovered, handler.events[len(handler.events)-1].Type)
+	assert.Equal(t, "healthy again after 3 restarts", handler.events[len(handler.events)-1].Msg)
+	assert.Len(t, handler.events, 2, "the stack is reported once until it recovers")
+}
+
+func TestWatchdog_PolicyPerService(t *testing.T) {
+	watchdog, target, handler, _ := newTestWatchdog(map[string]models.StackStatus{
+		"web": models.StackStatusUnhealthy,
+		"db":  models.StackStatusUnhealthy,
+	})
+	settings := models.Watchdog{
+		WatchdogPolicy: models.WatchdogPolicy{Restart: true, UnhealthyChecks: 1},
+		Services:       map[string]models.WatchdogPolicy{"db": {Disabled: true}},
+	}
+
+	watchdog.Check(context.Background(), settings)
+	assert.Equal(t, []string{"web restart watchdog"}, target.restarts)
+	assert.Len(t, handler.events, 1)
+	assert.Equal(t, "web", handler.events[0].ObjectName)
+}
+
+func TestWatchdog_SkipsStoppedStacks(t *testing.T) {
+	mocker := &Mocker{}
+	service := newServiceWithCurrentConfig(t, mocker, models.DeploymentParams{ServicesDir: "/services"}, stackActionsConfig)
+	mocker.On("GetServiceContainers", "web", "/services").Return([]string{"nginx"}, nil)
+	mocker.On("GetManagedStacks", "/services").Return(map[string][]models.ContainerSummary{}, nil)
+	mocker.On("RunStackAction", "web", mock.Anything, "/services").Return(nil)
+	handler := &recordingHandler{}
+	watchdog := NewWatchdog(service, events.NewDefaultDispatcher([]events.EventHandler{handler}))
+	settings := models.Watchdog{WatchdogPolicy: models.WatchdogPolicy{Restart: true, UnhealthyChecks: 1}}
+
+	deployment, err := service.RunStackAction("web", models.StackActionStop, "admin")
+	assert.NoError(t, err)
+	waitDeploymentEnded(t, service, deployment.ID)
+	watchdog.Check(context.Background(), settings)
+	watchdog.Check(context.Background(), settings)
+	assert.Empty(t, handler.events)
+	mocker.AssertNotCalled(t, "RunStackAction", "web", models.StackActionRestart, "/services")
+
+	// started again, the stack is watched
+	deployment, err = service.RunStackAction("web", models.StackActionStart, "admin")
+	assert.NoError(t, err)
+	waitDeploymentEnded(t, service, deployment.ID)
+	watchdog.Check(context.Background(), settings)
+	assert.Equal(t, []models.EventType{models.EventStackUnhealthy}, eventTypes(handler.events))
+	restart, err := service.store.GetLastDeployment()
+	assert.NoError(t, err)
+	assert.Equal(t, "Restart web", restart.Title)
+	waitDeploymentEnded(t, service, restart.ID)
+	mocker.AssertCalled(t, "RunStackAction", "web", models.StackActionRestart, "/services")
+}
+
+func TestWatchdog_HandleContainerEvent(t *testing.T) {
+	watchdog, _, _, _ := newTestWatchdog(nil)
+
+	watchdog.HandleContainerEvent(models.ContainerEvent{ContainerID: "1", Action: "start"})
+	assert.Empty(t, watchdog.wake)
+	watchdog.HandleContainerEvent(models.ContainerEvent{ContainerID: "1", Action: "health_status: unhealthy"})
+	watchdog.HandleContainerEvent(models.ContainerEvent{ContainerID: "1", Action: "die"})
+	assert.Len(t, watchdog.wake, 1, "the checks are coalesced")
+}
+
+func TestWatchdog_ContainerEventsAreNotCounted(t *testing.T) {
+	watchdog, target, handler, _ := newTestWatchdog(map[string]models.StackStatus{"web": models.StackStatusUnhealthy})
+	settings := models.Watchdog{WatchdogPolicy: models.WatchdogPolicy{UnhealthyChecks: 3, Restart: true}}
+	checks := make(chan struct{})
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		watchdog.Run(ctx, time.Hour, func() (models.Watchdog, error) {
+			checks <- struct{}{}
+			return settings, nil
+		})
+		close(done)
+	}()
+
+	// a crash loop sends a burst of die events
+	for range 10 {
+		watchdog.HandleContainerEvent(models.ContainerEvent{ContainerID: "1", Action: "die"})
+		testutil.WaitForChannel(t, checks, time.Second, "the event didn't trigger a check")
+	}
+	cancel()
+	testutil.WaitForChannel(t, done, time.Second, "the watchdog didn't stop")
+	assert.Empty(t, handler.events)
+	assert.Empty(t, target.restarts, "the stack is only restarted after the interval checks")
+
+	watchdog.Check(context.Background(), settings)
+	watchdog.Check(context.Background(), settings)
+	assert.Empty(t, target.restarts)
+	watchdog.Check(context.Background(), settings)
+	assert.Equal(t, []string{"web restart watchdog"}, target.restarts)
+}
@@ -4,12 +4,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"log/slog"
 	"net/http"
 	"reflect"
+	"slices"
 	"strconv"
+	"strings"
 
 	"omar-kada/autonas/api"
+	"omar-kada/autonas/internal/docker"
 	"omar-kada/autonas/internal/process"
 	"omar-kada/autonas/internal/server/mappers"
 	"omar-kada/autonas/internal/server/middlewares"
@@ -28,16 +32,25 @@ type Handler struct {
 	configStore    storage.ConfigStore
 	processService process.Service
 	accountService users.AccountService
+	backupStore    storage.BackupStorage
+	usageSource    UsageSource
 
 	depMapper        mappers.DeploymentMapper
 	depDetailsMapper mappers.DeploymentDetailsMapper
 	eventMapper      mappers.EventMapper
+	logMapper        mappers.DeploymentLogMapper
 	diffMapper       mappers.DiffMapper
 	statusMapper     mappers.StatusMapper
+	usageMapper      mappers.ResourceUsageMapper
 	statsMapper      mappers.StatsMapper
+	scheduleMapper   mappers.ScheduleMapper
 	configMapper     mappers.ConfigMapper
 	settingsMapper   mappers.SettingsMapper
 	featuresMapper   mappers.FeaturesMapper
+	requireMapper    mappers.RequirementMapper
+	secretMapper     mappers.SecretMapper
+	validationMapper mappers.ValidationMapper
+	revisionMapper   mappers.ConfigRevisionMapper
 }
 
 // NewHandler creates a new Handler
@@ -55,10 +68,31 @@ func NewHandler(configStore storage.ConfigStore, processService process.Service,
 		diffMapper:       diffMapper,
 		statusMapper:     mappers.StatusMapper{},
 		statsMapper:      mappers.StatsMapper{},
+		scheduleMapper:   mappers.ScheduleMapper{},
 		configMapper:     mappers.ConfigMapper{},
+		requireMapper:    mappers.RequirementMapper{},
+		secretMapper:     mappers.SecretMapper{},
+		validationMapper: mappers.ValidationMapper{},
+		revisionMapper:   mappers.ConfigRevisionMapper{},
 	}
 }
 
+// UsageSource gives the samples of the resources used by the containers
+type UsageSource interface {
+	ContainerUsage(containerID string) (models.ResourceUsage, bool)
+	StackHistory(stack string) []models.ResourceUsage
+}
+
+// SetUsageSource sets the source of the resources usage returned by /api/status
+func (h *Handler) SetUsageSource(usageSource UsageSource) {
+	h.usageSource = usageSource
+}
+
+// SetBackupStorage sets the storage creating the archives of /api/admin/backup
+func (h *Handler) SetBackupStorage(backupStore storage.BackupStorage) {
+	h.backupStore = backupStore
+}
+
 // DeployementAPIList lists deployments with pagination support
 func (h *Handler) DeployementAPIList(_ context.Context, request api.DeployementAPIListRequestObject) (api.DeployementAPIListResponseObject, error) {
 	offset, err := validateCursorOffset(request.Params.Offset)
@@ -109,6 +143,28 @@ func (h *Handler) DeployementAPIRead(_ context.Context, request api.DeployementA
 	return api.DeployementAPIRead200JSONResponse(h.depDetailsMapper.Map(dep)), err
 }
 
+// DeployementAPILogs retrieves a page of the logs of a deployment
+func (h *Handler) DeployementAPILogs(_ context.Context, request api.DeployementAPILogsRequestObject) (api.DeployementAPILogsResponseObject, error) {
+	id, err := strconv.ParseUint(request.Id, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	offset, err := validateCursorOffset(request.Params.Offset)
+	if err != nil {
+		return nil, fmt.Errorf("invalid after value")
+	}
+	if request.Params.Limit <= 0 {
+		return nil, fmt.Errorf("invalid first value")
+	}
+
+	logs, err := h.processService.GetDeploymentLogs(id, int(request.Params.Limit), offset)
+
+	return api.DeployementAPILogs200JSONResponse{
+		Items:    models.ListMapper(h.logMapper.Map)(logs),
+		PageInfo: h.logMapper.MapToPageInfo(logs, int(request.Params.Limit)),
+	}, err
+}
+
 // DeployementAPISync syncs the deployment
 func (h *Handler) DeployementAPISync(_ context.Context, _ api.DeployementAPISyncRequestObject) (api.DeployementAPISyncResponseObject, error) {
 	dep, err := h.processService.SyncDeployment()
@@ -120,12 +176,50 @@ func (h *Handler) DeployementAPISync(_ context.Context, _ api.DeployementAPISync
 	return api.DeployementAPISync200JSONResponse(h.depDetailsMapper.Map(dep)), err
 }
 
-// StatusAPIGet retrieves the status of managed stacks
+// StacksAPIAction starts an action on a stack, the started deployment is returned
+func (h *Handler) StacksAPIAction(ctx context.Context, request api.StacksAPIActionRequestObject) (api.StacksAPIActionResponseObject, error) {
+	username, _ := middlewares.UsernameFromContext(ctx)
+	dep, err := h.processService.RunStackAction(request.Name, models.StackAction(request.Action), username)
+	if errors.Is(err, process.ErrStackNotFound) {
+		return api.StacksAPIActiondefaultJSONResponse{Body: notFoundError(err), StatusCode: http.StatusNotFound}, nil
+	} else if errors.Is(err, process.ErrInvalidStackAction) {
+		return api.StacksAPIActiondefaultJSONResponse{
+			Body:       api.Error{Code: api.ErrorCodeINVALIDREQUEST, Message: err.Error()},
+			StatusCode: http.StatusBadRequest,
+		}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return api.StacksAPIAction200JSONResponse(h.depDetailsMapper.Map(dep)), nil
+}
+
+// StacksAPIRestartContainer starts the restart of a container of a stack, the started deployment is returned
+func (h *Handler) StacksAPIRestartContainer(ctx context.Context, request api.StacksAPIRestartContainerRequestObject) (api.StacksAPIRestartContainerResponseObject, error) {
+	username, _ := middlewares.UsernameFromContext(ctx)
+	dep, err := h.processService.RestartContainer(request.Name, request.Id, username)
+	if errors.Is(err, process.ErrStackNotFound) || errors.Is(err, docker.ErrContainerNotManaged) {
+		return api.StacksAPIRestartContainerdefaultJSONResponse{Body: notFoundError(err), StatusCode: http.StatusNotFound}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return api.StacksAPIRestartContainer200JSONResponse(h.depDetailsMapper.Map(dep)), nil
+}
+
+// StatusAPIGet retrieves the status of managed stacks, with the resources they use when they are sampled
 func (h *Handler) StatusAPIGet(_ context.Context, _ api.StatusAPIGetRequestObject) (api.StatusAPIGetResponseObject, error) {
 	stacks, err := h.processService.GetManagedStacks()
 	if err != nil {
 		return nil, err
 	}
+	if h.usageSource != nil {
+		for _, containers := range stacks {
+			for i, ctr := range containers {
+				if usage, found := h.usageSource.ContainerUsage(ctr.ID); found {
+					containers[i].Usage = &usage
+				}
+			}
+		}
+	}
 
 	result := models.MapMapper[string](
 		models.ListMapper(h.statusMapper.Map),
@@ -133,11 +227,19 @@ func (h *Handler) StatusAPIGet(_ context.Context, _ api.StatusAPIGetRequestObjec
 
 	var response []api.StackStatus
 	for stackName, containers := range result {
-		response = append(response, api.StackStatus{
+		stack := api.StackStatus{
 			StackId:  stackName,
 			Name:     stackName,
 			Services: containers,
-		})
+		}
+		if h.usageSource != nil {
+			if history := h.usageSource.StackHistory(stackName); len(history) > 0 {
+				usageHistory := models.ListMapper(h.usageMapper.Map)(history)
+				stack.Usage = &usageHistory[len(usageHistory)-1]
+				stack.UsageHistory = &usageHistory
+			}
+		}
+		response = append(response, stack)
 	}
 	return api.StatusAPIGet200JSONResponse(response), nil
 }
@@ -151,6 +253,33 @@ func (h *Handler) StatsAPIGet(_ context.Context, req api.StatsAPIGetRequestObjec
 	return api.StatsAPIGet200JSONResponse(h.statsMapper.Map(stats)), nil
 }
 
+// StatsAPIDaily retrieves the number of deployments per day for a specified number of days
+func (h *Handler) StatsAPIDaily(_ context.Context, req api.StatsAPIDailyRequestObject) (api.StatsAPIDailyResponseObject, error) {
+	if req.Days > process.MaxStatsDays {
+		return api.StatsAPIDailydefaultJSONResponse{
+			Body: api.Error{
+				Code:    api.ErrorCodeINVALIDREQUEST,
+				Message: fmt.Sprintf("days must be at most %d", process.MaxStatsDays),
+			},
+			StatusCode: http.StatusBadRequest,
+		}, nil
+	}
+	days, err := h.processService.GetDailyStats(int(req.Days))
+	if err != nil {
+		return nil, err
+	}
+	return api.StatsAPIDaily200JSONResponse(models.ListMapper(h.statsMapper.MapDaily)(days)), nil
+}
+
+// SchedulesAPIGet retrieves the scheduled jobs and the maintenance windows
+func (h *Handler) SchedulesAPIGet(_ context.Context, _ api.SchedulesAPIGetRequestObject) (api.SchedulesAPIGetResponseObject, error) {
+	schedules, err := h.processService.GetSchedules()
+	if err != nil {
+		return nil, err
+	}
+	return api.SchedulesAPIGet200JSONResponse(h.scheduleMapper.Map(schedules)), nil
+}
+
 // DiffAPIGet retrieves the differences in files
 func (h *Handler) DiffAPIGet(_ context.Context, _ api.DiffAPIGetRequestObject) (api.DiffAPIGetResponseObject, error) {
 	fileDiffs, err := h.processService.GetDiff()
@@ -160,53 +289,282 @@ func (h *Handler) DiffAPIGet(_ context.Context, _ api.DiffAPIGetRequestObject) (
 	return api.DiffAPIGet200JSONResponse(models.ListMapper(h.diffMapper.Map)(fileDiffs)), nil
 }
 
-// ConfigAPIGet retrieves the current configuration
-func (h *Handler) ConfigAPIGet(_ context.Context, _ api.ConfigAPIGetRequestObject) (api.ConfigAPIGetResponseObject, error) {
-	config, err := h.configStore.Get()
+// ConfigAPIGet retrieves the configuration file, or the effective configuration when resolved
+func (h *Handler) ConfigAPIGet(_ context.Context, r api.ConfigAPIGetRequestObject) (api.ConfigAPIGetResponseObject, error) {
+	config, version, err := h.configStore.GetBaseVersion()
 	if err != nil {
 		return nil, err
 	}
-	return api.ConfigAPIGet200JSONResponse(h.configMapper.Map(config)), nil
+	response := h.configMapper.Map(config)
+	if r.Params.Resolved != nil && *r.Params.Resolved {
+		config, origins, err := h.configStore.GetResolved()
+		if err != nil {
+			return nil, err
+		}
+		response = h.configMapper.Map(config)
+		response.Origins = (*map[string]string)(&origins)
+	}
+	return api.ConfigAPIGet200JSONResponse{
+		Body:    response,
+		Headers: api.ConfigAPIGet200ResponseHeaders{ETag: formatETag(version)},
+	}, nil
 }
 
 // ConfigAPISet updates the current configuration
-func (h *Handler) ConfigAPISet(_ context.Context, r api.ConfigAPISetRequestObject) (api.ConfigAPISetResponseObject, error) {
+func (h *Handler) ConfigAPISet(ctx context.Context, r api.ConfigAPISetRequestObject) (api.ConfigAPISetResponseObject, error) {
 	config := h.configMapper.UnMap(api.Config(*r.Body))
-	oldConfig, err := h.configStore.Get()
+	oldConfig, version, err := h.configStore.GetBaseVersion()
 	if err != nil {
 		return nil, err
 	}
+	if !matchesVersion(r.Params.IfMatch, version) {
+		return api.ConfigAPISetdefaultJSONResponse{Body: versionConflictError(), StatusCode: http.StatusPreconditionFailed}, nil
+	}
 	oldConfig.Environment = config.Environment
 	oldConfig.Services = config.Services
-	err = h.configStore.Update(oldConfig)
+	version, err = h.updateConfig(ctx, oldConfig, "configuration updated", version)
+	if apiErr, ok := h.validationError(err); ok {
+		return api.ConfigAPISetdefaultJSONResponse{Body: apiErr, StatusCode: http.StatusBadRequest}, nil
+	} else if errors.Is(err, storage.ErrVersionConflict) {
+		return api.ConfigAPISetdefaultJSONResponse{Body: versionConflictError(), StatusCode: http.StatusPreconditionFailed}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return api.ConfigAPISet200JSONResponse{
+		Body:    h.configMapper.Map(oldConfig),
+		Headers: api.ConfigAPISet200ResponseHeaders{ETag: formatETag(version)},
+	}, nil
+}
+
+// ConfigAPIRequirements lists the variables that are missing a value, per service
+func (h *Handler) ConfigAPIRequirements(_ context.Context, _ api.ConfigAPIRequirementsRequestObject) (api.ConfigAPIRequirementsResponseObject, error) {
+	requirements, err := h.processService.GetConfigRequirements()
 	if err != nil {
 		return nil, err
 	}
-	return api.ConfigAPISet200JSONResponse(h.configMapper.Map(oldConfig)), nil
+	response := make([]api.ServiceRequirements, 0, len(requirements))
+	for service, missing := range requirements {
+		response = append(response, api.ServiceRequirements{
+			Service: service,
+			Missing: models.ListMapper(h.requireMapper.Map)(missing),
+		})
+	}
+	slices.SortFunc(response, func(a, b api.ServiceRequirements) int {
+		return strings.Compare(a.Service, b.Service)
+	})
+	return api.ConfigAPIRequirements200JSONResponse(response), nil
 }
 
 // SettingsAPIGet retrieves the current settings
 func (h *Handler) SettingsAPIGet(_ context.Context, _ api.SettingsAPIGetRequestObject) (api.SettingsAPIGetResponseObject, error) {
-	config, err := h.configStore.Get()
+	config, version, err := h.configStore.GetBaseVersion()
 	if err != nil {
 		return nil, err
 	}
-	return api.SettingsAPIGet200JSONResponse(h.settingsMapper.Map(config.Settings)), nil
+	return api.SettingsAPIGet200JSONResponse{
+		Body:    h.settingsMapper.Map(config.Settings),
+		Headers: api.SettingsAPIGet200ResponseHeaders{ETag: formatETag(version)},
+	}, nil
 }
 
 // SettingsAPISet updates the current settings
-func (h *Handler) SettingsAPISet(_ context.Context, r api.SettingsAPISetRequestObject) (api.SettingsAPISetResponseObject, error) {
-	oldConfig, err := h.configStore.Get()
+func (h *Handler) SettingsAPISet(ctx context.Context, r api.SettingsAPISetRequestObject) (api.SettingsAPISetResponseObject, error) {
+	oldConfig, version, err := h.configStore.GetBaseVersion()
 	if err != nil {
 		return nil, err
 	}
+	if !matchesVersion(r.Params.IfMatch, version) {
+		return api.SettingsAPISetdefaultJSONResponse{Body: versionConflictError(), StatusCode: http.StatusPreconditionFailed}, nil
+	}
 	settings := h.settingsMapper.UnMap(api.Settings(*r.Body))
+	// schedules, retention and watchdog are only edited in the config file
+	settings.Timezone = oldConfig.Settings.Timezone
+	settings.Schedules = oldConfig.Settings.Schedules
+	settings.MaintenanceWindows = oldConfig.Settings.MaintenanceWindows
+	settings.Retention = oldConfig.Settings.Retention
+	settings.Watchdog = oldConfig.Settings.Watchdog
 	oldConfig.Settings = settings
-	err = h.configStore.Update(oldConfig)
+	version, err = h.updateConfig(ctx, oldConfig, "settings updated", version)
+	if apiErr, ok := h.validationError(err); ok {
+		return api.SettingsAPISetdefaultJSONResponse{Body: apiErr, StatusCode: http.StatusBadRequest}, nil
+	} else if errors.Is(err, storage.ErrVersionConflict) {
+		return api.SettingsAPISetdefaultJSONResponse{Body: versionConflictError(), StatusCode: http.StatusPreconditionFailed}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return api.SettingsAPISet200JSONResponse{
+		Body:    h.settingsMapper.Map(settings),
+		Headers: api.SettingsAPISet200ResponseHeaders{ETag: formatETag(version)},
+	}, nil
+}
+
+// updateConfig validates and stores the configuration if the config file is still at version,
+// the change is recorded in the history with the current user as author. The new version is returned.
+func (h *Handler) updateConfig(ctx context.Context, cfg models.Config, message, version string) (string, error) {
+	if err := h.processService.ValidateConfig(cfg); err != nil {
+		return "", err
+	}
+	username, _ := middlewares.UsernameFromContext(ctx)
+	version, err := h.configStore.UpdateAs(cfg, username, message, version)
+	if err != nil {
+		return "", err
+	}
+	h.processService.WriteBackConfig(username, message)
+	return version, nil
+}
+
+// formatETag quotes the version of the config file as an ETag
+func formatETag(version string) string {
+	return strconv.Quote(version)
+}
+
+// matchesVersion checks the If-Match header against the version of the config file,
+// a missing header or * matches any version
+func matchesVersion(ifMatch *string, version string) bool {
+	if ifMatch == nil || *ifMatch == "" || *ifMatch == "*" {
+		return true
+	}
+	for etag := range strings.SplitSeq(*ifMatch, ",") {
+		etag = strings.TrimPrefix(strings.TrimSpace(etag), "W/")
+		if strings.Trim(etag, `"`) == version {
+			return true
+		}
+	}
+	return false
+}
+
+func versionConflictError() api.Error {
+	return api.Error{
+		Code:    api.ErrorCodeCONFLICT,
+		Message: storage.ErrVersionConflict.Error(),
+	}
+}
+
+// validationError returns the api error listing the invalid fields when err is a validation error
+func (h *Handler) validationError(err error) (api.Error, bool) {
+	var validationErr *models.ValidationError
+	if !errors.As(err, &validationErr) {
+		return api.Error{}, false
+	}
+	return h.validationMapper.Map(validationErr), true
+}
+
+// ConfigHistoryAPIList lists the revisions of the config file with pagination support
+func (h *Handler) ConfigHistoryAPIList(_ context.Context, request api.ConfigHistoryAPIListRequestObject) (api.ConfigHistoryAPIListResponseObject, error) {
+	offset, err := validateCursorOffset(request.Params.Offset)
+	if err != nil {
+		return nil, fmt.Errorf("invalid after value")
+	}
+
+	if request.Params.Limit <= 0 {
+		return nil, fmt.Errorf("invalid first value")
+	}
+
+	revisions, err := h.configStore.GetRevisions(storage.NewIDCursor(int(request.Params.Limit), offset))
+	if err != nil {
+		return nil, err
+	}
+	return api.ConfigHistoryAPIList200JSONResponse{
+		Items:    models.ListMapper(h.revisionMapper.Map)(revisions),
+		PageInfo: h.revisionMapper.MapToPageInfo(revisions, int(request.Params.Limit)),
+	}, nil
+}
+
+// ConfigHistoryAPIRead retrieves a revision of the config file
+func (h *Handler) ConfigHistoryAPIRead(_ context.Context, request api.ConfigHistoryAPIReadRequestObject) (api.ConfigHistoryAPIReadResponseObject, error) {
+	id, err := strconv.ParseUint(request.Id, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	revision, err := h.configStore.GetRevision(id)
+	if errors.Is(err, storage.ErrRevisionNotFound) {
+		return api.ConfigHistoryAPIReaddefaultJSONResponse{Body: notFoundError(err), StatusCode: http.StatusNotFound}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return api.ConfigHistoryAPIRead200JSONResponse(h.revisionMapper.MapWithContent(revision)), nil
+}
+
+// ConfigHistoryAPIDiff retrieves the changes between two revisions of the config file
+func (h *Handler) ConfigHistoryAPIDiff(_ context.Context, request api.ConfigHistoryAPIDiffRequestObject) (api.ConfigHistoryAPIDiffResponseObject, error) {
+	fromID, err := strconv.ParseUint(request.Params.From, 10, 64)
 	if err != nil {
 		return nil, err
 	}
-	return api.SettingsAPISet200JSONResponse(h.settingsMapper.Map(settings)), nil
+	toID, err := strconv.ParseUint(request.Params.To, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	diff, err := h.configStore.DiffRevisions(fromID, toID)
+	if errors.Is(err, storage.ErrRevisionNotFound) {
+		return api.ConfigHistoryAPIDiffdefaultJSONResponse{Body: notFoundError(err), StatusCode: http.StatusNotFound}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return api.ConfigHistoryAPIDiff200JSONResponse(h.diffMapper.Map(diff)), nil
+}
+
+// ConfigHistoryAPIRestore writes back a revision to the config file
+func (h *Handler) ConfigHistoryAPIRestore(ctx context.Context, request api.ConfigHistoryAPIRestoreRequestObject) (api.ConfigHistoryAPIRestoreResponseObject, error) {
+	id, err := strconv.ParseUint(request.Id, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	username, _ := middlewares.UsernameFromContext(ctx)
+	revision, err := h.configStore.RestoreRevision(id, username)
+	if errors.Is(err, storage.ErrRevisionNotFound) {
+		return api.ConfigHistoryAPIRestoredefaultJSONResponse{Body: notFoundError(err), StatusCode: http.StatusNotFound}, nil
+	} else if apiErr, ok := h.validationError(err); ok {
+		return api.ConfigHistoryAPIRestoredefaultJSONResponse{Body: apiErr, StatusCode: http.StatusBadRequest}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	h.processService.WriteBackConfig(username, revision.Message)
+	return api.ConfigHistoryAPIRestore200JSONResponse(h.revisionMapper.Map(revision)), nil
+}
+
+func notFoundError(err error) api.Error {
+	return api.Error{
+		Code:    api.ErrorCodeNOTFOUND,
+		Message: err.Error(),
+	}
+}
+
+// SecretsAPIList lists the stored secrets without their values
+func (h *Handler) SecretsAPIList(_ context.Context, _ api.SecretsAPIListRequestObject) (api.SecretsAPIListResponseObject, error) {
+	secrets, err := h.processService.ListSecrets()
+	if err != nil {
+		return nil, err
+	}
+	return api.SecretsAPIList200JSONResponse(models.ListMapper(h.secretMapper.Map)(secrets)), nil
+}
+
+// SecretsAPISet creates or updates a secret
+func (h *Handler) SecretsAPISet(_ context.Context, r api.SecretsAPISetRequestObject) (api.SecretsAPISetResponseObject, error) {
+	secret, err := h.processService.SetSecret(r.Body.Name, r.Body.Value)
+	if errors.Is(err, storage.ErrInvalidSecretName) {
+		return api.SecretsAPISetdefaultJSONResponse{
+			Body: api.Error{
+				Code:    api.ErrorCodeINVALIDREQUEST,
+				Message: err.Error(),
+			},
+			StatusCode: http.StatusBadRequest,
+		}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return api.SecretsAPISet200JSONResponse(h.secretMapper.Map(secret)), nil
+}
+
+// SecretsAPIDelete deletes a secret
+func (h *Handler) SecretsAPIDelete(_ context.Context, r api.SecretsAPIDeleteRequestObject) (api.SecretsAPIDeleteResponseObject, error) {
+	deleted, err := h.processService.DeleteSecret(r.Name)
+	if err != nil {
+		return nil, err
+	}
+	return api.SecretsAPIDelete200JSONResponse{
+		Success: deleted,
+	}, nil
 }
 
 // FeaturesAPIGet retrieves the current features
@@ -303,3 +661,31 @@ func (h *Handler) NotificationsAPIList(_ context.Context, request api.Notificati
 		PageInfo: h.eventMapper.MapToPageInfo(events, int(request.Params.Limit)),
 	}, err
 }
+
+// AdminAPIBackup streams a tar.gz archive with a consistent copy of the database and the config file
+func (h *Handler) AdminAPIBackup(_ context.Context, _ api.AdminAPIBackupRequestObject) (api.AdminAPIBackupResponseObject, error) {
+	if h.backupStore == nil {
+		return nil, errors.New("backups aren't available")
+	}
+	snapshot, err := h.backupStore.Snapshot()
+	if errors.Is(err, storage.ErrBackupNotSupported) {
+		return api.AdminAPIBackupdefaultJSONResponse{
+			Body:       api.Error{Code: api.ErrorCodeDISABLED, Message: err.Error()},
+			StatusCode: http.StatusNotImplemented,
+		}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	reader, writer := io.Pipe()
+	go func() {
+		defer snapshot.Close()
+		// fails when the response is closed, the archive is then truncated
+		writer.CloseWithError(snapshot.Write(writer))
+	}()
+	return api.AdminAPIBackup200ApplicationgzipResponse{
+		Body: reader,
+		Headers: api.AdminAPIBackup200ResponseHeaders{
+			ContentDisposition: fmt.Sprintf("attachment; filename=%q", snapshot.FileName()),
+		},
+	}, nil
+}
@@ -1,14 +1,23 @@
 package server
 
 import (
+	"archive/tar"
+	"compress/gzip"
 	"context"
 	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"omar-kada/autonas/api"
+	"omar-kada/autonas/internal/docker"
+	"omar-kada/autonas/internal/process"
 	"omar-kada/autonas/internal/server/middlewares"
+	"omar-kada/autonas/internal/storage"
 	"omar-kada/autonas/models"
+	"omar-kada/autonas/testutil"
 
 	"github.com/moby/moby/api/types/container"
 	"github.com/stretchr/testify/assert"
@@ -17,6 +26,7 @@ import (
 
 type MockProcess struct {
 	mock.Mock
+	logLines []models.ContainerLogLine
 }
 
 func (m *MockProcess) SyncDeployment() (models.Deployment, error) {
@@ -24,6 +34,61 @@ func (m *MockProcess) SyncDeployment() (models.Deployment, error) {
 	return args.Get(0).(models.Deployment), args.Error(1)
 }
 
+func (m *MockProcess) UpdateImages() (models.Deployment, error) {
+	args := m.Called()
+	return args.Get(0).(models.Deployment), args.Error(1)
+}
+
+func (m *MockProcess) PruneImages() error {
+	args := m.Called()
+	return args.Error(0)
+}
+
+func (m *MockProcess) IsStackStopped(stack string) bool {
+	args := m.Called(stack)
+	return args.Bool(0)
+}
+
+func (m *MockProcess) RunStackAction(stack string, action models.StackAction, author string) (models.Deployment, error) {
+	args := m.Called(stack, action, author)
+	return args.Get(0).(models.Deployment), args.Error(1)
+}
+
+func (m *MockProcess) RestartContainer(stack, containerID, author string) (models.Deployment, error) {
+	args := m.Called(stack, containerID, author)
+	return args.Get(0).(models.Deployment), args.Error(1)
+}
+
+func (m *MockProcess) GetSchedules() (models.Schedules, error) {
+	args := m.Called()
+	return args.Get(0).(models.Schedules), args.Error(1)
+}
+
+func (m *MockProcess) GetStacksState() (models.StacksState, error) {
+	args := m.Called()
+	return args.Get(0).(models.StacksState), args.Error(1)
+}
+
+func (m *MockProcess) CheckManagedContainer(ctx context.Context, stack, containerID string) error {
+	args := m.Called(ctx, stack, containerID)
+	return args.Error(0)
+}
+
+func (m *MockProcess) StreamContainerLogs(ctx context.Context, containerID string,
+	options models.ContainerLogsOptions, onLine func(models.ContainerLogLine),
+) error {
+	args := m.Called(ctx, containerID, options)
+	for _, line := range m.logLines {
+		onLine(line)
+	}
+	return args.Error(0)
+}
+
+func (m *MockProcess) GetDailyStats(days int) ([]models.DailyStats, error) {
+	args := m.Called(days)
+	return args.Get(0).([]models.DailyStats), args.Error(1)
+}
+
 func (m *MockProcess) GetCurrentStats(days int) (models.Stats, error) {
 	args := m.Called(days)
 	return args.Get(0).(models.Stats), args.Error(1)
@@ -49,11 +114,45 @@ func (m *MockProcess) GetDeployment(id uint64) (models.Deployment, error) {
 	return args.Get(0).(models.Deployment), args.Error(1)
 }
 
+func (m *MockProcess) GetDeploymentLogs(id uint64, limit int, offset uint64) ([]models.DeploymentLog, error) {
+	args := m.Called(id, limit, offset)
+	return args.Get(0).([]models.DeploymentLog), args.Error(1)
+}
+
 func (m *MockProcess) GetNotifications(limit int, offset uint64) ([]models.Event, error) {
 	args := m.Called(limit, offset)
 	return args.Get(0).([]models.Event), args.Error(1)
 }
 
+func (m *MockProcess) GetConfigRequirements() (map[string][]models.VariableRequirement, error) {
+	args := m.Called()
+	return args.Get(0).(map[string][]models.VariableRequirement), args.Error(1)
+}
+
+func (m *MockProcess) ValidateConfig(cfg models.Config) error {
+	args := m.Called(cfg)
+	return args.Error(0)
+}
+
+func (m *MockProcess) WriteBackConfig(author, message string) {
+	m.Called(author, message)
+}
+
+func (m *MockProcess) ListSecrets() ([]models.Secret, error) {
+	args := m.Called()
+	return args.Get(0).([]models.Secret), args.Error(1)
+}
+
+func (m *MockProcess) SetSecret(name, value string) (models.Secret, error) {
+	args := m.Called(name, value)
+	return args.Get(0).(models.Secret), args.Error(1)
+}
+
+func (m *MockProcess) DeleteSecret(name string) (bool, error) {
+	args := m.Called(name)
+	return args.Bool(0), args.Error(1)
+}
+
 func (m *MockProcess) GetUser(username string) (models.User, error) {
 	args := m.Called(username)
 	return args.Get(0).(models.User), args.Error(1)
@@ -78,11 +177,55 @@ func (m *MockStore) Get() (models.Config, error) {
 	return args.Get(0).(models.Config), args.Error(1)
 }
 
+func (m *MockStore) GetBase() (models.Config, error) {
+	args := m.Called()
+	return args.Get(0).(models.Config), args.Error(1)
+}
+
+func (m *MockStore) GetBaseVersion() (models.Config, string, error) {
+	args := m.Called()
+	return args.Get(0).(models.Config), args.String(1), args.Error(2)
+}
+
+func (m *MockStore) GetResolved() (models.Config, models.ConfigOrigins, error) {
+	args := m.Called()
+	return args.Get(0).(models.Config), args.Get(1).(models.ConfigOrigins), args.Error(2)
+}
+
 func (m *MockStore) Update(config models.Config) error {
 	args := m.Called(config)
 	return args.Error(0)
 }
 
+func (m *MockStore) UpdateAs(config models.Config, author, message, version string) (string, error) {
+	args := m.Called(config, author, message, version)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockStore) SetHistory(history storage.ConfigHistoryStorage) {
+	m.Called(history)
+}
+
+func (m *MockStore) GetRevisions(c storage.Cursor[uint64]) ([]models.ConfigRevision, error) {
+	args := m.Called(c)
+	return args.Get(0).([]models.ConfigRevision), args.Error(1)
+}
+
+func (m *MockStore) GetRevision(id uint64) (models.ConfigRevision, error) {
+	args := m.Called(id)
+	return args.Get(0).(models.ConfigRevision), args.Error(1)
+}
+
+func (m *MockStore) DiffRevisions(fromID, toID uint64) (models.FileDiff, error) {
+	args := m.Called(fromID, toID)
+	return args.Get(0).(models.FileDiff), args.Error(1)
+}
+
+func (m *MockStore) RestoreRevision(id uint64, author string) (models.ConfigRevision, error) {
+	args := m.Called(id, author)
+	return args.Get(0).(models.ConfigRevision), args.Error(1)
+}
+
 func (m *MockStore) ToYaml(config models.Config) ([]byte, error) {
 	args := m.Called(config)
 	return args.Get(0).([]byte), args.Error(1)
@@ -92,6 +235,11 @@ func (m *MockStore) SetOnChange(fn func(models.Config, models.Config)) {
 	m.Called(fn)
 }
 
+func (m *MockStore) Watch(ctx context.Context, onError func(error)) error {
+	args := m.Called(ctx, onError)
+	return args.Error(0)
+}
+
 func TestDeployementAPIList_Success(t *testing.T) {
 	m := &MockProcess{}
 	store := &MockStore{}
@@ -130,6 +278,44 @@ func TestDeployementAPIList_InvalidOffset(t *testing.T) {
 	assert.EqualError(t, err, "invalid after value")
 }
 
+func TestDeployementAPILogs_Success(t *testing.T) {
+	m := &MockProcess{}
+	store := &MockStore{}
+	h := NewHandler(store, m, m)
+
+	logs := []models.DeploymentLog{
+		{ID: 8, Service: "web", Stream: models.LogStreamStdout, Line: "Started"},
+		{ID: 7, Service: "web", Stream: models.LogStreamStderr, Line: "Pulling"},
+	}
+	m.On("GetDeploymentLogs", uint64(3), 2, uint64(9)).Return(logs, nil)
+
+	offset := "9"
+	req := api.DeployementAPILogsRequestObject{Id: "3", Params: api.DeployementAPILogsParams{Limit: 2, Offset: &offset}}
+	resp, err := h.DeployementAPILogs(context.Background(), req)
+	assert.NoError(t, err)
+
+	r, ok := resp.(api.DeployementAPILogs200JSONResponse)
+	assert.True(t, ok, "unexpected response type: %T", resp)
+	assert.Equal(t, "Started", r.Items[0].Line)
+	assert.Equal(t, api.LogStreamStderr, r.Items[1].Stream)
+	assert.Equal(t, api.PageInfo{HasNextPage: true, EndCursor: "7"}, r.PageInfo)
+	m.AssertExpectations(t)
+}
+
+func TestDeployementAPILogs_InvalidParams(t *testing.T) {
+	m := &MockProcess{}
+	h := NewHandler(&MockStore{}, m, m)
+
+	_, err := h.DeployementAPILogs(context.Background(), api.DeployementAPILogsRequestObject{
+		Id: "abc", Params: api.DeployementAPILogsParams{Limit: 2},
+	})
+	assert.Error(t, err)
+	_, err = h.DeployementAPILogs(context.Background(), api.DeployementAPILogsRequestObject{
+		Id: "3", Params: api.DeployementAPILogsParams{Limit: 0},
+	})
+	assert.EqualError(t, err, "invalid first value")
+}
+
 func TestDeployementAPIRead_Success(t *testing.T) {
 	m := &MockProcess{}
 	store := &MockStore{}
@@ -194,6 +380,58 @@ func TestDeployementAPISync_SuccessAndError(t *testing.T) {
 	m.AssertExpectations(t)
 }
 
+func TestStacksAPIAction(t *testing.T) {
+	m := &MockProcess{}
+	h := NewHandler(&MockStore{}, m, m)
+	ctx := middlewares.ContextWithUsername(context.Background(), "admin")
+
+	dep := models.Deployment{ID: 7, Title: "Restart web", Author: "admin", Status: models.DeploymentStatusRunning}
+	m.On("RunStackAction", "web", models.StackActionRestart, "admin").Return(dep, nil)
+	m.On("RunStackAction", "web", models.StackActionStart, "admin").Return(models.Deployment{}, errors.New("db error"))
+	m.On("RunStackAction", "other", models.StackActionStop, "admin").Return(models.Deployment{}, process.ErrStackNotFound)
+	m.On("RunStackAction", "web", models.StackAction("kill"), "admin").Return(models.Deployment{}, process.ErrInvalidStackAction)
+
+	resp, err := h.StacksAPIAction(ctx, api.StacksAPIActionRequestObject{Name: "web", Action: api.StackActionRestart})
+	assert.NoError(t, err)
+	if r, ok := resp.(api.StacksAPIAction200JSONResponse); assert.True(t, ok) {
+		assert.Equal(t, "Restart web", r.Title)
+		assert.Equal(t, api.DeploymentStatusRunning, r.Status)
+	}
+
+	_, err = h.StacksAPIAction(ctx, api.StacksAPIActionRequestObject{Name: "web", Action: api.StackActionStart})
+	assert.Error(t, err)
+
+	resp, err = h.StacksAPIAction(ctx, api.StacksAPIActionRequestObject{Name: "other", Action: api.StackActionStop})
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, resp.(api.StacksAPIActiondefaultJSONResponse).StatusCode)
+
+	resp, err = h.StacksAPIAction(ctx, api.StacksAPIActionRequestObject{Name: "web", Action: "kill"})
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.(api.StacksAPIActiondefaultJSONResponse).StatusCode)
+
+	m.AssertExpectations(t)
+}
+
+func TestStacksAPIRestartContainer(t *testing.T) {
+	m := &MockProcess{}
+	h := NewHandler(&MockStore{}, m, m)
+	ctx := middlewares.ContextWithUsername(context.Background(), "admin")
+
+	dep := models.Deployment{ID: 8, Title: "Restart web/nginx", Status: models.DeploymentStatusRunning}
+	m.On("RestartContainer", "web", "abc", "admin").Return(dep, nil)
+	m.On("RestartContainer", "web", "other", "admin").Return(models.Deployment{}, docker.ErrContainerNotManaged)
+
+	resp, err := h.StacksAPIRestartContainer(ctx, api.StacksAPIRestartContainerRequestObject{Name: "web", Id: "abc"})
+	assert.NoError(t, err)
+	assert.Equal(t, "Restart web/nginx", resp.(api.StacksAPIRestartContainer200JSONResponse).Title)
+
+	resp, err = h.StacksAPIRestartContainer(ctx, api.StacksAPIRestartContainerRequestObject{Name: "web", Id: "other"})
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, resp.(api.StacksAPIRestartContainerdefaultJSONResponse).StatusCode)
+
+	m.AssertExpectations(t)
+}
+
 func TestStatusAPIGet_Success(t *testing.T) {
 	m := &MockProcess{}
 	store := &MockStore{}
@@ -218,13 +456,49 @@ func TestStatusAPIGet_Success(t *testing.T) {
 	m.AssertExpectations(t)
 }
 
+type fakeUsageSource struct {
+	containers map[string]models.ResourceUsage
+	stacks     map[string][]models.ResourceUsage
+}
+
+func (s fakeUsageSource) ContainerUsage(containerID string) (models.ResourceUsage, bool) {
+	usage, found := s.containers[containerID]
+	return usage, found
+}
+
+func (s fakeUsageSource) StackHistory(stack string) []models.ResourceUsage {
+	return s.stacks[stack]
+}
+
+func TestStatusAPIGet_WithUsage(t *testing.T) {
+	m := &MockProcess{}
+	h := NewHandler(&MockStore{}, m, m)
+	h.SetUsageSource(fakeUsageSource{
+		containers: map[string]models.ResourceUsage{"c1": {MemoryUsage: 100}},
+		stacks:     map[string][]models.ResourceUsage{"stack1": {{MemoryUsage: 90}, {MemoryUsage: 100}}},
+	})
+	m.On("GetManagedStacks").Return(map[string][]models.ContainerSummary{
+		"stack1": {{ID: "c1", Name: "c1", State: container.StateRunning}, {ID: "c2", Name: "c2", State: container.StateExited}},
+	}, nil)
+
+	resp, err := h.StatusAPIGet(context.Background(), api.StatusAPIGetRequestObject{})
+	assert.NoError(t, err)
+
+	r := resp.(api.StatusAPIGet200JSONResponse)
+	assert.Len(t, r, 1)
+	assert.Equal(t, uint64(100), r[0].Services[0].Usage.MemoryUsage)
+	assert.Nil(t, r[0].Services[1].Usage)
+	assert.Equal(t, uint64(100), r[0].Usage.MemoryUsage)
+	assert.Len(t, *r[0].UsageHistory, 2)
+}
+
 func TestStatsAPIGet_Success(t *testing.T) {
 	m := &MockProcess{}
 	store := &MockStore{}
 	h := NewHandler(store, m, m)
 
 	next := time.Now().Add(1 * time.Hour)
-	stats := models.Stats{Author: "bob", Error: 1, Success: 2, LastStatus: models.DeploymentStatusError, NextDeploy: next}
+	stats := models.Stats{Author: "bob", DeploymentStats: models.DeploymentStats{Error: 1, Success: 2}, LastStatus: models.DeploymentStatusError, NextDeploy: next}
 	m.On("GetCurrentStats", 7).Return(stats, nil)
 
 	req := api.StatsAPIGetRequestObject{Days: 7}
@@ -244,6 +518,77 @@ func TestStatsAPIGet_Success(t *testing.T) {
 	m.AssertExpectations(t)
 }
 
+func TestSchedulesAPIGet_Success(t *testing.T) {
+	m := &MockProcess{}
+	store := &MockStore{}
+	h := NewHandler(store, m, m)
+
+	next := time.Now().Add(time.Hour)
+	m.On("GetSchedules").Return(models.Schedules{
+		Timezone: "UTC",
+		Jobs:     []models.Schedule{{Name: models.ScheduleSync, Cron: "@hourly", Next: next}},
+	}, nil)
+
+	resp, err := h.SchedulesAPIGet(context.Background(), api.SchedulesAPIGetRequestObject{})
+	assert.NoError(t, err)
+
+	switch r := resp.(type) {
+	case api.SchedulesAPIGet200JSONResponse:
+		assert.Equal(t, "UTC", r.Timezone)
+		assert.Len(t, r.Jobs, 1)
+		assert.Equal(t, next, *r.Jobs[0].Next)
+	default:
+		t.Fatalf("unexpected resp type: %T", resp)
+	}
+	m.AssertExpectations(t)
+}
+
+func TestSchedulesAPIGet_Error(t *testing.T) {
+	m := &MockProcess{}
+	h := NewHandler(&MockStore{}, m, m)
+	m.On("GetSchedules").Return(models.Schedules{}, errors.New("unknown time zone"))
+
+	_, err := h.SchedulesAPIGet(context.Background(), api.SchedulesAPIGetRequestObject{})
+	assert.Error(t, err)
+}
+
+func TestAdminAPIBackup_Success(t *testing.T) {
+	m := &MockProcess{}
+	h := NewHandler(&MockStore{}, m, m)
+	configFile := filepath.Join(t.TempDir(), "config.yaml")
+	assert.NoError(t, os.WriteFile(configFile, []byte("settings: {}\n"), 0o600))
+	h.SetBackupStorage(storage.NewBackupStorage(testutil.NewMemoryStorage(), storage.BackupFiles{ConfigFile: configFile}))
+
+	resp, err := h.AdminAPIBackup(context.Background(), api.AdminAPIBackupRequestObject{})
+	assert.NoError(t, err)
+
+	r, ok := resp.(api.AdminAPIBackup200ApplicationgzipResponse)
+	if !ok {
+		t.Fatalf("unexpected resp type: %T", resp)
+	}
+	assert.Regexp(t, `^attachment; filename="autonas-backup-.*\.tar\.gz"$`, r.Headers.ContentDisposition)
+	gzipReader, err := gzip.NewReader(r.Body)
+	assert.NoError(t, err)
+	tarReader := tar.NewReader(gzipReader)
+	var names []string
+	for {
+		header, err := tarReader.Next()
+		if err != nil {
+			break
+		}
+		names = append(names, header.Name)
+	}
+	assert.Equal(t, []string{storage.BackupDBFile, storage.BackupConfigFile}, names)
+}
+
+func TestAdminAPIBackup_NotAvailable(t *testing.T) {
+	m := &MockProcess{}
+	h := NewHandler(&MockStore{}, m, m)
+
+	_, err := h.AdminAPIBackup(context.Background(), api.AdminAPIBackupRequestObject{})
+	assert.Error(t, err)
+}
+
 func TestDiffAPIGet_Success(t *testing.T) {
 	m := &MockProcess{}
 	store := &MockStore{}
@@ -348,6 +693,21 @@ func TestStatsAPIGet_Error(t *testing.T) {
 	m.AssertExpectations(t)
 }
 
+func TestStatsAPIDaily_MaxDays(t *testing.T) {
+	m := &MockProcess{}
+	h := NewHandler(&MockStore{}, m, m)
+	m.On("GetDailyStats", process.MaxStatsDays).Return([]models.DailyStats{}, nil)
+
+	resp, err := h.StatsAPIDaily(context.Background(), api.StatsAPIDailyRequestObject{Days: process.MaxStatsDays})
+	assert.NoError(t, err)
+	assert.IsType(t, api.StatsAPIDaily200JSONResponse{}, resp)
+
+	resp, err = h.StatsAPIDaily(context.Background(), api.StatsAPIDailyRequestObject{Days: process.MaxStatsDays + 1})
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.(api.StatsAPIDailydefaultJSONResponse).StatusCode)
+	m.AssertNumberOfCalls(t, "GetDailyStats", 1)
+}
+
 func TestDiffAPIGet_Error(t *testing.T) {
 	m := &MockProcess{}
 	store := &MockStore{}
@@ -374,14 +734,44 @@ func TestConfigAPIGet_Success(t *testing.T) {
 			"ENV": "VALUE",
 		},
 	}
-	store.On("Get").Return(config, nil)
+	store.On("GetBaseVersion").Return(config, "v1", nil)
 
 	resp, err := h.ConfigAPIGet(context.Background(), api.ConfigAPIGetRequestObject{})
 	assert.NoError(t, err)
 
 	switch r := resp.(type) {
 	case api.ConfigAPIGet200JSONResponse:
-		assert.Equal(t, "VALUE", r.GlobalVariables["ENV"])
+		assert.Equal(t, "VALUE", r.Body.GlobalVariables["ENV"])
+		assert.Equal(t, `"v1"`, r.Headers.ETag)
+	default:
+		t.Fatalf("unexpected resp type: %T", resp)
+	}
+
+	store.AssertExpectations(t)
+}
+
+func TestConfigAPIGet_Resolved(t *testing.T) {
+	m := &MockProcess{}
+	store := &MockStore{}
+	h := NewHandler(store, m, m)
+
+	config := models.Config{
+		Environment: models.Environment{"ENV": "VALUE"},
+	}
+	origins := models.ConfigOrigins{"environment.ENV": "config.nas1.yaml"}
+	store.On("GetBaseVersion").Return(models.Config{}, "v1", nil)
+	store.On("GetResolved").Return(config, origins, nil)
+
+	resolved := true
+	resp, err := h.ConfigAPIGet(context.Background(), api.ConfigAPIGetRequestObject{
+		Params: api.ConfigAPIGetParams{Resolved: &resolved},
+	})
+	assert.NoError(t, err)
+
+	switch r := resp.(type) {
+	case api.ConfigAPIGet200JSONResponse:
+		assert.Equal(t, "VALUE", r.Body.GlobalVariables["ENV"])
+		assert.Equal(t, map[string]string{"environment.ENV": "config.nas1.yaml"}, *r.Body.Origins)
 	default:
 		t.Fatalf("unexpected resp type: %T", resp)
 	}
@@ -395,7 +785,7 @@ func TestConfigAPIGet_Error(t *testing.T) {
 	h := NewHandler(store, m, m)
 
 	errConfig := errors.New("config error")
-	store.On("Get").Return(models.Config{}, errConfig)
+	store.On("GetBaseVersion").Return(models.Config{}, "v1", errConfig)
 
 	resp, err := h.ConfigAPIGet(context.Background(), api.ConfigAPIGetRequestObject{})
 	assert.Nil(t, resp)
@@ -404,6 +794,129 @@ func TestConfigAPIGet_Error(t *testing.T) {
 	store.AssertExpectations(t)
 }
 
+func TestConfigAPIRequirements_Success(t *testing.T) {
+	m := &MockProcess{}
+	store := &MockStore{}
+	h := NewHandler(store, m, m)
+
+	m.On("GetConfigRequirements").Return(map[string][]models.VariableRequirement{
+		"svc2": {},
+		"svc1": {{Name: "DB_PASSWORD", Required: true, Message: "required"}},
+	}, nil)
+
+	resp, err := h.ConfigAPIRequirements(context.Background(), api.ConfigAPIRequirementsRequestObject{})
+	assert.NoError(t, err)
+
+	switch r := resp.(type) {
+	case api.ConfigAPIRequirements200JSONResponse:
+		assert.Equal(t, 2, len(r))
+		assert.Equal(t, "svc1", r[0].Service)
+		assert.Equal(t, "DB_PASSWORD", r[0].Missing[0].Name)
+		assert.True(t, r[0].Missing[0].Required)
+		assert.Equal(t, "svc2", r[1].Service)
+		assert.Empty(t, r[1].Missing)
+	default:
+		t.Fatalf("unexpected resp type: %T", resp)
+	}
+
+	m.AssertExpectations(t)
+}
+
+func TestConfigAPIRequirements_Error(t *testing.T) {
+	m := &MockProcess{}
+	store := &MockStore{}
+	h := NewHandler(store, m, m)
+
+	errRequirements := errors.New("requirements error")
+	m.On("GetConfigRequirements").Return(map[string][]models.VariableRequirement{}, errRequirements)
+
+	resp, err := h.ConfigAPIRequirements(context.Background(), api.ConfigAPIRequirementsRequestObject{})
+	assert.Nil(t, resp)
+	assert.Equal(t, errRequirements, err)
+
+	m.AssertExpectations(t)
+}
+
+func TestSecretsAPIList_Success(t *testing.T) {
+	m := &MockProcess{}
+	store := &MockStore{}
+	h := NewHandler(store, m, m)
+
+	m.On("ListSecrets").Return([]models.Secret{{Name: "db_password", Ciphertext: []byte("encrypted")}}, nil)
+
+	resp, err := h.SecretsAPIList(context.Background(), api.SecretsAPIListRequestObject{})
+	assert.NoError(t, err)
+
+	switch r := resp.(type) {
+	case api.SecretsAPIList200JSONResponse:
+		assert.Equal(t, 1, len(r))
+		assert.Equal(t, "db_password", r[0].Name)
+	default:
+		t.Fatalf("unexpected resp type: %T", resp)
+	}
+
+	m.AssertExpectations(t)
+}
+
+func TestSecretsAPISet_Success(t *testing.T) {
+	m := &MockProcess{}
+	store := &MockStore{}
+	h := NewHandler(store, m, m)
+
+	m.On("SetSecret", "db_password", "value").Return(models.Secret{Name: "db_password"}, nil)
+
+	resp, err := h.SecretsAPISet(context.Background(), api.SecretsAPISetRequestObject{
+		Body: &api.SecretValue{Name: "db_password", Value: "value"},
+	})
+	assert.NoError(t, err)
+
+	switch r := resp.(type) {
+	case api.SecretsAPISet200JSONResponse:
+		assert.Equal(t, "db_password", r.Name)
+	default:
+		t.Fatalf("unexpected resp type: %T", resp)
+	}
+
+	m.AssertExpectations(t)
+}
+
+func TestSecretsAPISet_InvalidName(t *testing.T) {
+	m := &MockProcess{}
+	store := &MockStore{}
+	h := NewHandler(store, m, m)
+
+	m.On("SetSecret", "../name", "value").Return(models.Secret{}, storage.ErrInvalidSecretName)
+
+	resp, err := h.SecretsAPISet(context.Background(), api.SecretsAPISetRequestObject{
+		Body: &api.SecretValue{Name: "../name", Value: "value"},
+	})
+	assert.NoError(t, err)
+
+	switch r := resp.(type) {
+	case api.SecretsAPISetdefaultJSONResponse:
+		assert.Equal(t, http.StatusBadRequest, r.StatusCode)
+		assert.Equal(t, api.ErrorCodeINVALIDREQUEST, r.Body.Code)
+	default:
+		t.Fatalf("unexpected resp type: %T", resp)
+	}
+
+	m.AssertExpectations(t)
+}
+
+func TestSecretsAPIDelete_Success(t *testing.T) {
+	m := &MockProcess{}
+	store := &MockStore{}
+	h := NewHandler(store, m, m)
+
+	m.On("DeleteSecret", "db_password").Return(true, nil)
+
+	resp, err := h.SecretsAPIDelete(context.Background(), api.SecretsAPIDeleteRequestObject{Name: "db_password"})
+	assert.NoError(t, err)
+	assert.Equal(t, api.SecretsAPIDelete200JSONResponse{Success: true}, resp)
+
+	m.AssertExpectations(t)
+}
+
 func TestFeaturesAPIGet_Success(t *testing.T) {
 	m := &MockProcess{}
 	store := &MockStore{}
@@ -444,19 +957,19 @@ func TestSettingsAPIGet_Success(t *testing.T) {
 		Token:           "123456789123456789123456789",
 		NotificationURL: "gotify://123456789123456789",
 	}
-	store.On("Get").Return(models.Config{Settings: settings}, nil)
+	store.On("GetBaseVersion").Return(models.Config{Settings: settings}, "v1", nil)
 
 	resp, err := h.SettingsAPIGet(context.Background(), api.SettingsAPIGetRequestObject{})
 	assert.NoError(t, err)
 
 	switch r := resp.(type) {
 	case api.SettingsAPIGet200JSONResponse:
-		assert.Equal(t, "test-repo", r.Repo)
-		assert.Equal(t, "main", *r.Branch)
-		assert.Equal(t, "0 0 * * *", *r.Cron)
-		assert.Equal(t, "user", *r.Username)
-		assert.Equal(t, "1234567891********************", *r.Token)
-		assert.Equal(t, "gotify://1********************", *r.NotificationURL)
+		assert.Equal(t, "test-repo", r.Body.Repo)
+		assert.Equal(t, "main", *r.Body.Branch)
+		assert.Equal(t, "0 0 * * *", *r.Body.Cron)
+		assert.Equal(t, "user", *r.Body.Username)
+		assert.Equal(t, "1234567891********************", *r.Body.Token)
+		assert.Equal(t, "gotify://1********************", *r.Body.NotificationURL)
 	default:
 		t.Fatalf("unexpected resp type: %T", resp)
 	}
@@ -470,7 +983,7 @@ func TestSettingsAPIGet_Error(t *testing.T) {
 	h := NewHandler(store, m, m)
 
 	errSettings := errors.New("settings error")
-	store.On("Get").Return(models.Config{}, errSettings)
+	store.On("GetBaseVersion").Return(models.Config{}, "v1", errSettings)
 
 	resp, err := h.SettingsAPIGet(context.Background(), api.SettingsAPIGetRequestObject{})
 	assert.Nil(t, resp)
@@ -498,6 +1011,13 @@ func TestSettingsAPISet_Success(t *testing.T) {
 			Token:           "123456789",
 			NotificationURL: "http://example.com/notification?token=123456",
 			Username:        "old-user",
+			Timezone:        "UTC",
+			Schedules:       map[models.ScheduleName]models.ScheduleSettings{models.SchedulePrune: {Cron: "@weekly"}},
+			MaintenanceWindows: []models.MaintenanceWindow{
+				{Cron: "0 8 * * *", Duration: "10h"},
+			},
+			Retention: &models.Retention{MaxAge: "90d"},
+			Watchdog:  &models.Watchdog{WatchdogPolicy: models.WatchdogPolicy{Restart: true}},
 		},
 	}
 	newSettings := api.Settings{
@@ -509,8 +1029,9 @@ func TestSettingsAPISet_Success(t *testing.T) {
 		NotificationURL: ptr("http://ex*********************"),
 	}
 
-	store.On("Get").Return(oldConfig, nil)
-	store.On("Update", mock.MatchedBy(func(newCfg models.Config) bool {
+	store.On("GetBaseVersion").Return(oldConfig, "v1", nil)
+	m.On("ValidateConfig", mock.Anything).Return(nil)
+	store.On("UpdateAs", mock.MatchedBy(func(newCfg models.Config) bool {
 		// Check that only settings are updated
 		assert.Equal(t, oldConfig.Environment, newCfg.Environment)
 		assert.Equal(t, oldConfig.Services, newCfg.Services)
@@ -521,9 +1042,16 @@ func TestSettingsAPISet_Success(t *testing.T) {
 			Username:        *newSettings.Username,
 			Token:           "******************************",
 			NotificationURL: "http://ex*********************",
+			// schedules, retention and watchdog aren't part of the api, they are kept
+			Timezone:           oldConfig.Settings.Timezone,
+			Schedules:          oldConfig.Settings.Schedules,
+			MaintenanceWindows: oldConfig.Settings.MaintenanceWindows,
+			Retention:          oldConfig.Settings.Retention,
+			Watchdog:           oldConfig.Settings.Watchdog,
 		}, newCfg.Settings)
 		return true
-	})).Return(nil)
+	}), "", "settings updated", "v1").Return("v2", nil)
+	m.On("WriteBackConfig", "", "settings updated").Return()
 
 	req := api.SettingsAPISetRequestObject{Body: &newSettings}
 	resp, err := h.SettingsAPISet(context.Background(), req)
@@ -531,12 +1059,12 @@ func TestSettingsAPISet_Success(t *testing.T) {
 
 	switch r := resp.(type) {
 	case api.SettingsAPISet200JSONResponse:
-		assert.Equal(t, "new-repo", r.Repo)
-		assert.Equal(t, "new-branch", *r.Branch)
-		assert.Equal(t, "new-cron", *r.Cron)
-		assert.Equal(t, "new-user", *r.Username)
-		assert.Equal(t, "******************************", *r.Token)
-		assert.Equal(t, "http://ex*********************", *r.NotificationURL)
+		assert.Equal(t, "new-repo", r.Body.Repo)
+		assert.Equal(t, "new-branch", *r.Body.Branch)
+		assert.Equal(t, "new-cron", *r.Body.Cron)
+		assert.Equal(t, "new-user", *r.Body.Username)
+		assert.Equal(t, "******************************", *r.Body.Token)
+		assert.Equal(t, "http://ex*********************", *r.Body.NotificationURL)
 	default:
 		t.Fatalf("unexpected resp type: %T", resp)
 	}
@@ -564,8 +1092,9 @@ func TestSettingsAPISet_UpdateToken(t *testing.T) {
 		Token:    ptr("123456789123456789123456789"),
 	}
 
-	store.On("Get").Return(oldConfig, nil)
-	store.On("Update", mock.MatchedBy(func(newCfg models.Config) bool {
+	store.On("GetBaseVersion").Return(oldConfig, "v1", nil)
+	m.On("ValidateConfig", mock.Anything).Return(nil)
+	store.On("UpdateAs", mock.MatchedBy(func(newCfg models.Config) bool {
 		// Check that only settings are updated
 		assert.Equal(t, oldConfig.Environment, newCfg.Environment)
 		assert.Equal(t, oldConfig.Services, newCfg.Services)
@@ -575,7 +1104,8 @@ func TestSettingsAPISet_UpdateToken(t *testing.T) {
 			Token:    *newSettings.Token,
 		}, newCfg.Settings)
 		return true
-	})).Return(nil)
+	}), "", "settings updated", "v1").Return("v2", nil)
+	m.On("WriteBackConfig", "", "settings updated").Return()
 
 	req := api.SettingsAPISetRequestObject{Body: &newSettings}
 	resp, err := h.SettingsAPISet(context.Background(), req)
@@ -583,9 +1113,9 @@ func TestSettingsAPISet_UpdateToken(t *testing.T) {
 
 	switch r := resp.(type) {
 	case api.SettingsAPISet200JSONResponse:
-		assert.Equal(t, "new-repo", r.Repo)
-		assert.Equal(t, "new-user", *r.Username)
-		assert.Equal(t, "1234567891********************", *r.Token)
+		assert.Equal(t, "new-repo", r.Body.Repo)
+		assert.Equal(t, "new-user", *r.Body.Username)
+		assert.Equal(t, "1234567891********************", *r.Body.Token)
 	default:
 		t.Fatalf("unexpected resp type: %T", resp)
 	}
@@ -607,7 +1137,7 @@ func TestSettingsAPISet_Error(t *testing.T) {
 	}
 
 	errSettings := errors.New("settings error")
-	store.On("Get").Return(models.Config{}, errSettings)
+	store.On("GetBaseVersion").Return(models.Config{}, "v1", errSettings)
 
 	req := api.SettingsAPISetRequestObject{Body: &settings}
 	resp, err := h.SettingsAPISet(context.Background(), req)
@@ -646,34 +1176,37 @@ func TestConfigAPISet_Success(t *testing.T) {
 			"NEW_ENV": "NEW_VALUE",
 		},
 		Services: map[string]map[string]string{
-			"service2": {"key2": "value2"},
+			"service2": {"var2": "value2"},
 		},
 	}
 
-	store.On("Get").Return(oldConfig, nil)
-	store.On("Update", mock.MatchedBy(func(newCfg models.Config) bool {
+	store.On("GetBaseVersion").Return(oldConfig, "v1", nil)
+	m.On("ValidateConfig", mock.Anything).Return(nil)
+	store.On("UpdateAs", mock.MatchedBy(func(newCfg models.Config) bool {
 		// Check that only environment and services are updated
 		assert.Equal(t, models.Environment{"NEW_ENV": "NEW_VALUE"}, newCfg.Environment)
 		assert.Equal(t, map[string]models.ServiceConfig{
-			"service2": {"key2": "value2"},
+			"service2": {"var2": "value2"},
 		}, newCfg.Services)
 		assert.Equal(t, oldConfig.Settings, newCfg.Settings)
 		return true
-	})).Return(nil)
+	}), "admin", "configuration updated", "v1").Return("v2", nil)
+	m.On("WriteBackConfig", "admin", "configuration updated").Return()
 
 	req := api.ConfigAPISetRequestObject{Body: &newConfig}
-	resp, err := h.ConfigAPISet(context.Background(), req)
+	resp, err := h.ConfigAPISet(middlewares.ContextWithUsername(context.Background(), "admin"), req)
 	assert.NoError(t, err)
 
 	switch r := resp.(type) {
 	case api.ConfigAPISet200JSONResponse:
-		assert.Equal(t, "NEW_VALUE", r.GlobalVariables["NEW_ENV"])
-		assert.Equal(t, "value2", r.Services["service2"]["key2"])
+		assert.Equal(t, "NEW_VALUE", r.Body.GlobalVariables["NEW_ENV"])
+		assert.Equal(t, "value2", r.Body.Services["service2"]["var2"])
 	default:
 		t.Fatalf("unexpected resp type: %T", resp)
 	}
 
 	store.AssertExpectations(t)
+	m.AssertCalled(t, "WriteBackConfig", "admin", "configuration updated")
 }
 
 func TestConfigAPISet_Error(t *testing.T) {
@@ -691,7 +1224,7 @@ func TestConfigAPISet_Error(t *testing.T) {
 	}
 
 	errConfig := errors.New("config error")
-	store.On("Get").Return(models.Config{}, errConfig)
+	store.On("GetBaseVersion").Return(models.Config{}, "v1", errConfig)
 
 	req := api.ConfigAPISetRequestObject{Body: &config}
 	resp, err := h.ConfigAPISet(context.Background(), req)
@@ -946,3 +1479,249 @@ func TestUserAPIChangePassword_Error(t *testing.T) {
 
 	m.AssertExpectations(t)
 }
+
+func TestConfigAPISet_ValidationError(t *testing.T) {
+	m := &MockProcess{}
+	store := &MockStore{}
+	h := NewHandler(store, m, m)
+
+	config := api.Config{
+		GlobalVariables: map[string]string{"BAD-KEY": "VALUE"},
+		Services:        map[string]map[string]string{},
+	}
+	validationErr := &models.ValidationError{Fields: []models.FieldError{
+		{Field: "environment.BAD-KEY", Message: "invalid variable name"},
+	}}
+	store.On("GetBaseVersion").Return(models.Config{}, "v1", nil)
+	m.On("ValidateConfig", mock.Anything).Return(validationErr)
+
+	resp, err := h.ConfigAPISet(context.Background(), api.ConfigAPISetRequestObject{Body: &config})
+	assert.NoError(t, err)
+
+	switch r := resp.(type) {
+	case api.ConfigAPISetdefaultJSONResponse:
+		assert.Equal(t, http.StatusBadRequest, r.StatusCode)
+		assert.Equal(t, api.ErrorCodeINVALIDREQUEST, r.Body.Code)
+		assert.Equal(t, []api.FieldError{{Field: "environment.BAD-KEY", Message: "invalid variable name"}}, *r.Body.Fields)
+	default:
+		t.Fatalf("unexpected resp type: %T", resp)
+	}
+	store.AssertNotCalled(t, "UpdateAs", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestSettingsAPISet_ValidationError(t *testing.T) {
+	m := &MockProcess{}
+	store := &MockStore{}
+	h := NewHandler(store, m, m)
+
+	validationErr := &models.ValidationError{Fields: []models.FieldError{
+		{Field: "settings.cron", Message: "invalid cron expression"},
+	}}
+	store.On("GetBaseVersion").Return(models.Config{}, "v1", nil)
+	m.On("ValidateConfig", mock.Anything).Return(nil)
+	store.On("UpdateAs", mock.Anything, "", "settings updated", "v1").Return("", validationErr)
+
+	settings := api.Settings{Repo: "https://github.com/example/repo", Cron: ptr("invalid")}
+	resp, err := h.SettingsAPISet(context.Background(), api.SettingsAPISetRequestObject{Body: &settings})
+	assert.NoError(t, err)
+
+	switch r := resp.(type) {
+	case api.SettingsAPISetdefaultJSONResponse:
+		assert.Equal(t, http.StatusBadRequest, r.StatusCode)
+		assert.Equal(t, "settings.cron", (*r.Body.Fields)[0].Field)
+	default:
+		t.Fatalf("unexpected resp type: %T", resp)
+	}
+}
+
+func TestConfigHistoryAPIList_Success(t *testing.T) {
+	m := &MockProcess{}
+	store := &MockStore{}
+	h := NewHandler(store, m, m)
+
+	now := time.Now()
+	revisions := []models.ConfigRevision{
+		{ID: 3, Author: "admin", Message: "settings updated", Time: now},
+		{ID: 2, Message: "edited on disk", Time: now},
+	}
+	store.On("GetRevisions", storage.NewIDCursor(2, 4)).Return(revisions, nil)
+
+	resp, err := h.ConfigHistoryAPIList(context.Background(), api.ConfigHistoryAPIListRequestObject{
+		Params: api.ConfigHistoryAPIListParams{Limit: 2, Offset: ptr("4")},
+	})
+	assert.NoError(t, err)
+
+	switch r := resp.(type) {
+	case api.ConfigHistoryAPIList200JSONResponse:
+		assert.Equal(t, []api.ConfigRevision{
+			{Id: "3", Author: "admin", Message: "settings updated", Time: now},
+			{Id: "2", Message: "edited on disk", Time: now},
+		}, r.Items)
+		assert.Equal(t, api.PageInfo{HasNextPage: true, EndCursor: "2"}, r.PageInfo)
+	default:
+		t.Fatalf("unexpected resp type: %T", resp)
+	}
+}
+
+func TestConfigHistoryAPIList_InvalidLimit(t *testing.T) {
+	m := &MockProcess{}
+	store := &MockStore{}
+	h := NewHandler(store, m, m)
+
+	_, err := h.ConfigHistoryAPIList(context.Background(), api.ConfigHistoryAPIListRequestObject{
+		Params: api.ConfigHistoryAPIListParams{Limit: 0},
+	})
+	assert.Error(t, err)
+	store.AssertNotCalled(t, "GetRevisions", mock.Anything)
+}
+
+func TestConfigHistoryAPIRead(t *testing.T) {
+	m := &MockProcess{}
+	store := &MockStore{}
+	h := NewHandler(store, m, m)
+
+	store.On("GetRevision", uint64(1)).Return(models.ConfigRevision{ID: 1, Content: "cron: 0\n"}, nil)
+	store.On("GetRevision", uint64(9)).Return(models.ConfigRevision{}, storage.ErrRevisionNotFound)
+
+	resp, err := h.ConfigHistoryAPIRead(context.Background(), api.ConfigHistoryAPIReadRequestObject{Id: "1"})
+	assert.NoError(t, err)
+	assert.Equal(t, "cron: 0\n", resp.(api.ConfigHistoryAPIRead200JSONResponse).Content)
+
+	resp, err = h.ConfigHistoryAPIRead(context.Background(), api.ConfigHistoryAPIReadRequestObject{Id: "9"})
+	assert.NoError(t, err)
+	notFound := resp.(api.ConfigHistoryAPIReaddefaultJSONResponse)
+	assert.Equal(t, http.StatusNotFound, notFound.StatusCode)
+	assert.Equal(t, api.ErrorCodeNOTFOUND, notFound.Body.Code)
+
+	_, err = h.ConfigHistoryAPIRead(context.Background(), api.ConfigHistoryAPIReadRequestObject{Id: "abc"})
+	assert.Error(t, err)
+}
+
+func TestConfigHistoryAPIDiff(t *testing.T) {
+	m := &MockProcess{}
+	store := &MockStore{}
+	h := NewHandler(store, m, m)
+
+	diff := models.FileDiff{OldFile: "config.yaml (#1)", NewFile: "config.yaml (#2)", Diff: "-a\n+b\n"}
+	store.On("DiffRevisions", uint64(1), uint64(2)).Return(diff, nil)
+
+	resp, err := h.ConfigHistoryAPIDiff(context.Background(), api.ConfigHistoryAPIDiffRequestObject{
+		Params: api.ConfigHistoryAPIDiffParams{From: "1", To: "2"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, api.ConfigHistoryAPIDiff200JSONResponse{
+		OldFile: "config.yaml (#1)", NewFile: "config.yaml (#2)", Diff: "-a\n+b\n",
+	}, resp)
+}
+
+func TestConfigHistoryAPIRestore(t *testing.T) {
+	m := &MockProcess{}
+	store := &MockStore{}
+	h := NewHandler(store, m, m)
+
+	now := time.Now()
+	store.On("RestoreRevision", uint64(1), "admin").Return(
+		models.ConfigRevision{ID: 4, Author: "admin", Message: "restore of revision 1", Time: now}, nil)
+	store.On("RestoreRevision", uint64(2), "admin").Return(models.ConfigRevision{},
+		&models.ValidationError{Fields: []models.FieldError{{Field: "settings.cron", Message: "invalid cron expression"}}})
+
+	m.On("WriteBackConfig", "admin", "restore of revision 1").Return()
+
+	ctx := middlewares.ContextWithUsername(context.Background(), "admin")
+	resp, err := h.ConfigHistoryAPIRestore(ctx, api.ConfigHistoryAPIRestoreRequestObject{Id: "1"})
+	assert.NoError(t, err)
+	assert.Equal(t, api.ConfigHistoryAPIRestore200JSONResponse{
+		Id: "4", Author: "admin", Message: "restore of revision 1", Time: now,
+	}, resp)
+
+	resp, err = h.ConfigHistoryAPIRestore(ctx, api.ConfigHistoryAPIRestoreRequestObject{Id: "2"})
+	assert.NoError(t, err)
+	invalid := resp.(api.ConfigHistoryAPIRestoredefaultJSONResponse)
+	assert.Equal(t, http.StatusBadRequest, invalid.StatusCode)
+	assert.Equal(t, "settings.cron", (*invalid.Body.Fields)[0].Field)
+}
+
+func TestConfigAPISet_IfMatch(t *testing.T) {
+	m := &MockProcess{}
+	store := &MockStore{}
+	h := NewHandler(store, m, m)
+
+	store.On("GetBaseVersion").Return(models.Config{}, "v1", nil)
+	m.On("ValidateConfig", mock.Anything).Return(nil)
+	store.On("UpdateAs", mock.Anything, "", "configuration updated", "v1").Return("v2", nil)
+	m.On("WriteBackConfig", "", "configuration updated").Return()
+
+	config := api.Config{GlobalVariables: map[string]string{"A": "1"}, Services: map[string]map[string]string{}}
+	resp, err := h.ConfigAPISet(context.Background(), api.ConfigAPISetRequestObject{
+		Params: api.ConfigAPISetParams{IfMatch: ptr(`"v1"`)},
+		Body:   &config,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, `"v2"`, resp.(api.ConfigAPISet200JSONResponse).Headers.ETag)
+
+	resp, err = h.ConfigAPISet(context.Background(), api.ConfigAPISetRequestObject{
+		Params: api.ConfigAPISetParams{IfMatch: ptr(`"v0"`)},
+		Body:   &config,
+	})
+	assert.NoError(t, err)
+	conflict := resp.(api.ConfigAPISetdefaultJSONResponse)
+	assert.Equal(t, http.StatusPreconditionFailed, conflict.StatusCode)
+	assert.Equal(t, api.ErrorCodeCONFLICT, conflict.Body.Code)
+	store.AssertNumberOfCalls(t, "UpdateAs", 1)
+}
+
+func TestConfigAPI_GetThenSetKeepsSensitiveValues(t *testing.T) {
+	m := &MockProcess{}
+	configStore := storage.NewConfigStore(filepath.Join(t.TempDir(), "config.yaml"))
+	assert.NoError(t, configStore.Update(models.Config{
+		Environment: models.Environment{"DB_PASSWORD": "plain", "TZ": "UTC"},
+		Services:    map[string]models.ServiceConfig{"web": {"API_TOKEN": "plain"}},
+	}))
+	h := NewHandler(configStore, m, m)
+	m.On("ValidateConfig", mock.Anything).Return(nil)
+	m.On("WriteBackConfig", "", "configuration updated").Return()
+
+	resp, err := h.ConfigAPIGet(context.Background(), api.ConfigAPIGetRequestObject{})
+	assert.NoError(t, err)
+	got := resp.(api.ConfigAPIGet200JSONResponse)
+	assert.Equal(t, models.ObfuscatedValue, got.Body.GlobalVariables["DB_PASSWORD"])
+	assert.Equal(t, models.ObfuscatedValue, got.Body.Services["web"]["API_TOKEN"])
+
+	got.Body.GlobalVariables["TZ"] = "Europe/Paris"
+	_, err = h.ConfigAPISet(context.Background(), api.ConfigAPISetRequestObject{
+		Params: api.ConfigAPISetParams{IfMatch: &got.Headers.ETag},
+		Body:   &got.Body,
+	})
+	assert.NoError(t, err)
+
+	cfg, err := configStore.GetBase()
+	assert.NoError(t, err)
+	assert.Equal(t, models.Environment{"DB_PASSWORD": "plain", "TZ": "Europe/Paris"}, cfg.Environment)
+	assert.Equal(t, models.ServiceConfig{"API_TOKEN": "plain"}, cfg.Services["web"])
+}
+
+func TestSettingsAPISet_VersionConflict(t *testing.T) {
+	m := &MockProcess{}
+	store := &MockStore{}
+	h := NewHandler(store, m, m)
+
+	// the file changed between the read and the write
+	store.On("GetBaseVersion").Return(models.Config{}, "v1", nil)
+	m.On("ValidateConfig", mock.Anything).Return(nil)
+	store.On("UpdateAs", mock.Anything, "", "settings updated", "v1").Return("", storage.ErrVersionConflict)
+
+	settings := api.Settings{Repo: "https://github.com/example/repo"}
+	resp, err := h.SettingsAPISet(context.Background(), api.SettingsAPISetRequestObject{Body: &settings})
+	assert.NoError(t, err)
+	conflict := resp.(api.SettingsAPISetdefaultJSONResponse)
+	assert.Equal(t, http.StatusPreconditionFailed, conflict.StatusCode)
+	m.AssertNotCalled(t, "WriteBackConfig", mock.Anything, mock.Anything)
+}
+
+func TestMatchesVersion(t *testing.T) {
+	assert.True(t, matchesVersion(nil, "v1"))
+	assert.True(t, matchesVersion(ptr("*"), "v1"))
+	assert.True(t, matchesVersion(ptr(`"v1"`), "v1"))
+	assert.True(t, matchesVersion(ptr(`W/"v0", "v1"`), "v1"))
+	assert.False(t, matchesVersion(ptr(`"v0"`), "v1"))
+}
@@ -0,0 +1,110 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"omar-kada/autonas/internal/docker"
+	"omar-kada/autonas/internal/process"
+	"omar-kada/autonas/models"
+)
+
+// default number of lines returned from the end of the logs
+const defaultLogsTail = "100"
+
+// containerLogLine is a line of the logs, written as a line of JSON
+type containerLogLine struct {
+	Time   time.Time        `json:"time"`
+	Stream models.LogStream `json:"stream"`
+	Line   string           `json:"line"`
+}
+
+// ContainerLogsHandler streams the logs of the containers of the managed stacks
+type ContainerLogsHandler struct {
+	processSvc process.Service
+}
+
+func newContainerLogsHandler(processSvc process.Service) *ContainerLogsHandler {
+	return &ContainerLogsHandler{processSvc: processSvc}
+}
+
+// handle writes the logs of the container as newline delimited JSON, the parameters are
+// tail (number of lines or all), since (duration or RFC 3339 date) and follow.
+// The request is authenticated by the middlewares of /api
+func (h *ContainerLogsHandler) handle(w http.ResponseWriter, r *http.Request) {
+	options, err := parseContainerLogsOptions(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	stack, id := r.PathValue("stack"), r.PathValue("id")
+	err = h.processSvc.CheckManagedContainer(r.Context(), stack, id)
+	if errors.Is(err, docker.ErrContainerNotManaged) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	} else if err != nil {
+		slog.Error("couldn't read container logs", "container", id, "error", err)
+		http.Error(w, "couldn't read container logs", http.StatusInternalServerError)
+		return
+	}
+
+	// the headers are sent before the first line, which can take a while when following
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	rc := http.NewResponseController(w)
+	if err := rc.Flush(); err != nil {
+		return
+	}
+
+	encoder := json.NewEncoder(w)
+	var writeErr error
+	err = h.processSvc.StreamContainerLogs(r.Context(), id, options, func(line models.ContainerLogLine) {
+		if writeErr != nil {
+			return
+		}
+		writeErr = encoder.Encode(containerLogLine{Time: line.Time, Stream: line.Stream, Line: line.Line})
+		if writeErr == nil && options.Follow {
+			writeErr = rc.Flush()
+		}
+	})
+	if err != nil {
+		// the status is already sent, the client sees a truncated stream
+		slog.Warn("container logs interrupted", "container", id, "error", err)
+	}
+}
+
+func parseContainerLogsOptions(r *http.Request) (models.ContainerLogsOptions, error) {
+	query := r.URL.Query()
+	options := models.ContainerLogsOptions{Tail: defaultLogsTail}
+
+	if tail := query.Get("tail"); tail != "" {
+		if n, err := strconv.Atoi(tail); tail != "all" && (err != nil || n < 0) {
+			return options, fmt.Errorf("invalid tail %q, use a number of lines or all", tail)
+		}
+		options.Tail = tail
+	}
+	if since := query.Get("since"); since != "" {
+		if d, err := time.ParseDuration(since); err == nil && d > 0 {
+			options.Since = time.Now().Add(-d)
+		} else if t, err := time.Parse(time.RFC3339, since); err == nil {
+			options.Since = t
+		} else {
+			return options, fmt.Errorf("invalid since %q, use a duration (10m) or a date (2006-01-02T15:04:05Z)", since)
+		}
+	}
+	if follow := query.Get("follow"); follow != "" {
+		f, err := strconv.ParseBool(follow)
+		if err != nil {
+			return options, fmt.Errorf("invalid follow %q", follow)
+		}
+		options.Follow = f
+	}
+	return options, nil
+}
@@ -0,0 +1,86 @@
+package server
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"omar-kada/autonas/internal/docker"
+	"omar-kada/autonas/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func serveContainerLogs(processSvc *MockProcess, url string) *httptest.ResponseRecorder {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/stacks/{stack}/containers/{id}/logs", newContainerLogsHandler(processSvc).handle)
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, url, nil))
+	return rec
+}
+
+func TestContainerLogs_WritesLines(t *testing.T) {
+	processSvc := &MockProcess{logLines: []models.ContainerLogLine{
+		{Time: time.Date(2025, 3, 10, 10, 0, 0, 0, time.UTC), Stream: models.LogStreamStdout, Line: "started"},
+		{Time: time.Date(2025, 3, 10, 10, 0, 1, 0, time.UTC), Stream: models.LogStreamStderr, Line: "warning"},
+	}}
+	processSvc.On("CheckManagedContainer", mock.Anything, "web", "abc").Return(nil)
+	processSvc.On("StreamContainerLogs", mock.Anything, "abc", models.ContainerLogsOptions{Tail: "all", Follow: true}).Return(nil)
+
+	rec := serveContainerLogs(processSvc, "/api/stacks/web/containers/abc/logs?tail=all&follow=true")
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/x-ndjson", rec.Header().Get("Content-Type"))
+	assert.Equal(t, `{"time":"2025-03-10T10:00:00Z","stream":"stdout","line":"started"}
+{"time":"2025-03-10T10:00:01Z","stream":"stderr","line":"warning"}
+`, rec.Body.String())
+	assert.True(t, rec.Flushed)
+}
+
+func TestContainerLogs_Errors(t *testing.T) {
+	processSvc := &MockProcess{}
+	processSvc.On("CheckManagedContainer", mock.Anything, "web", "other").Return(docker.ErrContainerNotManaged)
+	processSvc.On("CheckManagedContainer", mock.Anything, "web", "broken").Return(errors.New("docker is down"))
+
+	assert.Equal(t, http.StatusNotFound, serveContainerLogs(processSvc, "/api/stacks/web/containers/other/logs").Code)
+	assert.Equal(t, http.StatusInternalServerError, serveContainerLogs(processSvc, "/api/stacks/web/containers/broken/logs").Code)
+	processSvc.AssertNotCalled(t, "StreamContainerLogs", mock.Anything, mock.Anything, mock.Anything)
+	for _, query := range []string{"tail=-1", "tail=last", "since=yesterday", "follow=maybe"} {
+		rec := serveContainerLogs(processSvc, "/api/stacks/web/containers/abc/logs?"+query)
+		assert.Equal(t, http.StatusBadRequest, rec.Code, query)
+	}
+}
+
+func TestContainerLogs_HeadersBeforeTheFirstLine(t *testing.T) {
+	processSvc := &MockProcess{}
+	processSvc.On("CheckManagedContainer", mock.Anything, "web", "abc").Return(nil)
+	processSvc.On("StreamContainerLogs", mock.Anything, "abc", mock.Anything).Return(errors.New("container stopped"))
+
+	rec := serveContainerLogs(processSvc, "/api/stacks/web/containers/abc/logs?follow=true")
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/x-ndjson", rec.Header().Get("Content-Type"))
+	assert.True(t, rec.Flushed, "the headers are flushed without any line")
+	assert.Empty(t, rec.Body.String())
+}
+
+func TestParseContainerLogsOptions(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/logs", nil)
+	options, err := parseContainerLogsOptions(r)
+	assert.NoError(t, err)
+	assert.Equal(t, models.ContainerLogsOptions{Tail: defaultLogsTail}, options)
+
+	r = httptest.NewRequest(http.MethodGet, "/logs?tail=20&since=2025-03-10T10:00:00Z", nil)
+	options, err = parseContainerLogsOptions(r)
+	assert.NoError(t, err)
+	assert.Equal(t, "20", options.Tail)
+	assert.Equal(t, time.Date(2025, 3, 10, 10, 0, 0, 0, time.UTC), options.Since)
+
+	r = httptest.NewRequest(http.MethodGet, "/logs?since=10m", nil)
+	options, err = parseContainerLogsOptions(r)
+	assert.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(-10*time.Minute), options.Since, time.Second)
+}
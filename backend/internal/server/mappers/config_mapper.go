@@ -8,16 +8,16 @@ import (
 // ConfigMapper maps models.Config to api.Config
 type ConfigMapper struct{}
 
-// Map converts a models.Config to an api.Config
+// Map converts a models.Config to an api.Config, the values of the sensitive variables are obfuscated
 func (ConfigMapper) Map(config models.Config) api.Config {
 	convertedMap := make(map[string]map[string]string)
 
 	for key, innerMap := range config.Services {
-		convertedMap[key] = innerMap
+		convertedMap[key] = models.ObfuscateVariables(innerMap)
 	}
 
 	return api.Config{
-		GlobalVariables: config.Environment,
+		GlobalVariables: models.ObfuscateVariables(config.Environment),
 		Services:        convertedMap,
 	}
 }
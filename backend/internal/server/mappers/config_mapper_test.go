@@ -26,37 +26,48 @@ func TestConfigMapper_Map(t *testing.T) {
 					Cron:   "0 0 * * *",
 				},
 				Environment: models.Environment{
-					"key1": "value1",
-					"key2": "value2",
+					"var1": "value1",
+					"var2": "value2",
 				},
 				Services: map[string]models.ServiceConfig{
 					"service1": {
-						"key1": "value1",
-						"key2": "value2",
+						"var1": "value1",
+						"var2": "value2",
 					},
 					"service2": {
-						"key3": "value3",
-						"key4": "value4",
+						"var3": "value3",
+						"var4": "value4",
 					},
 				},
 			},
 			want: api.Config{
 				GlobalVariables: map[string]string{
-					"key1": "value1",
-					"key2": "value2",
+					"var1": "value1",
+					"var2": "value2",
 				},
 				Services: map[string]map[string]string{
 					"service1": {
-						"key1": "value1",
-						"key2": "value2",
+						"var1": "value1",
+						"var2": "value2",
 					},
 					"service2": {
-						"key3": "value3",
-						"key4": "value4",
+						"var3": "value3",
+						"var4": "value4",
 					},
 				},
 			},
 		},
+		{
+			name: "sensitive values are obfuscated",
+			in: models.Config{
+				Environment: models.Environment{"DB_PASSWORD": "plain", "API_KEY": "secret://api", "EMPTY_TOKEN": ""},
+				Services:    map[string]models.ServiceConfig{"web": {"ADMIN_SECRET": "plain", "PORT": "80"}},
+			},
+			want: api.Config{
+				GlobalVariables: map[string]string{"DB_PASSWORD": models.ObfuscatedValue, "API_KEY": "secret://api", "EMPTY_TOKEN": ""},
+				Services:        map[string]map[string]string{"web": {"ADMIN_SECRET": models.ObfuscatedValue, "PORT": "80"}},
+			},
+		},
 		{
 			name: "empty",
 			in: models.Config{
@@ -90,34 +101,34 @@ func TestConfigMapper_UnMap(t *testing.T) {
 			name: "basic",
 			in: api.Config{
 				GlobalVariables: map[string]string{
-					"key1": "value1",
-					"key2": "value2",
+					"var1": "value1",
+					"var2": "value2",
 				},
 				Services: map[string]map[string]string{
 					"service1": {
-						"key1": "value1",
-						"key2": "value2",
+						"var1": "value1",
+						"var2": "value2",
 					},
 					"service2": {
-						"key3": "value3",
-						"key4": "value4",
+						"var3": "value3",
+						"var4": "value4",
 					},
 				},
 			},
 			want: models.Config{
 				Settings: models.Settings{},
 				Environment: models.Environment{
-					"key1": "value1",
-					"key2": "value2",
+					"var1": "value1",
+					"var2": "value2",
 				},
 				Services: map[string]models.ServiceConfig{
 					"service1": {
-						"key1": "value1",
-						"key2": "value2",
+						"var1": "value1",
+						"var2": "value2",
 					},
 					"service2": {
-						"key3": "value3",
-						"key4": "value4",
+						"var3": "value3",
+						"var4": "value4",
 					},
 				},
 			},
@@ -0,0 +1,40 @@
+package mappers
+
+import (
+	"fmt"
+
+	"omar-kada/autonas/api"
+	"omar-kada/autonas/models"
+)
+
+// ConfigRevisionMapper maps models.ConfigRevision to api.ConfigRevision
+type ConfigRevisionMapper struct{}
+
+// Map converts a models.ConfigRevision to an api.ConfigRevision, without its content
+func (ConfigRevisionMapper) Map(revision models.ConfigRevision) api.ConfigRevision {
+	return api.ConfigRevision{
+		Id:      fmt.Sprintf("%d", revision.ID),
+		Author:  revision.Author,
+		Message: revision.Message,
+		Time:    revision.Time,
+	}
+}
+
+// MapWithContent converts a models.ConfigRevision to an api.ConfigRevisionWithContent
+func (ConfigRevisionMapper) MapWithContent(revision models.ConfigRevision) api.ConfigRevisionWithContent {
+	return api.ConfigRevisionWithContent{
+		Id:      fmt.Sprintf("%d", revision.ID),
+		Author:  revision.Author,
+		Message: revision.Message,
+		Time:    revision.Time,
+		Content: revision.Content,
+	}
+}
+
+// MapToPageInfo maps a slice of models.ConfigRevision to an api.PageInfo, determining if there are more items
+// and providing the end cursor for pagination.
+func (ConfigRevisionMapper) MapToPageInfo(revisions []models.ConfigRevision, limit int) api.PageInfo {
+	return MapToPageInfo(revisions, limit, func(revision models.ConfigRevision) string {
+		return fmt.Sprintf("%d", revision.ID)
+	})
+}
@@ -0,0 +1,28 @@
+package mappers
+
+import (
+	"testing"
+	"time"
+
+	"omar-kada/autonas/api"
+	"omar-kada/autonas/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigRevisionMapper_Map(t *testing.T) {
+	now := time.Now()
+	in := models.ConfigRevision{ID: 3, Author: "admin", Message: "settings updated", Time: now, Content: "cron: 0"}
+
+	assert.Equal(t, api.ConfigRevision{Id: "3", Author: "admin", Message: "settings updated", Time: now}, ConfigRevisionMapper{}.Map(in))
+	assert.Equal(t, api.ConfigRevisionWithContent{
+		Id: "3", Author: "admin", Message: "settings updated", Time: now, Content: "cron: 0",
+	}, ConfigRevisionMapper{}.MapWithContent(in))
+}
+
+func TestConfigRevisionMapper_MapToPageInfo(t *testing.T) {
+	revisions := []models.ConfigRevision{{ID: 5}, {ID: 4}}
+
+	assert.Equal(t, api.PageInfo{HasNextPage: true, EndCursor: "4"}, ConfigRevisionMapper{}.MapToPageInfo(revisions, 2))
+	assert.Equal(t, api.PageInfo{HasNextPage: false, EndCursor: ""}, ConfigRevisionMapper{}.MapToPageInfo(nil, 2))
+}
@@ -0,0 +1,29 @@
+package mappers
+
+import (
+	"fmt"
+
+	"omar-kada/autonas/api"
+	"omar-kada/autonas/models"
+)
+
+// DeploymentLogMapper maps models.DeploymentLog to api.DeploymentLog.
+type DeploymentLogMapper struct{}
+
+// Map converts a models.DeploymentLog to an api.DeploymentLog.
+func (DeploymentLogMapper) Map(log models.DeploymentLog) api.DeploymentLog {
+	return api.DeploymentLog{
+		ID:      log.ID,
+		Time:    log.Time,
+		Service: log.Service,
+		Stream:  api.LogStream(log.Stream),
+		Line:    log.Line,
+	}
+}
+
+// MapToPageInfo maps a page of logs to an api.PageInfo, the end cursor is the ID of the last log.
+func (DeploymentLogMapper) MapToPageInfo(logs []models.DeploymentLog, limit int) api.PageInfo {
+	return MapToPageInfo(logs, limit, func(log models.DeploymentLog) string {
+		return fmt.Sprintf("%d", log.ID)
+	})
+}
@@ -0,0 +1,36 @@
+package mappers
+
+import (
+	"testing"
+	"time"
+
+	"omar-kada/autonas/api"
+	"omar-kada/autonas/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeploymentLogMapper_Map(t *testing.T) {
+	log := models.DeploymentLog{
+		ID:      3,
+		Time:    time.Now(),
+		Service: "web",
+		Stream:  models.LogStreamStderr,
+		Line:    "Pulling web",
+	}
+
+	assert.Equal(t, api.DeploymentLog{
+		ID:      3,
+		Time:    log.Time,
+		Service: "web",
+		Stream:  api.LogStreamStderr,
+		Line:    "Pulling web",
+	}, DeploymentLogMapper{}.Map(log))
+}
+
+func TestDeploymentLogMapper_MapToPageInfo(t *testing.T) {
+	logs := []models.DeploymentLog{{ID: 9}, {ID: 8}}
+
+	assert.Equal(t, api.PageInfo{HasNextPage: true, EndCursor: "8"}, DeploymentLogMapper{}.MapToPageInfo(logs, 2))
+	assert.Equal(t, api.PageInfo{HasNextPage: false, EndCursor: "8"}, DeploymentLogMapper{}.MapToPageInfo(logs, 5))
+}
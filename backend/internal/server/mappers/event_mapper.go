@@ -12,7 +12,7 @@ type EventMapper struct{}
 
 // Map converts a models.Event to an api.Event.
 func (EventMapper) Map(event models.Event) api.Event {
-	return api.Event{
+	res := api.Event{
 		ID:         event.ID,
 		Time:       event.Time,
 		Msg:        event.Msg,
@@ -20,6 +20,12 @@ func (EventMapper) Map(event models.Event) api.Event {
 		ObjectId:   event.ObjectID,
 		ObjectName: event.ObjectName,
 	}
+	if event.Type == models.EventLog {
+		stream := api.LogStream(event.Stream)
+		res.Service = &event.Service
+		res.Stream = &stream
+	}
+	return res
 }
 
 // MapToPageInfo maps a slice of models.Event to an api.PageInfo, determining if there are more items
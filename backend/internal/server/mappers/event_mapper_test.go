@@ -103,3 +103,12 @@ func TestEventMapper_MapToPageInfo(t *testing.T) {
 		})
 	}
 }
+
+func TestEventMapper_MapLog(t *testing.T) {
+	event := models.Event{ID: 0, Type: models.EventLog, Msg: "Pulling", ObjectID: 2, Service: "web", Stream: models.LogStreamStdout}
+
+	actual := EventMapper{}.Map(event)
+
+	assert.Equal(t, "web", *actual.Service)
+	assert.Equal(t, api.LogStreamStdout, *actual.Stream)
+}
@@ -0,0 +1,22 @@
+package mappers
+
+import (
+	"omar-kada/autonas/api"
+	"omar-kada/autonas/models"
+)
+
+// RequirementMapper maps models.VariableRequirement to api.VariableRequirement
+type RequirementMapper struct{}
+
+// Map converts a models.VariableRequirement to an api.VariableRequirement
+func (RequirementMapper) Map(requirement models.VariableRequirement) api.VariableRequirement {
+	var message *string
+	if requirement.Message != "" {
+		message = &requirement.Message
+	}
+	return api.VariableRequirement{
+		Name:     requirement.Name,
+		Required: requirement.Required,
+		Message:  message,
+	}
+}
@@ -0,0 +1,37 @@
+package mappers
+
+import (
+	"testing"
+
+	"omar-kada/autonas/api"
+	"omar-kada/autonas/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequirementMapper_Map(t *testing.T) {
+	message := "db password is required"
+	cases := []struct {
+		name string
+		in   models.VariableRequirement
+		want api.VariableRequirement
+	}{
+		{
+			name: "required with message",
+			in:   models.VariableRequirement{Name: "DB_PASSWORD", Required: true, Message: message},
+			want: api.VariableRequirement{Name: "DB_PASSWORD", Required: true, Message: &message},
+		},
+		{
+			name: "without message",
+			in:   models.VariableRequirement{Name: "PORT"},
+			want: api.VariableRequirement{Name: "PORT"},
+		},
+	}
+
+	m := RequirementMapper{}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, m.Map(tc.in))
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package mappers
+
+import (
+	"time"
+
+	"omar-kada/autonas/api"
+	"omar-kada/autonas/models"
+)
+
+// ScheduleMapper maps models.Schedules to api.Schedules
+type ScheduleMapper struct{}
+
+// Map converts a models.Schedules to an api.Schedules
+func (m ScheduleMapper) Map(schedules models.Schedules) api.Schedules {
+	return api.Schedules{
+		Timezone:           schedules.Timezone,
+		Jobs:               models.ListMapper(m.mapJob)(schedules.Jobs),
+		MaintenanceWindows: models.ListMapper(m.mapWindow)(schedules.MaintenanceWindows),
+	}
+}
+
+func (ScheduleMapper) mapJob(schedule models.Schedule) api.Schedule {
+	res := api.Schedule{
+		Name:          api.ScheduleName(schedule.Name),
+		Cron:          schedule.Cron,
+		Disruptive:    schedule.Disruptive,
+		Next:          optionalTime(schedule.Next),
+		DeferredUntil: optionalTime(schedule.DeferredUntil),
+	}
+	if schedule.Jitter != "" {
+		res.Jitter = &schedule.Jitter
+	}
+	return res
+}
+
+func (ScheduleMapper) mapWindow(window models.MaintenanceWindowState) api.MaintenanceWindow {
+	return api.MaintenanceWindow{
+		Cron:     window.Cron,
+		Duration: window.Duration,
+		Active:   window.Active,
+		Start:    window.Start,
+		End:      window.End,
+	}
+}
+
+func optionalTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
@@ -0,0 +1,46 @@
+package mappers
+
+import (
+	"testing"
+	"time"
+
+	"omar-kada/autonas/api"
+	"omar-kada/autonas/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScheduleMapper_Map(t *testing.T) {
+	next := time.Now().Truncate(time.Second)
+	jitter := "5m"
+
+	got := ScheduleMapper{}.Map(models.Schedules{
+		Timezone: "UTC",
+		Jobs: []models.Schedule{
+			{Name: models.ScheduleSync, Cron: "@daily", Jitter: jitter, Disruptive: true, Next: next},
+			{Name: models.SchedulePrune},
+		},
+		MaintenanceWindows: []models.MaintenanceWindowState{
+			{Cron: "0 8 * * *", Duration: "2h", Active: true, Start: next, End: next.Add(2 * time.Hour)},
+		},
+	})
+
+	assert.Equal(t, api.Schedules{
+		Timezone: "UTC",
+		Jobs: []api.Schedule{
+			{Name: api.ScheduleNameSync, Cron: "@daily", Jitter: &jitter, Disruptive: true, Next: &next},
+			{Name: api.ScheduleNamePrune},
+		},
+		MaintenanceWindows: []api.MaintenanceWindow{
+			{Cron: "0 8 * * *", Duration: "2h", Active: true, Start: next, End: next.Add(2 * time.Hour)},
+		},
+	}, got)
+}
+
+func TestScheduleMapper_Map_Empty(t *testing.T) {
+	got := ScheduleMapper{}.Map(models.Schedules{Timezone: "Local"})
+
+	assert.NotNil(t, got.Jobs)
+	assert.NotNil(t, got.MaintenanceWindows)
+	assert.Empty(t, got.Jobs)
+}
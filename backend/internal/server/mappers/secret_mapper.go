@@ -0,0 +1,17 @@
+package mappers
+
+import (
+	"omar-kada/autonas/api"
+	"omar-kada/autonas/models"
+)
+
+// SecretMapper maps models.Secret to api.Secret, the value is never mapped
+type SecretMapper struct{}
+
+// Map converts a models.Secret to an api.Secret
+func (SecretMapper) Map(secret models.Secret) api.Secret {
+	return api.Secret{
+		Name:      secret.Name,
+		UpdatedAt: secret.UpdatedAt,
+	}
+}
@@ -0,0 +1,20 @@
+package mappers
+
+import (
+	"testing"
+	"time"
+
+	"omar-kada/autonas/api"
+	"omar-kada/autonas/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSecretMapper_Map(t *testing.T) {
+	now := time.Now()
+	in := models.Secret{Name: "db_password", Ciphertext: []byte("encrypted"), UpdatedAt: now}
+
+	got := SecretMapper{}.Map(in)
+
+	assert.Equal(t, api.Secret{Name: "db_password", UpdatedAt: now}, got)
+}
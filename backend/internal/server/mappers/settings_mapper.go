@@ -20,9 +20,18 @@ func (SettingsMapper) Map(settings models.Settings) api.Settings {
 		Username:          &settings.Username,
 		NotificationURL:   &notificationURL,
 		NotificationTypes: mapEventTypes(settings.NotificationTypes),
+		WriteBack:         mapWriteBack(settings.WriteBack),
 	}
 }
 
+func mapWriteBack(mode models.WriteBackMode) *api.WriteBackMode {
+	if mode == "" {
+		return nil
+	}
+	writeBack := api.WriteBackMode(mode)
+	return &writeBack
+}
+
 func mapEventTypes(types []models.EventType) []api.EventType {
 	if types == nil {
 		return nil
@@ -55,6 +64,9 @@ func (SettingsMapper) UnMap(settings api.Settings) models.Settings {
 	if settings.NotificationURL != nil {
 		res.NotificationURL = *settings.NotificationURL
 	}
+	if settings.WriteBack != nil {
+		res.WriteBack = models.WriteBackMode(*settings.WriteBack)
+	}
 	return res
 }
 
@@ -18,6 +18,7 @@ func TestSettingsMapper_Map(t *testing.T) {
 	obfuscatedToken := models.Obfuscate(token)
 	obfuscatedURL := models.Obfuscate(notificationURL)
 	empty := ""
+	writeBack := api.WriteBackModeBranch
 	cases := []struct {
 		name string
 		in   models.Settings
@@ -33,6 +34,7 @@ func TestSettingsMapper_Map(t *testing.T) {
 				Token:             token,
 				NotificationURL:   notificationURL,
 				NotificationTypes: []models.EventType{},
+				WriteBack:         models.WriteBackBranch,
 			},
 			want: api.Settings{
 				Repo:              "https://github.com/example/repo",
@@ -42,6 +44,7 @@ func TestSettingsMapper_Map(t *testing.T) {
 				Username:          &username,
 				NotificationURL:   &obfuscatedURL,
 				NotificationTypes: []api.EventType{},
+				WriteBack:         &writeBack,
 			},
 		},
 		{
@@ -78,6 +81,7 @@ func TestSettingsMapper_UnMap(t *testing.T) {
 	username := "user"
 	token := "123456789123456789"
 	notificationURL := "gotify://123456789"
+	writeBack := api.WriteBackModeCommit
 
 	cases := []struct {
 		name string
@@ -94,6 +98,7 @@ func TestSettingsMapper_UnMap(t *testing.T) {
 				Token:             &token,
 				NotificationURL:   &notificationURL,
 				NotificationTypes: []api.EventType{},
+				WriteBack:         &writeBack,
 			},
 			want: models.Settings{
 				Repo:              repo,
@@ -103,6 +108,7 @@ func TestSettingsMapper_UnMap(t *testing.T) {
 				Token:             token,
 				NotificationURL:   notificationURL,
 				NotificationTypes: []models.EventType{},
+				WriteBack:         models.WriteBackCommit,
 			},
 		},
 		{
@@ -3,20 +3,44 @@ package mappers
 import (
 	"omar-kada/autonas/api"
 	"omar-kada/autonas/models"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
 // StatsMapper maps models.Stats to api.Stats
 type StatsMapper struct{}
 
 // Map converts a models.Stats to an api.Stats
-func (StatsMapper) Map(stats models.Stats) api.Stats {
+func (m StatsMapper) Map(stats models.Stats) api.Stats {
 	return api.Stats{
-		Author:     stats.Author,
-		Error:      stats.Error,
-		Success:    stats.Success,
-		LastDeploy: stats.LastDeploy,
-		NextDeploy: stats.NextDeploy,
-		Status:     api.DeploymentStatus(stats.LastStatus),
-		Health:     api.ContainerHealth(stats.Health),
+		Author:             stats.Author,
+		Error:              stats.Error,
+		Success:            stats.Success,
+		LastDeploy:         stats.LastDeploy,
+		NextDeploy:         stats.NextDeploy,
+		Status:             api.DeploymentStatus(stats.LastStatus),
+		Health:             api.ContainerHealth(stats.Health),
+		MeanDuration:       stats.MeanDuration.Seconds(),
+		P95Duration:        stats.P95Duration.Seconds(),
+		MeanTimeToRecovery: stats.MeanTimeToRecovery.Seconds(),
+		Services:           models.ListMapper(m.mapService)(stats.Services),
+	}
+}
+
+// MapDaily converts a models.DailyStats to an api.DailyStats
+func (StatsMapper) MapDaily(stats models.DailyStats) api.DailyStats {
+	return api.DailyStats{
+		Day:     openapi_types.Date{Time: stats.Day},
+		Success: stats.Success,
+		Error:   stats.Error,
+	}
+}
+
+func (StatsMapper) mapService(stats models.ServiceStats) api.ServiceStats {
+	return api.ServiceStats{
+		Service:     stats.Service,
+		Deployments: stats.Deployments,
+		Errors:      stats.Errors,
+		FailureRate: stats.FailureRate(),
 	}
 }
@@ -22,13 +22,12 @@ func TestStatsMapper_Map(t *testing.T) {
 		{
 			name: "basic",
 			in: models.Stats{
-				Author:     "alice",
-				Error:      1,
-				Success:    2,
-				LastDeploy: now,
-				NextDeploy: next,
-				LastStatus: models.DeploymentStatusRunning,
-				Health:     models.StackStatusHealthy,
+				Author:          "alice",
+				DeploymentStats: models.DeploymentStats{Error: 1, Success: 2},
+				LastDeploy:      now,
+				NextDeploy:      next,
+				LastStatus:      models.DeploymentStatusRunning,
+				Health:          models.StackStatusHealthy,
 			},
 			want: api.Stats{
 				Author:     "alice",
@@ -38,18 +37,18 @@ func TestStatsMapper_Map(t *testing.T) {
 				NextDeploy: next,
 				Status:     api.DeploymentStatus(models.DeploymentStatusRunning),
 				Health:     api.ContainerHealthHealthy,
+				Services:   []api.ServiceStats{},
 			},
 		},
 		{
 			name: "zero-times-empty-health",
 			in: models.Stats{
-				Author:     "bob",
-				Error:      0,
-				Success:    0,
-				LastDeploy: time.Time{},
-				NextDeploy: time.Time{},
-				LastStatus: models.DeploymentStatusPlanned,
-				Health:     models.StackStatusUnknown,
+				Author:          "bob",
+				DeploymentStats: models.DeploymentStats{Error: 0, Success: 0},
+				LastDeploy:      time.Time{},
+				NextDeploy:      time.Time{},
+				LastStatus:      models.DeploymentStatusPlanned,
+				Health:          models.StackStatusUnknown,
 			},
 			want: api.Stats{
 				Author:     "bob",
@@ -59,18 +58,18 @@ func TestStatsMapper_Map(t *testing.T) {
 				NextDeploy: time.Time{},
 				Status:     api.DeploymentStatus(models.DeploymentStatusPlanned),
 				Health:     api.ContainerHealthUnknown,
+				Services:   []api.ServiceStats{},
 			},
 		},
 		{
 			name: "zero-times-empty-health",
 			in: models.Stats{
-				Author:     "foo",
-				Error:      0,
-				Success:    0,
-				LastDeploy: time.Time{},
-				NextDeploy: time.Time{},
-				LastStatus: models.DeploymentStatusPlanned,
-				Health:     models.StackStatusStarting,
+				Author:          "foo",
+				DeploymentStats: models.DeploymentStats{Error: 0, Success: 0},
+				LastDeploy:      time.Time{},
+				NextDeploy:      time.Time{},
+				LastStatus:      models.DeploymentStatusPlanned,
+				Health:          models.StackStatusStarting,
 			},
 			want: api.Stats{
 				Author:     "foo",
@@ -80,18 +79,18 @@ func TestStatsMapper_Map(t *testing.T) {
 				NextDeploy: time.Time{},
 				Status:     api.DeploymentStatus(models.DeploymentStatusPlanned),
 				Health:     api.ContainerHealthStarting,
+				Services:   []api.ServiceStats{},
 			},
 		},
 		{
 			name: "zero-times-empty-health",
 			in: models.Stats{
-				Author:     "bob",
-				Error:      0,
-				Success:    0,
-				LastDeploy: time.Time{},
-				NextDeploy: time.Time{},
-				LastStatus: models.DeploymentStatusPlanned,
-				Health:     models.StackStatusUnhealthy,
+				Author:          "bob",
+				DeploymentStats: models.DeploymentStats{Error: 0, Success: 0},
+				LastDeploy:      time.Time{},
+				NextDeploy:      time.Time{},
+				LastStatus:      models.DeploymentStatusPlanned,
+				Health:          models.StackStatusUnhealthy,
 			},
 			want: api.Stats{
 				Author:     "bob",
@@ -101,6 +100,7 @@ func TestStatsMapper_Map(t *testing.T) {
 				NextDeploy: time.Time{},
 				Status:     api.DeploymentStatus(models.DeploymentStatusPlanned),
 				Health:     api.ContainerHealthUnhealthy,
+				Services:   []api.ServiceStats{},
 			},
 		},
 	}
@@ -113,3 +113,27 @@ func TestStatsMapper_Map(t *testing.T) {
 		})
 	}
 }
+
+func TestStatsMapper_MapDurationsAndServices(t *testing.T) {
+	got := StatsMapper{}.Map(models.Stats{DeploymentStats: models.DeploymentStats{
+		MeanDuration:       1500 * time.Millisecond,
+		P95Duration:        3 * time.Second,
+		MeanTimeToRecovery: time.Minute,
+		Services:           []models.ServiceStats{{Service: "web", Deployments: 4, Errors: 1}},
+	}})
+
+	assert.Equal(t, 1.5, got.MeanDuration)
+	assert.Equal(t, 3.0, got.P95Duration)
+	assert.Equal(t, 60.0, got.MeanTimeToRecovery)
+	assert.Equal(t, []api.ServiceStats{{Service: "web", Deployments: 4, Errors: 1, FailureRate: 0.25}}, got.Services)
+}
+
+func TestStatsMapper_MapDaily(t *testing.T) {
+	day := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+
+	got := StatsMapper{}.MapDaily(models.DailyStats{Day: day, Success: 3, Error: 1})
+
+	assert.Equal(t, "2025-03-10", got.Day.String())
+	assert.Equal(t, int32(3), got.Success)
+	assert.Equal(t, int32(1), got.Error)
+}
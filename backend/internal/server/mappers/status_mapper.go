@@ -10,11 +10,33 @@ type StatusMapper struct{}
 
 // Map converts a models.ContainerSummary to an api.ContainerStatus
 func (StatusMapper) Map(container models.ContainerSummary) api.ContainerStatus {
-	return api.ContainerStatus{
+	status := api.ContainerStatus{
 		ContainerId: container.ID,
 		State:       api.ContainerStatusState(container.State),
 		Name:        container.Name,
 		Health:      api.ContainerHealth(container.Health),
 		StartedAt:   container.StartedAt,
 	}
+	if container.Usage != nil {
+		usage := ResourceUsageMapper{}.Map(*container.Usage)
+		status.Usage = &usage
+	}
+	return status
+}
+
+// ResourceUsageMapper maps models.ResourceUsage to api.ResourceUsage
+type ResourceUsageMapper struct{}
+
+// Map converts a models.ResourceUsage to an api.ResourceUsage
+func (ResourceUsageMapper) Map(usage models.ResourceUsage) api.ResourceUsage {
+	return api.ResourceUsage{
+		Time:        usage.Time,
+		CpuPercent:  usage.CPUPercent,
+		MemoryUsage: usage.MemoryUsage,
+		MemoryLimit: usage.MemoryLimit,
+		NetworkRx:   usage.NetworkRx,
+		NetworkTx:   usage.NetworkTx,
+		BlockRead:   usage.BlockRead,
+		BlockWrite:  usage.BlockWrite,
+	}
 }
@@ -35,6 +35,25 @@ func TestStatusMapper_Map(t *testing.T) {
 				StartedAt:   now,
 			},
 		},
+		{
+			name: "running-with-usage",
+			in: models.ContainerSummary{
+				ID:        "cid3",
+				Name:      "c3",
+				State:     container.ContainerState("running"),
+				Health:    container.HealthStatus("healthy"),
+				StartedAt: now,
+				Usage:     &models.ResourceUsage{Time: now, CPUPercent: 12.5, MemoryUsage: 100, MemoryLimit: 1000, NetworkRx: 1, NetworkTx: 2, BlockRead: 3, BlockWrite: 4},
+			},
+			want: api.ContainerStatus{
+				ContainerId: "cid3",
+				Name:        "c3",
+				State:       api.ContainerStatusState("running"),
+				Health:      api.ContainerHealth("healthy"),
+				StartedAt:   now,
+				Usage:       &api.ResourceUsage{Time: now, CpuPercent: 12.5, MemoryUsage: 100, MemoryLimit: 1000, NetworkRx: 1, NetworkTx: 2, BlockRead: 3, BlockWrite: 4},
+			},
+		},
 		{
 			name: "exited-none",
 			in: models.ContainerSummary{
@@ -0,0 +1,22 @@
+package mappers
+
+import (
+	"omar-kada/autonas/api"
+	"omar-kada/autonas/models"
+)
+
+// ValidationMapper maps models.ValidationError to api.Error
+type ValidationMapper struct{}
+
+// Map converts a models.ValidationError to an api.Error listing the invalid fields
+func (ValidationMapper) Map(err *models.ValidationError) api.Error {
+	fields := make([]api.FieldError, len(err.Fields))
+	for i, field := range err.Fields {
+		fields[i] = api.FieldError{Field: field.Field, Message: field.Message}
+	}
+	return api.Error{
+		Code:    api.ErrorCodeINVALIDREQUEST,
+		Message: err.Error(),
+		Fields:  &fields,
+	}
+}
@@ -0,0 +1,22 @@
+package mappers
+
+import (
+	"testing"
+
+	"omar-kada/autonas/api"
+	"omar-kada/autonas/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidationMapper_Map(t *testing.T) {
+	in := &models.ValidationError{Fields: []models.FieldError{
+		{Field: "settings.cron", Message: "invalid cron expression"},
+	}}
+
+	got := ValidationMapper{}.Map(in)
+
+	assert.Equal(t, api.ErrorCodeINVALIDREQUEST, got.Code)
+	assert.Equal(t, "invalid configuration : settings.cron : invalid cron expression", got.Message)
+	assert.Equal(t, &[]api.FieldError{{Field: "settings.cron", Message: "invalid cron expression"}}, got.Fields)
+}
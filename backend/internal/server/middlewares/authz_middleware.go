@@ -31,8 +31,17 @@ func isDisabled(method, url string, features models.Features) bool {
 	switch url {
 	case "config":
 		return method == http.MethodPost && !features.EditConfig || method == http.MethodGet && !features.DisplayConfig
+	case "config/requirements":
+		return !features.DisplayConfig
 	case "settings":
 		return method == http.MethodPost && !features.EditSettings
 	}
+	if strings.HasPrefix(url, "admin/") {
+		// the backup archive contains the config file
+		return !features.DisplayConfig
+	}
+	if url == "secrets" || strings.HasPrefix(url, "secrets/") || strings.HasPrefix(url, "config/history") {
+		return method == http.MethodGet && !features.DisplayConfig || method != http.MethodGet && !features.EditConfig
+	}
 	return false
 }
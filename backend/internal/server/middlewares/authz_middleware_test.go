@@ -52,6 +52,60 @@ func TestAuthorizationMiddleware(t *testing.T) {
 			editSettings:   "true",
 			expectedStatus: http.StatusMethodNotAllowed,
 		},
+		{
+			name:           "GET config requirements with display config disabled",
+			method:         "GET",
+			url:            "/api/config/requirements",
+			displayConfig:  "false",
+			editConfig:     "true",
+			editSettings:   "true",
+			expectedStatus: http.StatusMethodNotAllowed,
+		},
+		{
+			name:           "DELETE secret with edit config disabled",
+			method:         "DELETE",
+			url:            "/api/secrets/db_password",
+			displayConfig:  "true",
+			editConfig:     "false",
+			editSettings:   "true",
+			expectedStatus: http.StatusMethodNotAllowed,
+		},
+		{
+			name:           "GET secrets with edit config disabled",
+			method:         "GET",
+			url:            "/api/secrets",
+			displayConfig:  "true",
+			editConfig:     "false",
+			editSettings:   "true",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "GET backup with display config disabled",
+			method:         "GET",
+			url:            "/api/admin/backup",
+			displayConfig:  "false",
+			editConfig:     "true",
+			editSettings:   "true",
+			expectedStatus: http.StatusMethodNotAllowed,
+		},
+		{
+			name:           "GET config history with display config disabled",
+			method:         "GET",
+			url:            "/api/config/history",
+			displayConfig:  "false",
+			editConfig:     "true",
+			editSettings:   "true",
+			expectedStatus: http.StatusMethodNotAllowed,
+		},
+		{
+			name:           "POST config revision restore with edit config disabled",
+			method:         "POST",
+			url:            "/api/config/history/3/restore",
+			displayConfig:  "true",
+			editConfig:     "false",
+			editSettings:   "true",
+			expectedStatus: http.StatusMethodNotAllowed,
+		},
 		{
 			name:           "POST settings with edit settings enabled",
 			method:         "POST",
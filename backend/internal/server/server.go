@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"omar-kada/autonas/api"
+	"omar-kada/autonas/internal/events"
 	"omar-kada/autonas/internal/process"
 	"omar-kada/autonas/internal/server/middlewares"
 	"omar-kada/autonas/internal/storage"
@@ -29,16 +30,32 @@ type HTTPServer struct {
 	processSvc       process.Service
 	userSvc          users.Service
 	websocketHandler *WebsocketHandler
+	sseHandler       *SSEHandler
+	logsHandler      *ContainerLogsHandler
+	metricsHandler   http.Handler
+	backupStore      storage.BackupStorage
+	usageSource      UsageSource
 	server           *http.Server
 }
 
-// NewServer creates a new http server
-func NewServer(configStore storage.ConfigStore, service process.Service, userService users.Service) Server {
+// NewServer creates a new http server, metricsHandler serves /metrics, backupStore creates
+// the archives of /api/admin/backup and the events of eventBus are streamed on /api/ws, and
+// with the stored events of eventStore on /api/events/stream. usageSource adds the resources
+// used by the containers to /api/status
+func NewServer(configStore storage.ConfigStore, service process.Service, userService users.Service,
+	metricsHandler http.Handler, backupStore storage.BackupStorage, eventBus *events.Bus,
+	eventStore storage.EventStorage, usageSource UsageSource,
+) Server {
 	return &HTTPServer{
 		configStore:      configStore,
 		processSvc:       service,
 		userSvc:          userService,
-		websocketHandler: newWebsocketHandler(),
+		websocketHandler: newWebsocketHandler(eventBus),
+		sseHandler:       newSSEHandler(eventBus, eventStore),
+		logsHandler:      newContainerLogsHandler(service),
+		metricsHandler:   metricsHandler,
+		backupStore:      backupStore,
+		usageSource:      usageSource,
 	}
 }
 
@@ -48,11 +65,16 @@ func (s *HTTPServer) Serve(port int) error {
 	mux := http.NewServeMux()
 
 	// Add frontend file server
-	mux.HandleFunc("/ws", s.websocketHandler.handle)
+	mux.HandleFunc("GET /api/ws", s.websocketHandler.handle)
+	mux.HandleFunc("GET /api/events/stream", s.sseHandler.handle)
+	mux.HandleFunc("GET /api/stacks/{stack}/containers/{id}/logs", s.logsHandler.handle)
+	mux.Handle("GET /metrics", s.metricsHandler)
 	mux.HandleFunc("/", spaHandler)
 
 	// create a type that satisfies the `api.ServerInterface`, which contains an implementation of every operation from the generated code
 	myHandler := NewHandler(s.configStore, s.processSvc, s.userSvc)
+	myHandler.SetBackupStorage(s.backupStore)
+	myHandler.SetUsageSource(s.usageSource)
 	strict := api.NewStrictHandler(myHandler, []api.StrictMiddlewareFunc{})
 
 	// get an `http.Handler` that we can use
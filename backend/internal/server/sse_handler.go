@@ -0,0 +1,134 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"omar-kada/autonas/internal/events"
+	"omar-kada/autonas/internal/server/mappers"
+	"omar-kada/autonas/internal/storage"
+	"omar-kada/autonas/models"
+)
+
+const (
+	// delay before the browsers reconnect
+	sseRetry = 5 * time.Second
+	// comments are sent when there are no events, to keep the proxies from closing the stream
+	sseHeartbeatPeriod = 30 * time.Second
+	// events read from the storage at once
+	sseBatchSize = 100
+)
+
+// sseEventTypes are the events streamed besides the notifications
+var sseEventTypes = []models.EventType{
+	models.EventDeploymentStarted,
+	models.EventDeploymentSuccess,
+	models.EventDeploymentError,
+	models.EventStackStatusChanged,
+	models.EventStackUnhealthy,
+	models.EventStackRecovered,
+}
+
+// SSEHandler streams the deployments, stacks and notifications events as Server-Sent Events.
+// The events are read from the storage, the bus only signals that new events were stored,
+// so the clients resume from the last received event with the Last-Event-ID header
+type SSEHandler struct {
+	bus         *events.Bus
+	eventStore  storage.EventStorage
+	eventMapper mappers.EventMapper
+}
+
+func newSSEHandler(bus *events.Bus, eventStore storage.EventStorage) *SSEHandler {
+	return &SSEHandler{
+		bus:        bus,
+		eventStore: eventStore,
+	}
+}
+
+// handle streams the events stored after Last-Event-ID, or the new events when it's missing.
+// The request is authenticated by the middlewares of /api
+func (h *SSEHandler) handle(w http.ResponseWriter, r *http.Request) {
+	lastID, err := h.startID(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// subscribe before reading the storage, so the events stored in between aren't missed
+	sub := h.bus.Subscribe(wsEventsBuffer, events.TopicDeployments, events.TopicStacks, events.TopicNotifications)
+	defer h.bus.Unsubscribe(sub)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	rc := http.NewResponseController(w)
+
+	fmt.Fprintf(w, "retry: %d\n\n", sseRetry.Milliseconds())
+	if lastID, err = h.writeEventsAfter(w, lastID); err != nil || rc.Flush() != nil {
+		return
+	}
+
+	ticker := time.NewTicker(sseHeartbeatPeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case _, ok := <-sub.Events():
+			if !ok {
+				// the client doesn't keep up, it resumes from the last event when reconnecting
+				return
+			}
+			if lastID, err = h.writeEventsAfter(w, lastID); err != nil {
+				return
+			}
+		case <-ticker.C:
+			if _, err := fmt.Fprint(w, ": ping\n\n"); err != nil {
+				return
+			}
+		case <-r.Context().Done():
+			return
+		}
+		if err := rc.Flush(); err != nil {
+			return
+		}
+	}
+}
+
+func (h *SSEHandler) startID(r *http.Request) (uint64, error) {
+	if header := r.Header.Get("Last-Event-ID"); header != "" {
+		id, err := strconv.ParseUint(header, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid Last-Event-ID %q", header)
+		}
+		return id, nil
+	}
+	return h.eventStore.LastEventID()
+}
+
+// writeEventsAfter writes the stored events after lastID and returns the ID of the last written one
+func (h *SSEHandler) writeEventsAfter(w http.ResponseWriter, lastID uint64) (uint64, error) {
+	for {
+		stored, err := h.eventStore.GetEventsAfter(lastID, sseEventTypes, sseBatchSize)
+		if err != nil {
+			slog.Error("couldn't read events to stream", "error", err)
+			return lastID, err
+		}
+		for _, event := range stored {
+			data, err := json.Marshal(h.eventMapper.Map(event))
+			if err != nil {
+				return lastID, err
+			}
+			if _, err := fmt.Fprintf(w, "id: %d\ndata: %s\n\n", event.ID, data); err != nil {
+				return lastID, err
+			}
+			lastID = event.ID
+		}
+		if len(stored) < sseBatchSize {
+			return lastID, nil
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package server
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"omar-kada/autonas/api"
+	"omar-kada/autonas/internal/events"
+	"omar-kada/autonas/internal/server/middlewares"
+	"omar-kada/autonas/internal/storage"
+	"omar-kada/autonas/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeEventStore struct {
+	storage.EventStorage
+	mu     sync.Mutex
+	events []models.Event
+}
+
+func (s *fakeEventStore) add(event models.Event) models.Event {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	event.ID = uint64(len(s.events) + 1)
+	s.events = append(s.events, event)
+	return event
+}
+
+func (s *fakeEventStore) GetEventsAfter(afterID uint64, _ []models.EventType, limit int) ([]models.Event, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var res []models.Event
+	for _, event := range s.events {
+		if event.ID > afterID && len(res) < limit {
+			res = append(res, event)
+		}
+	}
+	return res, nil
+}
+
+func (s *fakeEventStore) LastEventID() (uint64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return uint64(len(s.events)), nil
+}
+
+func newSSETestServer(t *testing.T) (*events.Bus, *fakeEventStore, string) {
+	bus := events.NewBus()
+	store := &fakeEventStore{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/events/stream", newSSEHandler(bus, store).handle)
+	srv := httptest.NewServer(middlewares.LoggingMiddleware(middlewares.AuthnMiddleware(mux, stubAuth{})))
+	t.Cleanup(srv.Close)
+	return bus, store, srv.URL + "/api/events/stream"
+}
+
+// openStream returns the id and data of the received events
+func openStream(t *testing.T, url string, lastEventID string) (*http.Response, <-chan [2]string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	req.Header.Set("Cookie", "token=valid")
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("request: %v", err)
+	}
+	t.Cleanup(func() { resp.Body.Close() })
+
+	received := make(chan [2]string, 10)
+	go func() {
+		defer close(received)
+		scanner := bufio.NewScanner(resp.Body)
+		var id string
+		for scanner.Scan() {
+			line := scanner.Text()
+			if after, ok := strings.CutPrefix(line, "id: "); ok {
+				id = after
+			}
+			if after, ok := strings.CutPrefix(line, "data: "); ok {
+				received <- [2]string{id, after}
+			}
+		}
+	}()
+	return resp, received
+}
+
+func nextEvent(t *testing.T, received <-chan [2]string) (string, api.Event) {
+	msg, ok := <-received
+	if !ok {
+		t.Fatal("stream closed")
+	}
+	var event api.Event
+	assert.NoError(t, json.Unmarshal([]byte(msg[1]), &event))
+	return msg[0], event
+}
+
+func TestSSE_StreamsNewEvents(t *testing.T) {
+	bus, store, url := newSSETestServer(t)
+	store.add(models.Event{Type: models.EventDeploymentSuccess, Msg: "before"})
+
+	resp, received := openStream(t, url, "")
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	event := store.add(models.Event{Type: models.EventDeploymentStarted, Msg: "started", ObjectID: 3})
+	bus.HandleEvent(context.Background(), event)
+
+	id, streamed := nextEvent(t, received)
+	assert.Equal(t, "2", id)
+	assert.Equal(t, "started", streamed.Msg)
+	assert.Equal(t, api.EventTypeDEPLOYMENTSTARTED, streamed.Type)
+}
+
+func TestSSE_ResumesFromLastEventID(t *testing.T) {
+	_, store, url := newSSETestServer(t)
+	for _, msg := range []string{"first", "second", "third"} {
+		store.add(models.Event{Type: models.EventDeploymentSuccess, Msg: msg})
+	}
+
+	_, received := openStream(t, url, "1")
+	id, event := nextEvent(t, received)
+	assert.Equal(t, "2", id)
+	assert.Equal(t, "second", event.Msg)
+	id, event = nextEvent(t, received)
+	assert.Equal(t, "3", id)
+	assert.Equal(t, "third", event.Msg)
+}
+
+func TestSSE_Rejections(t *testing.T) {
+	_, _, url := newSSETestServer(t)
+
+	resp, err := http.Get(url)
+	assert.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	resp, _ = openStream(t, url, "abc")
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
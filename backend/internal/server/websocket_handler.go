@@ -1,53 +1,202 @@
 package server
 
 import (
+	"encoding/json"
+	"fmt"
 	"log/slog"
 	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"omar-kada/autonas/api"
+	"omar-kada/autonas/internal/events"
+	"omar-kada/autonas/internal/server/mappers"
 
 	"github.com/gorilla/websocket"
 )
 
-// WebsocketHandler processes websocker requests
-type WebsocketHandler struct{}
+const (
+	// time allowed to write a message
+	wsWriteWait = 10 * time.Second
+	// time allowed between two pongs, pings are sent before it expires
+	wsPongWait   = 60 * time.Second
+	wsPingPeriod = wsPongWait * 9 / 10
+	// size of the messages sent by the clients
+	wsMaxMessageSize = 4096
+	// events waiting to be written before the client is disconnected
+	wsEventsBuffer = 256
+)
 
-func newWebsocketHandler() *WebsocketHandler {
-	return &WebsocketHandler{}
+// wsClientMessage changes the topics of the connection
+type wsClientMessage struct {
+	Action string   `json:"action"` // subscribe or unsubscribe
+	Topics []string `json:"topics"`
 }
 
-func (*WebsocketHandler) handle(w http.ResponseWriter, r *http.Request) {
-	slog.Debug("WebSocket connection attempt from ", "addr", r.RemoteAddr, "request", r)
-	if r.Method != "GET" {
-		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-		return
-	}
+// wsServerMessage is an event of the subscribed topics, or the reply to a client message
+type wsServerMessage struct {
+	Topics []string   `json:"topics,omitempty"`
+	Event  *api.Event `json:"event,omitempty"`
+	Error  string     `json:"error,omitempty"`
+}
 
-	upgrader := websocket.Upgrader{
-		ReadBufferSize:  1024,
-		WriteBufferSize: 1024,
-		CheckOrigin: func(_ *http.Request) bool {
-			return true
+// WebsocketHandler streams the events of the bus to the clients subscribed to their topics
+type WebsocketHandler struct {
+	bus         *events.Bus
+	eventMapper mappers.EventMapper
+	upgrader    websocket.Upgrader
+}
+
+func newWebsocketHandler(bus *events.Bus) *WebsocketHandler {
+	return &WebsocketHandler{
+		bus: bus,
+		upgrader: websocket.Upgrader{
+			ReadBufferSize:  1024,
+			WriteBufferSize: 1024,
+			CheckOrigin:     checkOrigin,
 		},
 	}
-	conn, err := upgrader.Upgrade(w, r, nil)
+}
+
+// checkOrigin accepts the clients without origin (not a browser) and the pages of the same host
+func checkOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+	u, err := url.Parse(origin)
+	return err == nil && strings.EqualFold(u.Host, r.Host)
+}
+
+// handle upgrades the request, the initial topics are the comma separated topics parameter.
+// The request is authenticated by the middlewares of /api
+func (h *WebsocketHandler) handle(w http.ResponseWriter, r *http.Request) {
+	var topics []string
+	if param := r.URL.Query().Get("topics"); param != "" {
+		topics = strings.Split(param, ",")
+	}
+	for _, topic := range topics {
+		if err := events.ValidateTopic(topic); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	conn, err := h.upgrader.Upgrade(w, r, nil)
 	if err != nil {
-		slog.Error("Upgrade error", "error", err)
-		http.Error(w, "error upgrading "+err.Error(), http.StatusInsufficientStorage)
+		// the upgrader already replied
+		slog.Warn("websocket upgrade error", "error", err, "addr", r.RemoteAddr)
 		return
 	}
 	defer conn.Close()
 
+	sub := h.bus.Subscribe(wsEventsBuffer, topics...)
+	defer h.bus.Unsubscribe(sub)
+
+	replies := make(chan wsServerMessage, 8)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		h.read(conn, sub, replies)
+	}()
+	h.write(conn, sub, replies, done)
+}
+
+// read applies the messages of the client until the connection fails
+func (*WebsocketHandler) read(conn *websocket.Conn, sub *events.Subscription, replies chan<- wsServerMessage) {
+	conn.SetReadLimit(wsMaxMessageSize)
+	conn.SetReadDeadline(time.Now().Add(wsPongWait))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(wsPongWait))
+	})
 	for {
-		messageType, p, err := conn.ReadMessage()
+		_, data, err := conn.ReadMessage()
 		if err != nil {
-			slog.Error("Read error", "error", err)
-			break
+			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseNormalClosure) {
+				slog.Debug("websocket read error", "error", err)
+			}
+			return
+		}
+		var msg wsClientMessage
+		if err := json.Unmarshal(data, &msg); err != nil {
+			reply(replies, wsServerMessage{Error: "invalid message : " + err.Error()})
+			continue
 		}
+		if err := applyClientMessage(sub, msg); err != nil {
+			reply(replies, wsServerMessage{Error: err.Error()})
+			continue
+		}
+		reply(replies, wsServerMessage{Topics: sub.Topics()})
+	}
+}
 
-		slog.Info("Received ", "payload", p)
+func applyClientMessage(sub *events.Subscription, msg wsClientMessage) error {
+	for _, topic := range msg.Topics {
+		if err := events.ValidateTopic(topic); err != nil {
+			return err
+		}
+	}
+	switch msg.Action {
+	case "subscribe":
+		sub.Subscribe(msg.Topics...)
+	case "unsubscribe":
+		sub.Unsubscribe(msg.Topics...)
+	default:
+		return fmt.Errorf("unknown action %q, use subscribe or unsubscribe", msg.Action)
+	}
+	return nil
+}
+
+// reply doesn't block the reader when the writer is late, the replies are dropped
+func reply(replies chan<- wsServerMessage, msg wsServerMessage) {
+	select {
+	case replies <- msg:
+	default:
+	}
+}
 
-		if err := conn.WriteMessage(messageType, p); err != nil {
-			slog.Error("Write error", "error", err)
-			break
+// write sends the events, the replies and the pings until the connection fails or the
+// subscription is dropped
+func (h *WebsocketHandler) write(conn *websocket.Conn, sub *events.Subscription,
+	replies <-chan wsServerMessage, done <-chan struct{},
+) {
+	ticker := time.NewTicker(wsPingPeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case busEvent, ok := <-sub.Events():
+			if !ok {
+				// the client doesn't keep up with the events
+				conn.WriteControl(websocket.CloseMessage,
+					websocket.FormatCloseMessage(websocket.CloseTryAgainLater, "too many pending events"),
+					time.Now().Add(wsWriteWait))
+				return
+			}
+			event := h.eventMapper.Map(busEvent.Event)
+			if !writeJSON(conn, wsServerMessage{Topics: busEvent.Topics, Event: &event}) {
+				return
+			}
+		case msg := <-replies:
+			if !writeJSON(conn, msg) {
+				return
+			}
+		case <-ticker.C:
+			conn.SetWriteDeadline(time.Now().Add(wsWriteWait))
+			if err := conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
+		case <-done:
+			return
 		}
 	}
 }
+
+func writeJSON(conn *websocket.Conn, msg wsServerMessage) bool {
+	conn.SetWriteDeadline(time.Now().Add(wsWriteWait))
+	if err := conn.WriteJSON(msg); err != nil {
+		slog.Debug("websocket write error", "error", err)
+		return false
+	}
+	return true
+}
@@ -0,0 +1,112 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"omar-kada/autonas/api"
+	"omar-kada/autonas/internal/events"
+	"omar-kada/autonas/internal/server/middlewares"
+	"omar-kada/autonas/internal/users"
+	"omar-kada/autonas/models"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+)
+
+type stubAuth struct {
+	users.AuthService
+}
+
+func (stubAuth) GetUsernameByToken(token models.Token) (string, error) {
+	if token.Value != "valid" {
+		return "", errors.New("invalid token")
+	}
+	return "admin", nil
+}
+
+func newWebsocketTestServer(t *testing.T) (*events.Bus, string) {
+	bus := events.NewBus()
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/ws", newWebsocketHandler(bus).handle)
+	srv := httptest.NewServer(middlewares.LoggingMiddleware(middlewares.AuthnMiddleware(mux, stubAuth{})))
+	t.Cleanup(srv.Close)
+	return bus, "ws" + strings.TrimPrefix(srv.URL, "http") + "/api/ws"
+}
+
+func dialWebsocket(t *testing.T, url string, header http.Header) *websocket.Conn {
+	if header == nil {
+		header = http.Header{}
+	}
+	header.Set("Cookie", "token=valid")
+	conn, resp, err := websocket.DefaultDialer.Dial(url, header)
+	if err != nil {
+		t.Fatalf("dial: %v (%v)", err, resp)
+	}
+	t.Cleanup(func() { conn.Close() })
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	return conn
+}
+
+func TestWebsocket_StreamsEventsOfTopics(t *testing.T) {
+	bus, url := newWebsocketTestServer(t)
+	conn := dialWebsocket(t, url+"?topics=deployment:4", nil)
+
+	// the subscription is registered once the reply is received
+	assert.NoError(t, conn.WriteJSON(wsClientMessage{Action: "subscribe", Topics: []string{events.TopicStacks}}))
+	var msg wsServerMessage
+	assert.NoError(t, conn.ReadJSON(&msg))
+	assert.Equal(t, []string{"deployment:4", events.TopicStacks}, msg.Topics)
+
+	bus.HandleEvent(context.Background(), models.Event{Type: models.EventMisc, Msg: "other", ObjectID: 5})
+	bus.HandleEvent(context.Background(), models.Event{Type: models.EventMisc, Msg: "pulling", ObjectID: 4})
+
+	msg = wsServerMessage{}
+	assert.NoError(t, conn.ReadJSON(&msg))
+	assert.Equal(t, []string{"deployment:4"}, msg.Topics)
+	assert.Equal(t, "pulling", msg.Event.Msg)
+	assert.Equal(t, api.EventTypeMISC, msg.Event.Type)
+}
+
+func TestWebsocket_InvalidMessages(t *testing.T) {
+	_, url := newWebsocketTestServer(t)
+	conn := dialWebsocket(t, url, nil)
+
+	for _, message := range []string{`not json`, `{"action":"subscribe","topics":["other"]}`, `{"action":"publish"}`} {
+		assert.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(message)))
+		var msg wsServerMessage
+		assert.NoError(t, conn.ReadJSON(&msg))
+		assert.NotEmpty(t, msg.Error, message)
+	}
+}
+
+func TestWebsocket_Rejections(t *testing.T) {
+	_, url := newWebsocketTestServer(t)
+
+	_, resp, err := websocket.DefaultDialer.Dial(url, nil)
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	header := http.Header{"Cookie": {"token=valid"}, "Origin": {"http://evil.example"}}
+	_, resp, err = websocket.DefaultDialer.Dial(url, header)
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	_, resp, err = websocket.DefaultDialer.Dial(url+"?topics=other", http.Header{"Cookie": {"token=valid"}})
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestCheckOrigin(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "http://nas:5005/api/ws", nil)
+	assert.True(t, checkOrigin(r))
+	r.Header.Set("Origin", "http://NAS:5005")
+	assert.True(t, checkOrigin(r))
+	r.Header.Set("Origin", "http://nas:3000")
+	assert.False(t, checkOrigin(r))
+}
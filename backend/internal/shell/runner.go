@@ -2,16 +2,25 @@
 package shell
 
 import (
+	"bufio"
 	"fmt"
+	"io"
 	"log/slog"
 	"os/exec"
+	"strings"
+	"sync"
 
 	"omar-kada/autonas/internal/events"
+	"omar-kada/autonas/models"
 )
 
+// maxLineSize is the size of the longest line read by Stream, longer lines are split
+const maxLineSize = 64 * 1024
+
 // Executor abstracts writing content to a file
 type Executor interface {
 	Exec(cmd string, args ...string) ([]byte, error)
+	Stream(onLine func(stream models.LogStream, line string), cmd string, args ...string) error
 }
 
 type cmdExecuter struct{}
@@ -35,6 +44,53 @@ func (cmdExecuter) Exec(cmd string, args ...string) ([]byte, error) {
 	return out, err
 }
 
+// Stream runs a shell command and calls onLine with each line printed on stdout and stderr
+// while it runs, onLine is never called concurrently
+func (cmdExecuter) Stream(onLine func(stream models.LogStream, line string), cmd string, args ...string) error {
+	path, err := exec.LookPath(cmd)
+	if err != nil {
+		return fmt.Errorf("executable not found: %w", err)
+	}
+	c := execCommand(path, args...)
+	stdout, err := c.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	stderr, err := c.StderrPipe()
+	if err != nil {
+		return err
+	}
+	if err := c.Start(); err != nil {
+		return err
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	scan := func(stream models.LogStream, r io.Reader) {
+		defer wg.Done()
+		reader := bufio.NewReaderSize(r, maxLineSize)
+		for {
+			line, err := reader.ReadSlice('\n')
+			if text := strings.TrimRight(string(line), "\r\n"); text != "" {
+				mu.Lock()
+				onLine(stream, text)
+				mu.Unlock()
+			}
+			if err != nil && err != bufio.ErrBufferFull {
+				return
+			}
+		}
+	}
+	wg.Add(2)
+	go scan(models.LogStreamStdout, stdout)
+	go scan(models.LogStreamStderr, stderr)
+	// the pipes must be read before waiting
+	wg.Wait()
+	err = c.Wait()
+	slog.Debug("command result", "cmd", cmd, "args", args, "err", err)
+	return err
+}
+
 // execCommand is a wrapper for exec.Command for testability
 var execCommand = defaultExecCommand
 
@@ -5,6 +5,8 @@ import (
 	"os/exec"
 	"testing"
 
+	"omar-kada/autonas/models"
+
 	"github.com/stretchr/testify/assert"
 )
 
@@ -61,3 +63,39 @@ func TestRunCommand_ExecError(t *testing.T) {
 	_, err := NewExecutor().Exec("echo")
 	assert.ErrorContains(t, err, "exec error")
 }
+
+func TestStream_Lines(t *testing.T) {
+	originalExecCommand := execCommand
+	defer func() { execCommand = originalExecCommand }()
+
+	execCommand = func(_ string, _ ...string) *exec.Cmd {
+		return exec.Command("sh", "-c", "echo pulling; echo warning >&2; printf 'done\\r\\n\\nlast'")
+	}
+
+	lines := map[models.LogStream][]string{}
+	err := NewExecutor().Stream(func(stream models.LogStream, line string) {
+		lines[stream] = append(lines[stream], line)
+	}, "sh")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"pulling", "done", "last"}, lines[models.LogStreamStdout])
+	assert.Equal(t, []string{"warning"}, lines[models.LogStreamStderr])
+}
+
+func TestStream_Error(t *testing.T) {
+	originalExecCommand := execCommand
+	defer func() { execCommand = originalExecCommand }()
+
+	execCommand = func(_ string, _ ...string) *exec.Cmd {
+		return exec.Command("sh", "-c", "echo failed >&2; exit 3")
+	}
+
+	var lines []string
+	err := NewExecutor().Stream(func(_ models.LogStream, line string) {
+		lines = append(lines, line)
+	}, "sh")
+	assert.ErrorContains(t, err, "exit status 3")
+	assert.Equal(t, []string{"failed"}, lines)
+
+	err = NewExecutor().Stream(func(models.LogStream, string) {}, "non-existent-command")
+	assert.ErrorContains(t, err, "executable not found")
+}
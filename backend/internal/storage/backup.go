@@ -0,0 +1,310 @@
+package storage
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"omar-kada/autonas/internal/files"
+
+	"gorm.io/gorm"
+)
+
+// Names of the files in a backup archive
+const (
+	BackupDBFile        = "autonas.db"
+	BackupConfigFile    = "config.yaml"
+	BackupMasterKeyFile = "master.key"
+)
+
+// ErrBackupNotSupported is returned when backing up a postgres database, pg_dump must be used instead
+var ErrBackupNotSupported = errors.New("backups of postgres databases aren't supported, use pg_dump")
+
+// sqliteHeader starts every sqlite database file
+var sqliteHeader = []byte("SQLite format 3\x00")
+
+// BackupStorage creates backups of the database and the config file
+type BackupStorage interface {
+	Snapshot() (*Snapshot, error)
+}
+
+// BackupFiles are the files bundled with the database in a backup
+type BackupFiles struct {
+	// ConfigFile is the config file, its overlays (config.<profile>.yaml) are bundled with it
+	ConfigFile string
+	// MasterKeyFile is the file of the master key, only bundled when set :
+	// the archive then decrypts the secrets of the database
+	MasterKeyFile string
+}
+
+type gormBackupStorage struct {
+	db    *gorm.DB
+	files BackupFiles
+}
+
+// NewBackupStorage creates backups of db bundled with the files
+func NewBackupStorage(db *gorm.DB, files BackupFiles) BackupStorage {
+	return &gormBackupStorage{db: db, files: files}
+}
+
+// Snapshot is a consistent copy of the database and of the files bundled with it, it must be closed
+// to remove the copy
+type Snapshot struct {
+	Time  time.Time
+	dir   string
+	files []backupEntry
+}
+
+// backupEntry is a file of the archive, besides the database
+type backupEntry struct {
+	name    string
+	content []byte
+}
+
+// Snapshot copies the database while it's in use, the copy is consistent even with
+// concurrent writes
+func (s *gormBackupStorage) Snapshot() (*Snapshot, error) {
+	if IsPostgres(s.db) {
+		return nil, ErrBackupNotSupported
+	}
+	entries, err := s.readFiles()
+	if err != nil {
+		return nil, err
+	}
+	dir, err := os.MkdirTemp("", "autonas-backup-*")
+	if err != nil {
+		return nil, err
+	}
+	snapshot := &Snapshot{Time: time.Now(), dir: dir, files: entries}
+	if err := s.db.Exec("VACUUM INTO ?", snapshot.dbFile()).Error; err != nil {
+		snapshot.Close()
+		return nil, fmt.Errorf("error copying the database: %w", err)
+	}
+	return snapshot, nil
+}
+
+// readFiles reads the config file, its overlays and the master key, the missing files are skipped
+func (s *gormBackupStorage) readFiles() ([]backupEntry, error) {
+	paths := map[string]string{BackupConfigFile: s.files.ConfigFile}
+	names := []string{BackupConfigFile}
+	overlays, err := filepath.Glob(OverlayPath(s.files.ConfigFile, "*"))
+	if err != nil {
+		return nil, err
+	}
+	for _, overlay := range overlays {
+		profile := overlayProfile(s.files.ConfigFile, overlay)
+		name := OverlayPath(BackupConfigFile, profile)
+		paths[name] = overlay
+		names = append(names, name)
+	}
+	if s.files.MasterKeyFile != "" {
+		paths[BackupMasterKeyFile] = s.files.MasterKeyFile
+		names = append(names, BackupMasterKeyFile)
+	}
+
+	var entries []backupEntry
+	for _, name := range names {
+		content, err := os.ReadFile(paths[name])
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("error reading %s: %w", paths[name], err)
+		}
+		entries = append(entries, backupEntry{name: name, content: content})
+	}
+	return entries, nil
+}
+
+// overlayProfile returns the profile of an overlay path, config.<profile>.yaml gives <profile>
+func overlayProfile(configFile, overlay string) string {
+	ext := filepath.Ext(configFile)
+	prefix := strings.TrimSuffix(filepath.Base(configFile), ext) + "."
+	return strings.TrimSuffix(strings.TrimPrefix(filepath.Base(overlay), prefix), ext)
+}
+
+// FileName returns the name of the archive, with the time of the snapshot
+func (s *Snapshot) FileName() string {
+	return "autonas-backup-" + s.Time.Format("20060102-150405") + ".tar.gz"
+}
+
+// Write writes the snapshot to w as a tar.gz archive
+func (s *Snapshot) Write(w io.Writer) error {
+	gzipWriter := gzip.NewWriter(w)
+	tarWriter := tar.NewWriter(gzipWriter)
+	db, err := os.Open(s.dbFile())
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	info, err := db.Stat()
+	if err != nil {
+		return err
+	}
+	if err := s.writeEntry(tarWriter, BackupDBFile, info.Size(), db); err != nil {
+		return err
+	}
+	for _, entry := range s.files {
+		if err := s.writeEntry(tarWriter, entry.name, int64(len(entry.content)), bytes.NewReader(entry.content)); err != nil {
+			return err
+		}
+	}
+	if err := tarWriter.Close(); err != nil {
+		return err
+	}
+	return gzipWriter.Close()
+}
+
+func (s *Snapshot) writeEntry(w *tar.Writer, name string, size int64, content io.Reader) error {
+	header := &tar.Header{Name: name, Mode: 0o600, Size: size, ModTime: s.Time, Typeflag: tar.TypeReg}
+	if err := w.WriteHeader(header); err != nil {
+		return err
+	}
+	_, err := io.Copy(w, content)
+	return err
+}
+
+// Close removes the copy of the database
+func (s *Snapshot) Close() error {
+	return os.RemoveAll(s.dir)
+}
+
+func (s *Snapshot) dbFile() string {
+	return filepath.Join(s.dir, BackupDBFile)
+}
+
+// RestoreBackup replaces dbFile and the files with the content of a backup archive, the
+// replaced files are kept with a .bak suffix. The database must not be in use.
+// The master key is only restored when the archive has one
+func RestoreBackup(r io.Reader, dbFile string, files BackupFiles) error {
+	gzipReader, err := gzip.NewReader(r)
+	if err != nil {
+		return fmt.Errorf("invalid backup archive: %w", err)
+	}
+	defer gzipReader.Close()
+
+	if err := os.MkdirAll(filepath.Dir(dbFile), 0o700); err != nil {
+		return err
+	}
+	tmpDB := dbFile + ".restore"
+	defer os.Remove(tmpDB)
+	var restored []restoredFile
+	hasDB := false
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, err := tarReader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return fmt.Errorf("invalid backup archive: %w", err)
+		}
+		if header.Name == BackupDBFile {
+			if err := writeRestoredDB(tmpDB, tarReader); err != nil {
+				return err
+			}
+			hasDB = true
+			continue
+		}
+		file, ok := restoredPath(header.Name, files)
+		if !ok {
+			continue
+		}
+		if file.content, err = io.ReadAll(tarReader); err != nil {
+			return fmt.Errorf("invalid backup archive: %w", err)
+		}
+		restored = append(restored, file)
+	}
+	if !hasDB {
+		return fmt.Errorf("invalid backup archive: no %s file", BackupDBFile)
+	}
+
+	// the write-ahead log goes with the replaced database, it would be applied to the restored one
+	for _, suffix := range []string{"", "-wal", "-shm"} {
+		if err := keepBackup(dbFile, suffix); err != nil {
+			return err
+		}
+	}
+	if err := os.Rename(tmpDB, dbFile); err != nil {
+		return fmt.Errorf("error restoring database %s: %w", dbFile, err)
+	}
+	for _, file := range restored {
+		if err := restoreFile(file); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// restoredFile is a file of the archive to write back
+type restoredFile struct {
+	path    string
+	perm    os.FileMode
+	content []byte
+}
+
+// restoredPath returns where the file of the archive named name is restored,
+// it returns false for the unknown files
+func restoredPath(name string, files BackupFiles) (restoredFile, bool) {
+	switch {
+	case name == BackupConfigFile && files.ConfigFile != "":
+		return restoredFile{path: files.ConfigFile, perm: 0o644}, true
+	case name == BackupMasterKeyFile && files.MasterKeyFile != "":
+		return restoredFile{path: files.MasterKeyFile, perm: 0o600}, true
+	case files.ConfigFile != "" && name != BackupConfigFile && filepath.Base(name) == name:
+		if profile := overlayProfile(BackupConfigFile, name); profile != "" && OverlayPath(BackupConfigFile, profile) == name {
+			return restoredFile{path: OverlayPath(files.ConfigFile, profile), perm: 0o644}, true
+		}
+	}
+	return restoredFile{}, false
+}
+
+// restoreFile writes back a file of the archive, the replaced file is kept with a .bak suffix
+func restoreFile(file restoredFile) error {
+	if old, err := os.ReadFile(file.path); err == nil {
+		if err := files.WriteFileAtomic(BackupPath(file.path), old, 0o600); err != nil {
+			return fmt.Errorf("error writing backup of %s: %w", file.path, err)
+		}
+	}
+	if err := os.MkdirAll(filepath.Dir(file.path), 0o700); err != nil {
+		return err
+	}
+	if err := files.WriteFileAtomic(file.path, file.content, file.perm); err != nil {
+		return fmt.Errorf("error restoring %s: %w", file.path, err)
+	}
+	return nil
+}
+
+// writeRestoredDB writes the database of the archive to file, checking it's a sqlite database
+func writeRestoredDB(file string, content io.Reader) error {
+	f, err := os.OpenFile(file, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return err
+	}
+	header := make([]byte, len(sqliteHeader))
+	if _, err := io.ReadFull(content, header); err != nil || !bytes.Equal(header, sqliteHeader) {
+		f.Close()
+		return fmt.Errorf("invalid backup archive: %s isn't a sqlite database", BackupDBFile)
+	}
+	_, err = io.Copy(f, io.MultiReader(bytes.NewReader(header), content))
+	if err == nil {
+		err = f.Sync()
+	}
+	return errors.Join(err, f.Close())
+}
+
+// keepBackup renames the file of the database with the given suffix to the file of the .bak database
+func keepBackup(dbFile, suffix string) error {
+	if err := os.Remove(dbFile + ".bak" + suffix); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	if err := os.Rename(dbFile+suffix, dbFile+".bak"+suffix); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("error keeping a backup of %s: %w", dbFile+suffix, err)
+	}
+	return nil
+}
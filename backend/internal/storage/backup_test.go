@@ -0,0 +1,142 @@
+package storage
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"omar-kada/autonas/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackupAndRestore(t *testing.T) {
+	s, db := setupDeploymentStorage(t)
+	_, err := s.InitDeployment("backed up", "author", "+ diff", nil)
+	assert.NoError(t, err)
+	configFile := filepath.Join(t.TempDir(), "config.yaml")
+	assert.NoError(t, os.WriteFile(configFile, []byte("settings:\n  cron: '@daily'\n"), 0o600))
+
+	snapshot, err := NewBackupStorage(db, BackupFiles{ConfigFile: configFile}).Snapshot()
+	assert.NoError(t, err)
+	var archive bytes.Buffer
+	assert.NoError(t, snapshot.Write(&archive))
+	assert.Regexp(t, `^autonas-backup-\d{8}-\d{6}\.tar\.gz$`, snapshot.FileName())
+	assert.NoError(t, snapshot.Close())
+	assert.NoDirExists(t, snapshot.dir)
+
+	targetDir := t.TempDir()
+	dbFile := filepath.Join(targetDir, "db", "autonas.db")
+	targetConfig := filepath.Join(targetDir, "config.yaml")
+	assert.NoError(t, os.WriteFile(targetConfig, []byte("old"), 0o600))
+	assert.NoError(t, RestoreBackup(&archive, dbFile, BackupFiles{ConfigFile: targetConfig}))
+
+	restored, err := NewGormDb(dbFile, 0o000)
+	assert.NoError(t, err)
+	var deps []models.Deployment
+	assert.NoError(t, restored.Find(&deps).Error)
+	assert.Len(t, deps, 1)
+	assert.Equal(t, "backed up", deps[0].Title)
+	config, _ := os.ReadFile(targetConfig)
+	assert.Equal(t, "settings:\n  cron: '@daily'\n", string(config))
+	old, _ := os.ReadFile(BackupPath(targetConfig))
+	assert.Equal(t, "old", string(old))
+}
+
+func TestBackupAndRestore_OverlaysAndMasterKey(t *testing.T) {
+	_, db := setupDeploymentStorage(t)
+	sourceDir := t.TempDir()
+	source := BackupFiles{
+		ConfigFile:    filepath.Join(sourceDir, "autonas.yaml"),
+		MasterKeyFile: filepath.Join(sourceDir, "master.key"),
+	}
+	assert.NoError(t, os.WriteFile(source.ConfigFile, []byte("environment: {}\n"), 0o600))
+	assert.NoError(t, os.WriteFile(OverlayPath(source.ConfigFile, "nas"), []byte("environment:\n  A: nas\n"), 0o600))
+	assert.NoError(t, os.WriteFile(source.MasterKeyFile, []byte("key"), 0o600))
+
+	// the master key is only bundled when it's set
+	withoutKey, err := NewBackupStorage(db, BackupFiles{ConfigFile: source.ConfigFile}).Snapshot()
+	assert.NoError(t, err)
+	defer withoutKey.Close()
+	assert.Equal(t, []string{BackupConfigFile, "config.nas.yaml"}, entryNames(withoutKey))
+
+	snapshot, err := NewBackupStorage(db, source).Snapshot()
+	assert.NoError(t, err)
+	defer snapshot.Close()
+	assert.Equal(t, []string{BackupConfigFile, "config.nas.yaml", BackupMasterKeyFile}, entryNames(snapshot))
+	var archive bytes.Buffer
+	assert.NoError(t, snapshot.Write(&archive))
+
+	targetDir := t.TempDir()
+	target := BackupFiles{
+		ConfigFile:    filepath.Join(targetDir, "config.yaml"),
+		MasterKeyFile: filepath.Join(targetDir, "keys", "master.key"),
+	}
+	assert.NoError(t, os.WriteFile(OverlayPath(target.ConfigFile, "nas"), []byte("old"), 0o600))
+	assert.NoError(t, RestoreBackup(&archive, filepath.Join(targetDir, "autonas.db"), target))
+
+	overlay, _ := os.ReadFile(OverlayPath(target.ConfigFile, "nas"))
+	assert.Equal(t, "environment:\n  A: nas\n", string(overlay))
+	old, _ := os.ReadFile(BackupPath(OverlayPath(target.ConfigFile, "nas")))
+	assert.Equal(t, "old", string(old))
+	key, _ := os.ReadFile(target.MasterKeyFile)
+	assert.Equal(t, "key", string(key))
+	if info, err := os.Stat(target.MasterKeyFile); assert.NoError(t, err) {
+		assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+	}
+}
+
+func entryNames(snapshot *Snapshot) []string {
+	var names []string
+	for _, entry := range snapshot.files {
+		names = append(names, entry.name)
+	}
+	return names
+}
+
+func TestRestoreBackup_KeepsReplacedDB(t *testing.T) {
+	_, db := setupDeploymentStorage(t)
+	snapshot, err := NewBackupStorage(db, BackupFiles{ConfigFile: filepath.Join(t.TempDir(), "missing.yaml")}).Snapshot()
+	assert.NoError(t, err)
+	defer snapshot.Close()
+	var archive bytes.Buffer
+	assert.NoError(t, snapshot.Write(&archive))
+
+	dir := t.TempDir()
+	dbFile := filepath.Join(dir, "autonas.db")
+	configFile := filepath.Join(dir, "config.yaml")
+	assert.NoError(t, os.WriteFile(dbFile, []byte("current"), 0o600))
+	assert.NoError(t, os.WriteFile(dbFile+"-wal", []byte("log"), 0o600))
+	assert.NoError(t, RestoreBackup(&archive, dbFile, BackupFiles{ConfigFile: configFile}))
+
+	current, _ := os.ReadFile(dbFile + ".bak")
+	assert.Equal(t, "current", string(current))
+	assert.FileExists(t, dbFile+".bak-wal")
+	assert.NoFileExists(t, dbFile+"-wal")
+	// the archive has no config file
+	assert.NoFileExists(t, configFile)
+}
+
+func TestRestoreBackup_Invalid(t *testing.T) {
+	dir := t.TempDir()
+	dbFile := filepath.Join(dir, "autonas.db")
+
+	assert.ErrorContains(t, RestoreBackup(bytes.NewReader([]byte("not gzip")), dbFile, BackupFiles{}), "invalid backup archive")
+
+	var archive bytes.Buffer
+	gzipWriter := gzip.NewWriter(&archive)
+	tarWriter := tar.NewWriter(gzipWriter)
+	content := []byte("not a database, but long enough")
+	assert.NoError(t, tarWriter.WriteHeader(&tar.Header{Name: BackupDBFile, Mode: 0o600, Size: int64(len(content))}))
+	_, err := tarWriter.Write(content)
+	assert.NoError(t, err)
+	assert.NoError(t, tarWriter.Close())
+	assert.NoError(t, gzipWriter.Close())
+
+	assert.ErrorContains(t, RestoreBackup(&archive, dbFile, BackupFiles{}), "isn't a sqlite database")
+	assert.NoFileExists(t, dbFile)
+	assert.NoFileExists(t, dbFile+".restore")
+}
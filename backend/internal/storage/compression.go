@@ -0,0 +1,66 @@
+package storage
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"strings"
+
+	"omar-kada/autonas/models"
+)
+
+// compressedPrefix marks the diffs stored compressed, as base64 encoded gzip
+const compressedPrefix = "gzip:"
+
+// compressThreshold is the size in bytes above which diffs are compressed
+const compressThreshold = 8 * 1024
+
+func compressText(text string) (string, error) {
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	if _, err := writer.Write([]byte(text)); err != nil {
+		return "", err
+	}
+	if err := writer.Close(); err != nil {
+		return "", err
+	}
+	return compressedPrefix + base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+// decompressText returns the text as is when it isn't compressed
+func decompressText(text string) (string, error) {
+	encoded, ok := strings.CutPrefix(text, compressedPrefix)
+	if !ok {
+		return text, nil
+	}
+	compressed, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", fmt.Errorf("invalid compressed diff : %w", err)
+	}
+	reader, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return "", fmt.Errorf("invalid compressed diff : %w", err)
+	}
+	defer reader.Close()
+	bs, err := io.ReadAll(reader)
+	if err != nil {
+		return "", fmt.Errorf("invalid compressed diff : %w", err)
+	}
+	return string(bs), nil
+}
+
+// decompressDiffs restores the compressed diffs of the deployment and its files
+func decompressDiffs(dep *models.Deployment) error {
+	var err error
+	if dep.Diff, err = decompressText(dep.Diff); err != nil {
+		return err
+	}
+	for i := range dep.Files {
+		if dep.Files[i].Diff, err = decompressText(dep.Files[i].Diff); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -1,73 +1,180 @@
 package storage
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"log/slog"
+	"maps"
 	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
 
+	"omar-kada/autonas/internal/files"
 	"omar-kada/autonas/models"
 
 	"github.com/go-viper/mapstructure/v2"
 	"go.yaml.in/yaml/v3"
 )
 
+// ErrVersionConflict is returned when the config file changed since the version given to an update
+var ErrVersionConflict = errors.New("the configuration was modified since it was read")
+
 // ConfigStore stores and retreives the configuration
 type ConfigStore interface {
 	Update(cfg models.Config) error
 	Get() (models.Config, error)
+	GetBase() (models.Config, error)
+	GetBaseVersion() (models.Config, string, error)
+	GetResolved() (models.Config, models.ConfigOrigins, error)
 	ToYaml(cfg models.Config) ([]byte, error)
+	UpdateAs(cfg models.Config, author, message, version string) (string, error)
 	SetOnChange(fn func(oldConfig, newConfig models.Config))
+	Watch(ctx context.Context, onError func(err error)) error
+
+	SetHistory(history ConfigHistoryStorage)
+	GetRevisions(c Cursor[uint64]) ([]models.ConfigRevision, error)
+	GetRevision(id uint64) (models.ConfigRevision, error)
+	DiffRevisions(fromID, toID uint64) (models.FileDiff, error)
+	RestoreRevision(id uint64, author string) (models.ConfigRevision, error)
 }
 
 type configStore struct {
 	OnConfigUpdate func(oldConfig, newConfig models.Config)
 	configFilePath string
+	profiles       []string
+	watchDelay     time.Duration
+	history        ConfigHistoryStorage
+
+	// writeMu serializes the writes of the config file
+	writeMu sync.Mutex
+	mu      sync.Mutex
+	// lastValid is the last configuration read successfully, returned while the files are invalid
+	lastValid *resolvedConfig
+	// watched is the configuration the watcher compares the files to
+	watched *resolvedConfig
 }
 
-// NewConfigStore creates a new config file storage
-func NewConfigStore(filePath string) ConfigStore {
+type resolvedConfig struct {
+	cfg     models.Config
+	origins models.ConfigOrigins
+	yaml    []byte
+}
+
+// NewConfigStore creates a new config file storage, the overlays of profiles
+// (config.<profile>.yaml next to the config file) are merged over it in order
+func NewConfigStore(filePath string, profiles ...string) ConfigStore {
 	return &configStore{
 		configFilePath: filePath,
+		profiles:       profiles,
+		watchDelay:     500 * time.Millisecond,
 	}
 }
 
-func (s *configStore) Update(cfg models.Config) (err error) {
+// Update writes cfg to the base config file, overlays are never modified
+func (s *configStore) Update(cfg models.Config) error {
+	_, err := s.UpdateAs(cfg, "", "", "")
+	return err
+}
+
+// UpdateAs writes cfg to the base config file, the revision is recorded with author and message.
+// When version isn't empty, the update fails with ErrVersionConflict if the file changed since
+// this version was read. The version of the written file is returned.
+func (s *configStore) UpdateAs(cfg models.Config, author, message, version string) (string, error) {
 	slog.Debug("updating configuration file")
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
 
-	oldCfg, err := s.Get()
+	oldBase, currentVersion, err := s.GetBaseVersion()
 	if err != nil {
-		return err
+		return "", err
+	}
+	if version != "" && version != currentVersion {
+		return "", ErrVersionConflict
 	}
 
 	if models.IsObfuscated(cfg.Settings.Token) {
-		cfg.Settings.Token = oldCfg.Settings.Token // keep old token when obfuscated
+		cfg.Settings.Token = oldBase.Settings.Token // keep old token when obfuscated
 	}
 	if models.IsObfuscated(cfg.Settings.NotificationURL) {
-		cfg.Settings.NotificationURL = oldCfg.Settings.NotificationURL // keep old url when obfuscated
+		cfg.Settings.NotificationURL = oldBase.Settings.NotificationURL // keep old url when obfuscated
 	}
-
-	if s.OnConfigUpdate != nil {
-		defer func() {
-			if err != nil { // check no error occurred when updating the config
-				return
-			}
-			s.OnConfigUpdate(oldCfg, cfg)
-		}()
+	// keep the old values of the obfuscated sensitive variables
+	cfg.Environment = restoreObfuscatedVariables(cfg.Environment, oldBase.Environment)
+	for name, vars := range cfg.Services {
+		cfg.Services[name] = restoreObfuscatedVariables(vars, oldBase.Services[name])
+	}
+	if err := ValidateConfig(cfg, ""); err != nil {
+		return "", err
 	}
 
 	bs, err := s.ToYaml(cfg)
+	if err != nil {
+		return "", err
+	}
+	if err := s.write(cfg, bs, author, message); err != nil {
+		return "", err
+	}
+	return configVersion(bs), nil
+}
+
+// restoreObfuscatedVariables replaces the obfuscated values of the sensitive variables by their old values
+func restoreObfuscatedVariables[M ~map[string]string](vars, oldVars M) M {
+	for key, value := range vars {
+		if value != models.ObfuscatedValue || !models.IsSensitiveKey(key) {
+			continue
+		}
+		if oldValue, ok := oldVars[key]; ok {
+			vars[key] = oldValue
+		}
+	}
+	return vars
+}
+
+// write replaces the content of the config file with the valid configuration cfg, and notifies the change.
+// The previous file is kept as a backup next to it, writeMu must be held.
+func (s *configStore) write(cfg models.Config, content []byte, author, message string) error {
+	oldCfg, err := s.Get()
 	if err != nil {
 		return err
 	}
 
-	if err := os.WriteFile(s.configFilePath, bs, 0o644); err != nil {
+	if old, err := os.ReadFile(s.configFilePath); err == nil {
+		if err := files.WriteFileAtomic(BackupPath(s.configFilePath), old, 0o600); err != nil {
+			return fmt.Errorf("error writing config backup: %w", err)
+		}
+	}
+	if err := files.WriteFileAtomic(s.configFilePath, content, 0o644); err != nil {
 		return fmt.Errorf("error writing config file %s: %w", s.configFilePath, err)
 	}
+	// the watcher must not notify this change a second time
+	if resolved, err := s.resolve(); err == nil {
+		s.mu.Lock()
+		s.watched = resolved
+		s.mu.Unlock()
+	}
+	s.recordRevision(content, author, message)
 
+	if s.OnConfigUpdate != nil {
+		newCfg, err := s.applyOverlays(cfg, content)
+		if err != nil {
+			return err
+		}
+		s.OnConfigUpdate(oldCfg, newCfg)
+	}
 	return nil
 }
 
+// BackupPath returns the path of the backup of the config file, config.yaml is backed up to config.yaml.bak
+func BackupPath(cfgPath string) string {
+	return cfgPath + ".bak"
+}
+
 func (*configStore) ToYaml(cfg models.Config) ([]byte, error) {
 	var m map[string]any
 	encCfg := &mapstructure.DecoderConfig{
@@ -95,22 +202,213 @@ func (s *configStore) SetOnChange(fn func(oldConfig, newConfig models.Config)) {
 	s.OnConfigUpdate = fn
 }
 
-// Get reads the configuration from the config file
+// Get returns the effective configuration : the config file merged with the overlays
 func (s *configStore) Get() (models.Config, error) {
-	bs, err := os.ReadFile(s.configFilePath)
+	cfg, _, err := s.GetResolved()
+	return cfg, err
+}
+
+// GetBase reads the configuration from the config file, without overlays
+func (s *configStore) GetBase() (models.Config, error) {
+	cfg, _, err := s.GetBaseVersion()
+	return cfg, err
+}
+
+// GetBaseVersion reads the configuration from the config file, without overlays,
+// and the version of the file, which changes with its content
+func (s *configStore) GetBaseVersion() (models.Config, string, error) {
+	content, err := readConfigFile(s.configFilePath)
+	if err != nil {
+		return models.Config{}, "", err
+	}
+	m, err := parseConfigMap(s.configFilePath, content)
 	if err != nil {
-		if errors.Is(err, os.ErrNotExist) {
-			return models.Config{}, nil
+		return models.Config{}, "", err
+	}
+	cfg, err := decodeConfig(m)
+	if err != nil {
+		return models.Config{}, "", err
+	}
+	return cfg, configVersion(content), nil
+}
+
+// configVersion returns a short hash of the config file content
+func configVersion(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:8])
+}
+
+// GetResolved returns the effective configuration and the file each value comes from,
+// the last valid configuration is returned while the files are invalid
+func (s *configStore) GetResolved() (models.Config, models.ConfigOrigins, error) {
+	resolved, err := s.resolve()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err != nil {
+		if s.lastValid == nil {
+			return models.Config{}, nil, err
 		}
-		return models.Config{}, fmt.Errorf("error reading config file %s: %w", s.configFilePath, err)
+		slog.Warn("using the last valid configuration", "error", err)
+		resolved = s.lastValid
 	}
+	s.lastValid = resolved
+	return resolved.cfg, maps.Clone(resolved.origins), nil
+}
 
-	var m map[string]any
-	if err := yaml.Unmarshal(bs, &m); err != nil {
+// resolve reads and validates the effective configuration
+func (s *configStore) resolve() (*resolvedConfig, error) {
+	merged, err := readConfigMap(s.configFilePath)
+	if err != nil {
+		return nil, err
+	}
+	origins := models.ConfigOrigins{}
+	recordOrigins(origins, "", merged, filepath.Base(s.configFilePath))
+
+	overlays, err := s.readOverlays()
+	if err != nil {
+		return nil, err
+	}
+	for _, overlay := range overlays {
+		mergeConfigMaps(merged, overlay.values, "", overlay.name, origins)
+	}
+	cfg, err := decodeConfig(merged)
+	if err != nil {
+		return nil, err
+	}
+	if err := ValidateConfig(cfg, ""); err != nil {
+		return nil, err
+	}
+	bs, err := s.ToYaml(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &resolvedConfig{cfg: cfg, origins: origins, yaml: bs}, nil
+}
+
+// applyOverlays returns the effective configuration of the base config cfg, serialized as baseYaml
+func (s *configStore) applyOverlays(cfg models.Config, baseYaml []byte) (models.Config, error) {
+	overlays, err := s.readOverlays()
+	if err != nil || len(overlays) == 0 {
+		return cfg, err
+	}
+	var merged map[string]any
+	if err := yaml.Unmarshal(baseYaml, &merged); err != nil {
 		return models.Config{}, fmt.Errorf("error unmarshaling yaml %s: %w", s.configFilePath, err)
 	}
+	for _, overlay := range overlays {
+		mergeConfigMaps(merged, overlay.values, "", overlay.name, models.ConfigOrigins{})
+	}
+	return decodeConfig(merged)
+}
+
+type configOverlay struct {
+	name   string
+	values map[string]any
+}
+
+// readOverlays reads the overlays of the profiles that exist, in order
+func (s *configStore) readOverlays() ([]configOverlay, error) {
+	var overlays []configOverlay
+	for _, profile := range s.profiles {
+		if profile == "" {
+			continue
+		}
+		if strings.ContainsAny(profile, `/\`) || strings.HasPrefix(profile, ".") {
+			return nil, fmt.Errorf("invalid profile %q", profile)
+		}
+		path := OverlayPath(s.configFilePath, profile)
+		if _, err := os.Stat(path); errors.Is(err, os.ErrNotExist) {
+			slog.Debug("no config overlay for profile", "profile", profile, "path", path)
+			continue
+		}
+		values, err := readConfigMap(path)
+		if err != nil {
+			return nil, err
+		}
+		overlays = append(overlays, configOverlay{name: filepath.Base(path), values: values})
+	}
+	return overlays, nil
+}
+
+// OverlayPath returns the path of the overlay of profile, config.yaml becomes config.<profile>.yaml
+func OverlayPath(configFilePath, profile string) string {
+	ext := filepath.Ext(configFilePath)
+	return strings.TrimSuffix(configFilePath, ext) + "." + profile + ext
+}
 
-	return decodeConfig(m)
+// readConfigMap reads a yaml config file, a missing file is an empty configuration
+func readConfigMap(path string) (map[string]any, error) {
+	bs, err := readConfigFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return parseConfigMap(path, bs)
+}
+
+// readConfigFile returns the content of a config file, missing files are empty
+func readConfigFile(path string) ([]byte, error) {
+	bs, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("error reading config file %s: %w", path, err)
+	}
+	return bs, nil
+}
+
+func parseConfigMap(path string, bs []byte) (map[string]any, error) {
+	m := map[string]any{}
+	if err := yaml.Unmarshal(bs, &m); err != nil {
+		return nil, fmt.Errorf("error unmarshaling yaml %s: %w", path, err)
+	}
+	if m == nil {
+		m = map[string]any{}
+	}
+	return m, nil
+}
+
+// mergeConfigMaps merges src into dst : maps are merged recursively, other values
+// (scalars and lists) of src replace the ones of dst, empty values of src are ignored.
+// origins of the replaced values are set to source.
+func mergeConfigMaps(dst, src map[string]any, prefix, source string, origins models.ConfigOrigins) {
+	for _, key := range slices.Sorted(maps.Keys(src)) {
+		path := joinConfigPath(prefix, key)
+		value := src[key]
+		if existing, exists := dst[key]; exists && value == nil {
+			continue
+		} else if dstMap, ok := existing.(map[string]any); ok {
+			if srcMap, ok := value.(map[string]any); ok {
+				mergeConfigMaps(dstMap, srcMap, path, source, origins)
+				continue
+			}
+		}
+		for originPath := range origins {
+			if originPath == path || strings.HasPrefix(originPath, path+".") {
+				delete(origins, originPath)
+			}
+		}
+		dst[key] = value
+		recordOrigins(origins, path, value, source)
+	}
+}
+
+// recordOrigins sets the origin of all the leaf values of value to source
+func recordOrigins(origins models.ConfigOrigins, path string, value any, source string) {
+	valueMap, ok := value.(map[string]any)
+	if !ok || (len(valueMap) == 0 && path != "") {
+		origins[path] = source
+		return
+	}
+	for key, child := range valueMap {
+		recordOrigins(origins, joinConfigPath(path, key), child, source)
+	}
+}
+
+func joinConfigPath(prefix, key string) string {
+	if prefix == "" {
+		return key
+	}
+	return prefix + "." + key
 }
 
 func decodeConfig(configMap map[string]any) (models.Config, error) {
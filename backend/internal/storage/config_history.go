@@ -0,0 +1,182 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+
+	"omar-kada/autonas/internal/files"
+	"omar-kada/autonas/models"
+
+	"go.yaml.in/yaml/v3"
+)
+
+// ErrHistoryDisabled is returned when the config history is used without a history storage
+var ErrHistoryDisabled = errors.New("config history is disabled")
+
+// SetHistory sets the storage of config revisions, the current config file
+// is recorded when it differs from the last revision
+func (s *configStore) SetHistory(history ConfigHistoryStorage) {
+	s.history = history
+	content, err := os.ReadFile(s.configFilePath)
+	if err != nil {
+		return
+	}
+	message := "edited on disk"
+	if _, err := history.GetLastRevision(); errors.Is(err, ErrRevisionNotFound) {
+		message = "initial revision"
+	}
+	s.recordRevision(content, "", message)
+}
+
+// recordRevision stores content as a new revision, unless it didn't change since the last one
+func (s *configStore) recordRevision(content []byte, author, message string) {
+	if s.history == nil {
+		return
+	}
+	last, err := s.history.GetLastRevision()
+	if err == nil && last.Content == string(content) {
+		return
+	} else if err != nil && !errors.Is(err, ErrRevisionNotFound) {
+		slog.Warn("couldn't read the last config revision", "error", err)
+	}
+	if _, err := s.history.AddRevision(models.ConfigRevision{
+		Author:  author,
+		Message: message,
+		Content: string(content),
+	}); err != nil {
+		slog.Warn("couldn't record config revision", "error", err)
+	}
+}
+
+// GetRevisions returns the revisions of the config file from the most recent one, without their content
+func (s *configStore) GetRevisions(c Cursor[uint64]) ([]models.ConfigRevision, error) {
+	if s.history == nil {
+		return nil, ErrHistoryDisabled
+	}
+	return s.history.GetRevisions(c)
+}
+
+// GetRevision returns a revision of the config file, with secrets obfuscated
+func (s *configStore) GetRevision(id uint64) (models.ConfigRevision, error) {
+	if s.history == nil {
+		return models.ConfigRevision{}, ErrHistoryDisabled
+	}
+	revision, err := s.history.GetRevision(id)
+	if err != nil {
+		return models.ConfigRevision{}, err
+	}
+	revision.Content = obfuscateContent(revision.Content)
+	return revision, nil
+}
+
+// DiffRevisions returns the changes between two revisions, with secrets obfuscated
+func (s *configStore) DiffRevisions(fromID, toID uint64) (models.FileDiff, error) {
+	from, err := s.GetRevision(fromID)
+	if err != nil {
+		return models.FileDiff{}, err
+	}
+	to, err := s.GetRevision(toID)
+	if err != nil {
+		return models.FileDiff{}, err
+	}
+	name := filepath.Base(s.configFilePath)
+	return models.FileDiff{
+		OldFile: fmt.Sprintf("%s (#%d)", name, from.ID),
+		NewFile: fmt.Sprintf("%s (#%d)", name, to.ID),
+		Diff:    files.DiffText(from.Content, to.Content),
+	}, nil
+}
+
+// RestoreRevision writes back the content of a revision to the config file,
+// the restore is recorded as a new revision, which is returned
+func (s *configStore) RestoreRevision(id uint64, author string) (models.ConfigRevision, error) {
+	if s.history == nil {
+		return models.ConfigRevision{}, ErrHistoryDisabled
+	}
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+	revision, err := s.history.GetRevision(id)
+	if err != nil {
+		return models.ConfigRevision{}, err
+	}
+	m := map[string]any{}
+	if err := yaml.Unmarshal([]byte(revision.Content), &m); err != nil {
+		return models.ConfigRevision{}, fmt.Errorf("invalid revision %d : %w", id, err)
+	}
+	cfg, err := decodeConfig(m)
+	if err != nil {
+		return models.ConfigRevision{}, err
+	}
+	if err := ValidateConfig(cfg, ""); err != nil {
+		return models.ConfigRevision{}, err
+	}
+	if err := s.write(cfg, []byte(revision.Content), author, fmt.Sprintf("restore of revision %d", id)); err != nil {
+		return models.ConfigRevision{}, err
+	}
+	restored, err := s.history.GetLastRevision()
+	if err != nil {
+		return models.ConfigRevision{}, err
+	}
+	restored.Content = obfuscateContent(restored.Content)
+	return restored, nil
+}
+
+// obfuscateContent hides the token, the notification url and the sensitive variables
+// of a config file content, comments and formatting are kept
+func obfuscateContent(content string) string {
+	var root yaml.Node
+	if err := yaml.Unmarshal([]byte(content), &root); err != nil || len(root.Content) == 0 {
+		return content
+	}
+	if settings := mappingValue(root.Content[0], "settings"); settings != nil {
+		for _, key := range []string{"token", "notificationURL"} {
+			if value := mappingValue(settings, key); value != nil && value.Kind == yaml.ScalarNode {
+				value.Value = models.Obfuscate(value.Value)
+			}
+		}
+	}
+	obfuscateVariables(mappingValue(root.Content[0], "environment"))
+	if services := mappingValue(root.Content[0], "services"); services != nil && services.Kind == yaml.MappingNode {
+		for i := 1; i < len(services.Content); i += 2 {
+			obfuscateVariables(services.Content[i])
+		}
+	}
+	bs, err := yaml.Marshal(&root)
+	if err != nil {
+		return content
+	}
+	return string(bs)
+}
+
+// obfuscateVariables hides the values of the variables with a sensitive name,
+// the secret references are kept as they don't hold the value
+func obfuscateVariables(node *yaml.Node) {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		value := node.Content[i+1]
+		if value.Kind != yaml.ScalarNode {
+			continue
+		}
+		if obfuscated := models.ObfuscateVariable(node.Content[i].Value, value.Value); obfuscated != value.Value {
+			value.Value = obfuscated
+			value.Style = 0
+		}
+	}
+}
+
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
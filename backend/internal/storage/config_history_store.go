@@ -0,0 +1,71 @@
+package storage
+
+import (
+	"errors"
+
+	"omar-kada/autonas/models"
+
+	"gorm.io/gorm"
+)
+
+// ErrRevisionNotFound is returned when a config revision doesn't exist
+var ErrRevisionNotFound = errors.New("config revision not found")
+
+// ConfigHistoryStorage stores the revisions of the config file
+type ConfigHistoryStorage interface {
+	AddRevision(revision models.ConfigRevision) (models.ConfigRevision, error)
+	GetRevision(id uint64) (models.ConfigRevision, error)
+	GetLastRevision() (models.ConfigRevision, error)
+	GetRevisions(c Cursor[uint64]) ([]models.ConfigRevision, error)
+}
+
+type gormConfigHistoryStorage struct {
+	db *gorm.DB
+}
+
+// NewConfigHistoryStorage creates a storage for config revisions using gorm
+func NewConfigHistoryStorage(db *gorm.DB) (ConfigHistoryStorage, error) {
+	if err := checkTables(db, &models.ConfigRevision{}); err != nil {
+		return nil, err
+	}
+	return &gormConfigHistoryStorage{db: db}, nil
+}
+
+// AddRevision stores a new revision
+func (s *gormConfigHistoryStorage) AddRevision(revision models.ConfigRevision) (models.ConfigRevision, error) {
+	revision.ID = 0
+	if err := s.db.Create(&revision).Error; err != nil {
+		return models.ConfigRevision{}, err
+	}
+	return revision, nil
+}
+
+// GetRevision returns the revision with its content
+func (s *gormConfigHistoryStorage) GetRevision(id uint64) (models.ConfigRevision, error) {
+	var revision models.ConfigRevision
+	err := s.db.First(&revision, id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return models.ConfigRevision{}, ErrRevisionNotFound
+	}
+	return revision, err
+}
+
+// GetLastRevision returns the most recent revision, with its content
+func (s *gormConfigHistoryStorage) GetLastRevision() (models.ConfigRevision, error) {
+	var revision models.ConfigRevision
+	err := s.db.Order("id desc").First(&revision).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return models.ConfigRevision{}, ErrRevisionNotFound
+	}
+	return revision, err
+}
+
+// GetRevisions returns the revisions from the most recent one, without their content
+func (s *gormConfigHistoryStorage) GetRevisions(c Cursor[uint64]) ([]models.ConfigRevision, error) {
+	var revisions []models.ConfigRevision
+	err := s.db.Scopes(Paginate(c)).Omit("content").Order("id desc").Find(&revisions).Error
+	if err != nil {
+		return nil, err
+	}
+	return revisions, nil
+}
@@ -0,0 +1,67 @@
+package storage
+
+import (
+	"testing"
+
+	"omar-kada/autonas/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func setupConfigHistoryStorage(t *testing.T) ConfigHistoryStorage {
+	db, err := NewGormDb(":memory:", 0o000)
+	if err != nil {
+		t.Fatalf("new db: %v", err)
+	}
+	history, err := NewConfigHistoryStorage(db)
+	if err != nil {
+		t.Fatalf("new storage: %v", err)
+	}
+	return history
+}
+
+func TestConfigHistoryStorage_AddAndGetRevision(t *testing.T) {
+	s := setupConfigHistoryStorage(t)
+
+	_, err := s.GetLastRevision()
+	assert.ErrorIs(t, err, ErrRevisionNotFound)
+
+	first, err := s.AddRevision(models.ConfigRevision{Author: "admin", Message: "first", Content: "cron: 0\n"})
+	assert.NoError(t, err)
+	second, err := s.AddRevision(models.ConfigRevision{Message: "second", Content: "cron: 1\n"})
+	assert.NoError(t, err)
+	assert.Greater(t, second.ID, first.ID)
+	assert.False(t, first.Time.IsZero())
+
+	revision, err := s.GetRevision(first.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "admin", revision.Author)
+	assert.Equal(t, "cron: 0\n", revision.Content)
+
+	last, err := s.GetLastRevision()
+	assert.NoError(t, err)
+	assert.Equal(t, second.ID, last.ID)
+
+	_, err = s.GetRevision(42)
+	assert.ErrorIs(t, err, ErrRevisionNotFound)
+}
+
+func TestConfigHistoryStorage_GetRevisions(t *testing.T) {
+	s := setupConfigHistoryStorage(t)
+	for _, content := range []string{"a", "b", "c"} {
+		_, err := s.AddRevision(models.ConfigRevision{Content: content})
+		assert.NoError(t, err)
+	}
+
+	revisions, err := s.GetRevisions(NewIDCursor(2, 0))
+	assert.NoError(t, err)
+	assert.Len(t, revisions, 2)
+	assert.Equal(t, uint64(3), revisions[0].ID)
+	assert.Equal(t, uint64(2), revisions[1].ID)
+	assert.Empty(t, revisions[0].Content)
+
+	revisions, err = s.GetRevisions(NewIDCursor(2, revisions[1].ID))
+	assert.NoError(t, err)
+	assert.Len(t, revisions, 1)
+	assert.Equal(t, uint64(1), revisions[0].ID)
+}
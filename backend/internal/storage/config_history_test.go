@@ -0,0 +1,136 @@
+package storage
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"omar-kada/autonas/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func setupConfigHistory(t *testing.T, content string) (*configStore, ConfigHistoryStorage, string) {
+	t.Helper()
+	filePath := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(filePath, []byte(content), 0o600); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+	store := NewConfigStore(filePath).(*configStore)
+	history := setupConfigHistoryStorage(t)
+	store.SetHistory(history)
+	return store, history, filePath
+}
+
+func TestSetHistory_RecordsInitialRevision(t *testing.T) {
+	store, history, _ := setupConfigHistory(t, "environment:\n  A: 1\n")
+
+	last, err := history.GetLastRevision()
+	assert.NoError(t, err)
+	assert.Equal(t, "initial revision", last.Message)
+	assert.Equal(t, "environment:\n  A: 1\n", last.Content)
+
+	// the same content isn't recorded twice
+	store.SetHistory(history)
+	revisions, err := store.GetRevisions(NewIDCursor(10, 0))
+	assert.NoError(t, err)
+	assert.Len(t, revisions, 1)
+}
+
+func TestUpdateAs_RecordsRevision(t *testing.T) {
+	store, _, _ := setupConfigHistory(t, "environment:\n  A: 1\n")
+
+	cfg := models.Config{Environment: models.Environment{"A": "2"}}
+	_, err := store.UpdateAs(cfg, "admin", "configuration updated", "")
+	assert.NoError(t, err)
+	// unchanged config doesn't create a new revision
+	_, err = store.UpdateAs(cfg, "admin", "configuration updated", "")
+	assert.NoError(t, err)
+
+	revisions, err := store.GetRevisions(NewIDCursor(10, 0))
+	assert.NoError(t, err)
+	assert.Len(t, revisions, 2)
+	assert.Equal(t, "admin", revisions[0].Author)
+	assert.Equal(t, "configuration updated", revisions[0].Message)
+
+	diff, err := store.DiffRevisions(revisions[1].ID, revisions[0].ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "config.yaml (#1)", diff.OldFile)
+	assert.Equal(t, "config.yaml (#2)", diff.NewFile)
+	assert.Contains(t, diff.Diff, "- 1")
+	assert.Contains(t, diff.Diff, "+ \"2\"")
+}
+
+func TestGetRevision_ObfuscatesSecrets(t *testing.T) {
+	store, _, _ := setupConfigHistory(t, "settings:\n  repo: https://example.com/repo\n  token: supersecrettoken # comment\n")
+
+	revision, err := store.GetRevision(1)
+	assert.NoError(t, err)
+	assert.NotContains(t, revision.Content, "supersecrettoken")
+	assert.Contains(t, revision.Content, "# comment")
+	assert.Contains(t, revision.Content, "repo: https://example.com/repo")
+
+	_, err = store.GetRevision(42)
+	assert.ErrorIs(t, err, ErrRevisionNotFound)
+}
+
+func TestDiffRevisions_ObfuscatesSensitiveVariables(t *testing.T) {
+	store, _, _ := setupConfigHistory(t, "environment:\n  DB_PASSWORD: firstpassword\n  DATA_PATH: /data\n")
+	cfg := models.Config{
+		Environment: models.Environment{"DB_PASSWORD": "secondpassword", "DATA_PATH": "/mnt/data"},
+		Services: map[string]models.ServiceConfig{
+			"app": {"API_KEY": "apikeyvalue", "ADMIN_TOKEN": "secret://admin_token"},
+		},
+	}
+	_, err := store.UpdateAs(cfg, "admin", "configuration updated", "")
+	assert.NoError(t, err)
+
+	diff, err := store.DiffRevisions(1, 2)
+	assert.NoError(t, err)
+	for _, value := range []string{"firstpassword", "secondpassword", "apikeyvalue"} {
+		assert.NotContains(t, diff.Diff, value)
+	}
+	assert.Contains(t, diff.Diff, "/mnt/data")
+	assert.Contains(t, diff.Diff, "secret://admin_token", "the secret references are kept")
+}
+
+func TestRestoreRevision(t *testing.T) {
+	initial := "# my config\nenvironment:\n  A: 1\n"
+	store, _, filePath := setupConfigHistory(t, initial)
+	_, err := store.UpdateAs(models.Config{Environment: models.Environment{"A": "2"}}, "admin", "configuration updated", "")
+	assert.NoError(t, err)
+
+	restored, err := store.RestoreRevision(1, "admin")
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(3), restored.ID)
+	assert.Equal(t, "restore of revision 1", restored.Message)
+	assert.Equal(t, "admin", restored.Author)
+
+	content, err := os.ReadFile(filePath)
+	assert.NoError(t, err)
+	assert.Equal(t, initial, string(content))
+	cfg, err := store.GetBase()
+	assert.NoError(t, err)
+	assert.Equal(t, "1", cfg.Environment["A"])
+
+	_, err = store.RestoreRevision(42, "admin")
+	assert.ErrorIs(t, err, ErrRevisionNotFound)
+}
+
+func TestRestoreRevision_Invalid(t *testing.T) {
+	store, history, _ := setupConfigHistory(t, "environment:\n  A: 1\n")
+	invalid, err := history.AddRevision(models.ConfigRevision{Content: "settings:\n  cron: invalid\n"})
+	assert.NoError(t, err)
+
+	_, err = store.RestoreRevision(invalid.ID, "admin")
+	assert.ErrorIs(t, err, models.ErrInvalidConfig)
+}
+
+func TestConfigHistory_Disabled(t *testing.T) {
+	store := NewConfigStore(filepath.Join(t.TempDir(), "config.yaml"))
+
+	_, err := store.GetRevisions(NewIDCursor(10, 0))
+	assert.ErrorIs(t, err, ErrHistoryDisabled)
+	_, err = store.RestoreRevision(1, "admin")
+	assert.ErrorIs(t, err, ErrHistoryDisabled)
+}
@@ -0,0 +1,130 @@
+package storage
+
+import (
+	"maps"
+
+	"omar-kada/autonas/models"
+)
+
+// ConfigJSONSchema returns the JSON Schema of the config file, to be used by editors
+func ConfigJSONSchema() map[string]any {
+	variables := map[string]any{
+		"type":                 "object",
+		"propertyNames":        map[string]any{"pattern": envKeyNameRegexp.String()},
+		"additionalProperties": map[string]any{"type": []string{"string", "number", "boolean"}},
+	}
+	schedule := map[string]any{
+		"type":                 "object",
+		"additionalProperties": false,
+		"properties": map[string]any{
+			"cron": map[string]any{
+				"type":        "string",
+				"description": "cron expression of the job, 0 or empty to disable",
+			},
+			"jitter": map[string]any{
+				"type":        "string",
+				"description": "maximum random delay of each run (ex: 5m)",
+			},
+		},
+	}
+	watchdogPolicy := map[string]any{
+		"disabled": map[string]any{"type": "boolean", "description": "don't watch the stack"},
+		"restart":  map[string]any{"type": "boolean", "description": "restart the stack once it's unhealthy, it's only reported otherwise"},
+		"unhealthyChecks": map[string]any{
+			"type": "integer", "minimum": 1, "default": models.DefaultUnhealthyChecks,
+			"description": "consecutive unhealthy checks before the stack is reported and restarted",
+		},
+		"backoff":    map[string]any{"type": "string", "description": "delay before restarting the stack again, doubled after each restart (ex: 1m)"},
+		"maxBackoff": map[string]any{"type": "string", "description": "maximum delay between two restarts (ex: 30m)"},
+	}
+	watchdogServices := map[string]any{
+		"type":                 "object",
+		"propertyNames":        map[string]any{"pattern": serviceNameRegexp.String()},
+		"additionalProperties": map[string]any{"type": "object", "additionalProperties": false, "properties": watchdogPolicy},
+	}
+	watchdog := map[string]any{"services": watchdogServices}
+	maps.Copy(watchdog, watchdogPolicy)
+
+	return map[string]any{
+		"$schema":              "https://json-schema.org/draft/2020-12/schema",
+		"title":                "AutoNAS configuration",
+		"type":                 "object",
+		"additionalProperties": false,
+		"properties": map[string]any{
+			"settings": map[string]any{
+				"type":                 "object",
+				"additionalProperties": false,
+				"properties": map[string]any{
+					"repo": map[string]any{
+						"type":        "string",
+						"description": "git repository containing the stacks, http(s), ssh, git, file url, user@host:path or absolute path",
+					},
+					"branch":   map[string]any{"type": "string", "default": models.DefaultBranch},
+					"username": map[string]any{"type": "string"},
+					"token":    map[string]any{"type": "string"},
+					"cron": map[string]any{
+						"type":        "string",
+						"description": "cron expression of the sync, 1 to run a single time, 0 or empty to disable",
+					},
+					"notificationURL": map[string]any{
+						"type":        "string",
+						"description": "shoutrrr url used to send notifications",
+					},
+					"notificationTypes": map[string]any{
+						"type":        "array",
+						"uniqueItems": true,
+						"items":       map[string]any{"enum": models.EventTypes},
+					},
+					"writeBack": map[string]any{
+						"enum":        []models.WriteBackMode{models.WriteBackCommit, models.WriteBackBranch},
+						"description": "commit the configuration edits to the repo, or to a new branch",
+					},
+					"timezone": map[string]any{
+						"type":        "string",
+						"description": "IANA timezone of the cron expressions (ex: Europe/Paris), local time by default",
+					},
+					"schedules": map[string]any{
+						"type":                 "object",
+						"propertyNames":        map[string]any{"enum": models.ScheduleNames},
+						"additionalProperties": schedule,
+					},
+					"maintenanceWindows": map[string]any{
+						"type":        "array",
+						"description": "periods during which sync and image updates are deferred",
+						"items": map[string]any{
+							"type":                 "object",
+							"additionalProperties": false,
+							"required":             []string{"cron", "duration"},
+							"properties": map[string]any{
+								"cron":     map[string]any{"type": "string", "description": "cron expression of the start of the window"},
+								"duration": map[string]any{"type": "string", "description": "length of the window (ex: 2h)"},
+							},
+						},
+					},
+					"retention": map[string]any{
+						"type":                 "object",
+						"description":          "deployments history kept, everything is kept by default",
+						"additionalProperties": false,
+						"properties": map[string]any{
+							"maxAge":        map[string]any{"type": "string", "description": "age after which deployments are deleted (ex: 90d, 720h)"},
+							"maxCount":      map[string]any{"type": "integer", "minimum": 0, "description": "number of deployments kept"},
+							"compressDiffs": map[string]any{"type": "boolean", "description": "compress the large diffs"},
+						},
+					},
+					"watchdog": map[string]any{
+						"type":                 "object",
+						"description":          "health watchdog of the stacks, the services have their own policy",
+						"additionalProperties": false,
+						"properties":           watchdog,
+					},
+				},
+			},
+			"environment": variables,
+			"services": map[string]any{
+				"type":                 "object",
+				"propertyNames":        map[string]any{"pattern": serviceNameRegexp.String()},
+				"additionalProperties": map[string]any{"oneOf": []any{variables, map[string]any{"type": "null"}}},
+			},
+		},
+	}
+}
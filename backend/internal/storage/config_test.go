@@ -1,6 +1,7 @@
 package storage
 
 import (
+	"embed"
 	"os"
 	"path/filepath"
 	"reflect"
@@ -9,6 +10,8 @@ import (
 	"omar-kada/autonas/models"
 
 	"github.com/stretchr/testify/assert"
+	"go.yaml.in/yaml/v3"
+	"golang.org/x/tools/txtar"
 )
 
 func TestDecodeConfig(t *testing.T) {
@@ -179,7 +182,7 @@ func TestUpdateConfig(t *testing.T) {
 		input := models.Config{
 			Settings: models.Settings{
 				Token:           "my-secret-token-12345",
-				NotificationURL: "https://example.com/webhook?token=12345",
+				NotificationURL: "generic+https://example.com/webhook?token=12345",
 			},
 			Environment: models.Environment{},
 			Services:    map[string]models.ServiceConfig{},
@@ -196,12 +199,12 @@ func TestUpdateConfig(t *testing.T) {
 		assert.Equal(t, "my-secret-token-12345", storedToken)
 
 		storedURL := storedCfg.Settings.NotificationURL
-		assert.Equal(t, "https://example.com/webhook?token=12345", storedURL)
+		assert.Equal(t, "generic+https://example.com/webhook?token=12345", storedURL)
 
 		input2 := models.Config{
 			Settings: models.Settings{
 				Token:           "my-secret-********************",
-				NotificationURL: "https://ex********************",
+				NotificationURL: "generic+ht********************",
 			},
 			Environment: models.Environment{},
 			Services:    map[string]models.ServiceConfig{},
@@ -218,7 +221,7 @@ func TestUpdateConfig(t *testing.T) {
 		assert.Equal(t, "my-secret-token-12345", storedToken)
 
 		storedURL = storedCfg.Settings.NotificationURL
-		assert.Equal(t, "https://example.com/webhook?token=12345", storedURL)
+		assert.Equal(t, "generic+https://example.com/webhook?token=12345", storedURL)
 	})
 }
 
@@ -244,3 +247,171 @@ func TestLoadConfig_FileError(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+//go:embed test_data/*
+var testDataFS embed.FS
+
+func TestMergeConfigMaps(t *testing.T) {
+	content, err := testDataFS.ReadFile("test_data/config_override.txtar")
+	assert.NoError(t, err)
+	archive := txtar.Parse(content)
+	yamlFiles := make([]map[string]any, len(archive.Files))
+	for i, file := range archive.Files {
+		assert.NoError(t, yaml.Unmarshal(file.Data, &yamlFiles[i]), file.Name)
+	}
+	base, overlay, want := yamlFiles[0], yamlFiles[1], yamlFiles[2]
+
+	origins := models.ConfigOrigins{}
+	recordOrigins(origins, "", base, "base")
+	mergeConfigMaps(base, overlay, "", "overlay", origins)
+
+	assert.Equal(t, want, base)
+	assert.Equal(t, models.ConfigOrigins{
+		"AUTONAS_HOST":           "base",
+		"DATA_PATH":              "overlay",
+		"SERVICES_PATH":          "overlay",
+		"services.svc.PORT":      "overlay",
+		"services.svc.VERSION":   "overlay",
+		"services.svc.NEW_FIELD": "overlay",
+	}, origins)
+}
+
+func TestConfigStore_Overlays(t *testing.T) {
+	tmpDir := t.TempDir()
+	filePath := filepath.Join(tmpDir, "config.yaml")
+	writeFile(t, filePath, `
+settings:
+  cron: "*/10 * * * *"
+environment:
+  DATA_PATH: /data
+services:
+  db:
+    PORT: "5432"
+  app:
+    PORT: "80"
+`)
+	writeFile(t, OverlayPath(filePath, "nas1"), `
+settings:
+  cron: "0 * * * *"
+environment:
+  DATA_PATH: /mnt/data
+services:
+  db:
+    PORT: "5433"
+  media:
+`)
+	writeFile(t, OverlayPath(filePath, "gpu"), `
+services:
+  db:
+    PORT: "6000"
+`)
+	store := NewConfigStore(filePath, "nas1", "missing", "gpu")
+
+	cfg, origins, err := store.GetResolved()
+
+	assert.NoError(t, err)
+	assert.Equal(t, "0 * * * *", cfg.Settings.Cron)
+	assert.Equal(t, models.Environment{"DATA_PATH": "/mnt/data"}, cfg.Environment)
+	assert.Equal(t, models.ServiceConfig{"PORT": "6000"}, cfg.Services["db"])
+	assert.Equal(t, models.ServiceConfig{"PORT": "80"}, cfg.Services["app"])
+	assert.Contains(t, cfg.Services, "media")
+	assert.Equal(t, "config.nas1.yaml", origins["settings.cron"])
+	assert.Equal(t, "config.yaml", origins["services.app.PORT"])
+	assert.Equal(t, "config.gpu.yaml", origins["services.db.PORT"])
+
+	base, err := store.GetBase()
+	assert.NoError(t, err)
+	assert.Equal(t, models.ServiceConfig{"PORT": "5432"}, base.Services["db"])
+
+	// updates are written to the base file, the callback receives the effective configs
+	var newCfg models.Config
+	store.SetOnChange(func(_, c models.Config) { newCfg = c })
+	base.Services["app"] = models.ServiceConfig{"PORT": "8080"}
+	assert.NoError(t, store.Update(base))
+	assert.Equal(t, models.ServiceConfig{"PORT": "8080"}, newCfg.Services["app"])
+	assert.Equal(t, models.ServiceConfig{"PORT": "6000"}, newCfg.Services["db"])
+	written, err := os.ReadFile(filePath)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(written), "6000")
+}
+
+func TestConfigStore_InvalidProfile(t *testing.T) {
+	store := NewConfigStore(filepath.Join(t.TempDir(), "config.yaml"), "../other")
+
+	_, err := store.Get()
+	assert.ErrorContains(t, err, "invalid profile")
+}
+
+func TestOverlayPath(t *testing.T) {
+	assert.Equal(t, "/data/config.nas1.yaml", OverlayPath("/data/config.yaml", "nas1"))
+	assert.Equal(t, "/data/config.nas1", OverlayPath("/data/config", "nas1"))
+}
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("write %s: %v", path, err)
+	}
+}
+
+func TestUpdateAs_Version(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "config.yaml")
+	assert.NoError(t, os.WriteFile(filePath, []byte("environment:\n  A: 1\n"), 0o600))
+	store := NewConfigStore(filePath)
+
+	cfg, version, err := store.GetBaseVersion()
+	assert.NoError(t, err)
+	assert.Equal(t, "1", cfg.Environment["A"])
+	assert.NotEmpty(t, version)
+
+	cfg.Environment["A"] = "2"
+	newVersion, err := store.UpdateAs(cfg, "admin", "configuration updated", version)
+	assert.NoError(t, err)
+	assert.NotEqual(t, version, newVersion)
+	_, currentVersion, err := store.GetBaseVersion()
+	assert.NoError(t, err)
+	assert.Equal(t, newVersion, currentVersion)
+
+	// an update from the first version would overwrite the second one
+	cfg.Environment["A"] = "3"
+	_, err = store.UpdateAs(cfg, "admin", "configuration updated", version)
+	assert.ErrorIs(t, err, ErrVersionConflict)
+	cfg, err = store.GetBase()
+	assert.NoError(t, err)
+	assert.Equal(t, "2", cfg.Environment["A"])
+}
+
+func TestUpdateAs_KeepsObfuscatedVariables(t *testing.T) {
+	store := NewConfigStore(filepath.Join(t.TempDir(), "config.yaml"))
+	assert.NoError(t, store.Update(models.Config{
+		Environment: models.Environment{"DB_PASSWORD": "plain"},
+		Services:    map[string]models.ServiceConfig{"web": {"API_KEY": "plain", "PORT": "80"}},
+	}))
+
+	assert.NoError(t, store.Update(models.Config{
+		Environment: models.Environment{"DB_PASSWORD": models.ObfuscatedValue, "NEW_SECRET": models.ObfuscatedValue},
+		Services:    map[string]models.ServiceConfig{"web": {"API_KEY": models.ObfuscatedValue, "PORT": models.ObfuscatedValue}},
+	}))
+
+	cfg, err := store.GetBase()
+	assert.NoError(t, err)
+	assert.Equal(t, models.Environment{"DB_PASSWORD": "plain", "NEW_SECRET": models.ObfuscatedValue}, cfg.Environment)
+	assert.Equal(t, models.ServiceConfig{"API_KEY": "plain", "PORT": models.ObfuscatedValue}, cfg.Services["web"],
+		"only the sensitive variables are restored")
+}
+
+func TestUpdateAs_Backup(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "config.yaml")
+	assert.NoError(t, os.WriteFile(filePath, []byte("# previous\nenvironment:\n  A: 1\n"), 0o640))
+	store := NewConfigStore(filePath)
+
+	assert.NoError(t, store.Update(models.Config{Environment: models.Environment{"A": "2"}}))
+
+	backup, err := os.ReadFile(BackupPath(filePath))
+	assert.NoError(t, err)
+	assert.Equal(t, "# previous\nenvironment:\n  A: 1\n", string(backup))
+	// the mode of the config file is kept
+	info, err := os.Stat(filePath)
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o640), info.Mode().Perm())
+}
@@ -0,0 +1,166 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+
+	"omar-kada/autonas/models"
+
+	"github.com/containrrr/shoutrrr"
+	"github.com/robfig/cron/v3"
+)
+
+var (
+	// serviceNameRegexp matches names usable as directory and compose project names
+	serviceNameRegexp = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_.-]*$`)
+	// envKeyNameRegexp matches variable names that can be interpolated in compose files
+	envKeyNameRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	// scpLikeRepoRegexp matches ssh repositories written as user@host:path
+	scpLikeRepoRegexp = regexp.MustCompile(`^[A-Za-z0-9_.-]+@[A-Za-z0-9_.-]+:.+$`)
+
+	repoSchemes = []string{"http", "https", "ssh", "git", "file"}
+)
+
+// ValidateConfig checks the configuration, the returned error is a *models.ValidationError
+// listing the invalid fields. When servicesDir exists, services must have a directory in it.
+func ValidateConfig(cfg models.Config, servicesDir string) error {
+	var fields []models.FieldError
+	invalid := func(field, format string, args ...any) {
+		fields = append(fields, models.FieldError{Field: field, Message: fmt.Sprintf(format, args...)})
+	}
+
+	settings := cfg.Settings
+	switch settings.Cron {
+	case "", "0", "1": // not scheduled, or run a single time
+	default:
+		if _, err := cron.ParseStandard(settings.Cron); err != nil {
+			invalid("settings.cron", "invalid cron expression : %v", err)
+		}
+	}
+	validateSchedules(settings, invalid)
+	if _, err := settings.GetRetention().GetMaxAge(); err != nil {
+		invalid("settings.retention.maxAge", "invalid duration : %v", err)
+	}
+	if settings.GetRetention().MaxCount < 0 {
+		invalid("settings.retention.maxCount", "must be positive")
+	}
+	validateWatchdog(settings.GetWatchdog(), invalid)
+	if settings.Repo != "" && !isValidRepoURL(settings.Repo) {
+		invalid("settings.repo", "expected a %v url, user@host:path or an absolute path", repoSchemes)
+	}
+	if settings.NotificationURL != "" && !models.IsObfuscated(settings.NotificationURL) {
+		if _, err := shoutrrr.CreateSender(settings.NotificationURL); err != nil {
+			invalid("settings.notificationURL", "invalid notification url : %v", err)
+		}
+	}
+	for i, eventType := range settings.NotificationTypes {
+		if !eventType.IsValid() {
+			invalid(fmt.Sprintf("settings.notificationTypes[%d]", i), "unknown event type %q", eventType)
+		}
+	}
+	if !settings.WriteBack.IsValid() {
+		invalid("settings.writeBack", "unknown write back mode %q, expected %q or %q",
+			settings.WriteBack, models.WriteBackCommit, models.WriteBackBranch)
+	} else if settings.WriteBack != "" && settings.Repo == "" {
+		invalid("settings.writeBack", "a repo is required to write back the configuration")
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(cfg.Environment)) {
+		if !envKeyNameRegexp.MatchString(key) {
+			invalid("environment."+key, "invalid variable name, expected letters, digits and _")
+		}
+	}
+
+	checkDirs := false
+	if info, err := os.Stat(servicesDir); servicesDir != "" && err == nil && info.IsDir() {
+		checkDirs = true
+	}
+	for _, service := range slices.Sorted(maps.Keys(cfg.Services)) {
+		path := "services." + service
+		if !serviceNameRegexp.MatchString(service) {
+			invalid(path, "invalid service name, expected letters, digits, ., _ and -")
+			continue
+		}
+		if checkDirs {
+			if _, err := os.Stat(filepath.Join(servicesDir, service)); errors.Is(err, os.ErrNotExist) {
+				invalid(path, "no directory %s in the services directory", service)
+			}
+		}
+		for _, key := range slices.Sorted(maps.Keys(cfg.Services[service])) {
+			if !envKeyNameRegexp.MatchString(key) {
+				invalid(path+"."+key, "invalid variable name, expected letters, digits and _")
+			}
+		}
+	}
+
+	if len(fields) > 0 {
+		return &models.ValidationError{Fields: fields}
+	}
+	return nil
+}
+
+func validateWatchdog(watchdog models.Watchdog, invalid func(field, format string, args ...any)) {
+	validatePolicy := func(path string, policy models.WatchdogPolicy) {
+		if policy.UnhealthyChecks < 0 {
+			invalid(path+".unhealthyChecks", "must be positive")
+		}
+		if _, err := policy.GetFirstBackoff(); err != nil {
+			invalid(path+".backoff", "invalid duration : %v", err)
+		}
+		if _, err := policy.GetMaxBackoff(); err != nil {
+			invalid(path+".maxBackoff", "invalid duration : %v", err)
+		}
+	}
+	validatePolicy("settings.watchdog", watchdog.WatchdogPolicy)
+	for _, stack := range slices.Sorted(maps.Keys(watchdog.Services)) {
+		validatePolicy("settings.watchdog.services."+stack, watchdog.Services[stack])
+	}
+}
+
+func validateSchedules(settings models.Settings, invalid func(field, format string, args ...any)) {
+	if _, err := settings.GetLocation(); err != nil {
+		invalid("settings.timezone", "unknown timezone : %v", err)
+	}
+	for _, name := range slices.Sorted(maps.Keys(settings.Schedules)) {
+		path := "settings.schedules." + string(name)
+		schedule := settings.Schedules[name]
+		if !name.IsValid() {
+			invalid(path, "unknown schedule, expected one of %v", models.ScheduleNames)
+			continue
+		}
+		if schedule.IsEnabled() && !(name == models.ScheduleSync && schedule.Cron == "1") {
+			if _, err := cron.ParseStandard(schedule.Cron); err != nil {
+				invalid(path+".cron", "invalid cron expression : %v", err)
+			}
+		}
+		if _, err := schedule.GetJitter(); err != nil {
+			invalid(path+".jitter", "invalid duration : %v", err)
+		}
+	}
+	for i, window := range settings.MaintenanceWindows {
+		path := fmt.Sprintf("settings.maintenanceWindows[%d]", i)
+		if _, err := cron.ParseStandard(window.Cron); err != nil {
+			invalid(path+".cron", "invalid cron expression : %v", err)
+		}
+		if _, err := window.GetDuration(); err != nil {
+			invalid(path+".duration", "invalid duration : %v", err)
+		}
+	}
+}
+
+func isValidRepoURL(repo string) bool {
+	if scpLikeRepoRegexp.MatchString(repo) || filepath.IsAbs(repo) {
+		return true
+	}
+	u, err := url.Parse(repo)
+	if err != nil || !slices.Contains(repoSchemes, u.Scheme) {
+		return false
+	}
+	return u.Scheme == "file" || u.Host != ""
+}
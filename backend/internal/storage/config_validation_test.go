@@ -0,0 +1,173 @@
+package storage
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"omar-kada/autonas/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateConfig_Valid(t *testing.T) {
+	servicesDir := t.TempDir()
+	assert.NoError(t, os.Mkdir(filepath.Join(servicesDir, "home-assistant"), 0o750))
+
+	for _, repo := range []string{"https://github.com/omar-kada/autonas-config", "git@github.com:omar-kada/config.git", "file:///srv/config", "/srv/config", ""} {
+		cfg := models.Config{
+			Settings: models.Settings{
+				Repo:              repo,
+				Cron:              "*/10 * * * *",
+				NotificationURL:   "generic://example.com/webhook",
+				NotificationTypes: []models.EventType{models.EventError, models.EventDeploymentSuccess},
+			},
+			Environment: models.Environment{"DATA_PATH": "/data", "_PRIVATE": "1"},
+			Services: map[string]models.ServiceConfig{
+				"home-assistant": {"PORT_1": "8123"},
+			},
+		}
+		assert.NoError(t, ValidateConfig(cfg, servicesDir), repo)
+	}
+}
+
+func TestValidateConfig_Invalid(t *testing.T) {
+	servicesDir := t.TempDir()
+	assert.NoError(t, os.Mkdir(filepath.Join(servicesDir, "db"), 0o750))
+
+	cfg := models.Config{
+		Settings: models.Settings{
+			Repo:              "ftp://example.com/repo",
+			Cron:              "every minute",
+			NotificationURL:   "unknown://example.com",
+			NotificationTypes: []models.EventType{models.EventError, "NOPE"},
+			WriteBack:         "push",
+		},
+		Environment: models.Environment{"1ST": "a", "WITH-DASH": "b"},
+		Services: map[string]models.ServiceConfig{
+			"db":        {"bad key": "1"},
+			"../escape": {},
+			"missing":   {},
+		},
+	}
+
+	err := ValidateConfig(cfg, servicesDir)
+
+	assert.ErrorIs(t, err, models.ErrInvalidConfig)
+	var validationErr *models.ValidationError
+	assert.True(t, errors.As(err, &validationErr))
+	var fields []string
+	for _, field := range validationErr.Fields {
+		fields = append(fields, field.Field)
+	}
+	assert.Equal(t, []string{
+		"settings.cron",
+		"settings.repo",
+		"settings.notificationURL",
+		"settings.notificationTypes[1]",
+		"settings.writeBack",
+		"environment.1ST",
+		"environment.WITH-DASH",
+		"services.../escape",
+		"services.db.bad key",
+		"services.missing",
+	}, fields)
+}
+
+func TestValidateConfig_SkipsMissingServicesDir(t *testing.T) {
+	cfg := models.Config{Services: map[string]models.ServiceConfig{"svc": {}}}
+
+	assert.NoError(t, ValidateConfig(cfg, filepath.Join(t.TempDir(), "not-fetched")))
+	assert.NoError(t, ValidateConfig(cfg, ""))
+}
+
+func TestValidateConfig_ObfuscatedNotificationURL(t *testing.T) {
+	cfg := models.Config{Settings: models.Settings{NotificationURL: models.Obfuscate("unknown://a-very-long-notification-url")}}
+
+	assert.NoError(t, ValidateConfig(cfg, ""))
+}
+
+func TestValidateConfig_WriteBack(t *testing.T) {
+	cfg := models.Config{Settings: models.Settings{Repo: "https://example.com/repo.git", WriteBack: models.WriteBackBranch}}
+	assert.NoError(t, ValidateConfig(cfg, ""))
+
+	cfg.Settings.Repo = ""
+	var validationErr *models.ValidationError
+	assert.True(t, errors.As(ValidateConfig(cfg, ""), &validationErr))
+	assert.Equal(t, "settings.writeBack", validationErr.Fields[0].Field)
+}
+
+func TestValidateConfig_Schedules(t *testing.T) {
+	cfg := models.Config{Settings: models.Settings{
+		Timezone: "Europe/Paris",
+		Schedules: map[models.ScheduleName]models.ScheduleSettings{
+			models.ScheduleSync:  {Cron: "1"},
+			models.SchedulePrune: {Cron: "@weekly", Jitter: "30m"},
+		},
+		MaintenanceWindows: []models.MaintenanceWindow{{Cron: "0 8 * * 1-5", Duration: "10h"}},
+	}}
+	assert.NoError(t, ValidateConfig(cfg, ""))
+
+	cfg.Settings = models.Settings{
+		Timezone: "Mars/Olympus",
+		Schedules: map[models.ScheduleName]models.ScheduleSettings{
+			"backup":                   {Cron: "@daily"},
+			models.ScheduleImageUpdate: {Cron: "1", Jitter: "soon"},
+		},
+		MaintenanceWindows: []models.MaintenanceWindow{{Cron: "0 8 * * *", Duration: "-1h"}},
+	}
+	var validationErr *models.ValidationError
+	assert.True(t, errors.As(ValidateConfig(cfg, ""), &validationErr))
+	var fields []string
+	for _, field := range validationErr.Fields {
+		fields = append(fields, field.Field)
+	}
+	assert.Equal(t, []string{
+		"settings.timezone",
+		"settings.schedules.backup",
+		"settings.schedules.imageUpdate.cron",
+		"settings.schedules.imageUpdate.jitter",
+		"settings.maintenanceWindows[0].duration",
+	}, fields)
+}
+
+func TestValidateConfig_Retention(t *testing.T) {
+	cfg := models.Config{Settings: models.Settings{
+		Retention: &models.Retention{MaxAge: "90d", MaxCount: 500, CompressDiffs: true},
+	}}
+	assert.NoError(t, ValidateConfig(cfg, ""))
+
+	cfg.Settings.Retention = &models.Retention{MaxAge: "3 months", MaxCount: -1}
+	var validationErr *models.ValidationError
+	assert.True(t, errors.As(ValidateConfig(cfg, ""), &validationErr))
+	assert.Len(t, validationErr.Fields, 2)
+	assert.Equal(t, "settings.retention.maxAge", validationErr.Fields[0].Field)
+	assert.Equal(t, "settings.retention.maxCount", validationErr.Fields[1].Field)
+}
+
+func TestValidateConfig_Watchdog(t *testing.T) {
+	cfg := models.Config{Settings: models.Settings{
+		Watchdog: &models.Watchdog{
+			WatchdogPolicy: models.WatchdogPolicy{Restart: true, UnhealthyChecks: 5, Backoff: "2m", MaxBackoff: "1h"},
+			Services:       map[string]models.WatchdogPolicy{"web": {Disabled: true}},
+		},
+	}}
+	assert.NoError(t, ValidateConfig(cfg, ""))
+
+	cfg.Settings.Watchdog = &models.Watchdog{
+		WatchdogPolicy: models.WatchdogPolicy{UnhealthyChecks: -1},
+		Services:       map[string]models.WatchdogPolicy{"web": {Backoff: "soon", MaxBackoff: "-1m"}},
+	}
+	var validationErr *models.ValidationError
+	assert.True(t, errors.As(ValidateConfig(cfg, ""), &validationErr))
+	var fields []string
+	for _, field := range validationErr.Fields {
+		fields = append(fields, field.Field)
+	}
+	assert.Equal(t, []string{
+		"settings.watchdog.unhealthyChecks",
+		"settings.watchdog.services.web.backoff",
+		"settings.watchdog.services.web.maxBackoff",
+	}, fields)
+}
@@ -0,0 +1,122 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"omar-kada/autonas/models"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// Watch reloads the configuration when its files are edited outside of AutoNAS,
+// until ctx is done. Valid edits fire the on change callback, onError is called
+// with invalid edits, which are ignored until they are fixed.
+func (s *configStore) Watch(ctx context.Context, onError func(err error)) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("couldn't create config watcher : %w", err)
+	}
+	defer watcher.Close()
+
+	// the directory is watched to follow files replaced by editors,
+	// and the files to follow edits of bind mounted files
+	if err := watcher.Add(filepath.Dir(s.configFilePath)); err != nil {
+		return fmt.Errorf("couldn't watch config directory : %w", err)
+	}
+	s.watchFiles(watcher)
+
+	s.mu.Lock()
+	if s.watched == nil {
+		s.watched, _ = s.resolve()
+	}
+	s.mu.Unlock()
+
+	// editors emit several events for a single save, the reload waits for the last one
+	var reload <-chan time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+			if slices.Contains(s.configFiles(), filepath.Clean(event.Name)) {
+				reload = time.After(s.watchDelay)
+			}
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			slog.Warn("config watcher error", "error", err)
+		case <-reload:
+			reload = nil
+			s.watchFiles(watcher)
+			s.reload(onError)
+		}
+	}
+}
+
+// reload reads the edited configuration and notifies the change
+func (s *configStore) reload(onError func(err error)) {
+	resolved, err := s.resolve()
+	if err != nil {
+		slog.Warn("ignoring invalid configuration edit", "error", err)
+		if onError != nil {
+			onError(fmt.Errorf("the configuration edit is ignored until it is fixed : %w", err))
+		}
+		return
+	}
+
+	if content, err := os.ReadFile(s.configFilePath); err == nil {
+		s.recordRevision(content, "", "edited on disk")
+	}
+
+	s.mu.Lock()
+	old := s.watched
+	changed := old == nil || !bytes.Equal(old.yaml, resolved.yaml)
+	s.watched = resolved
+	s.lastValid = resolved
+	s.mu.Unlock()
+
+	if !changed {
+		return
+	}
+	slog.Info("configuration file edited, reloading")
+	if s.OnConfigUpdate != nil {
+		var oldCfg models.Config
+		if old != nil {
+			oldCfg = old.cfg
+		}
+		s.OnConfigUpdate(oldCfg, resolved.cfg)
+	}
+}
+
+// watchFiles adds the existing config files to the watcher, files replaced by editors need to be added again
+func (s *configStore) watchFiles(watcher *fsnotify.Watcher) {
+	for _, file := range s.configFiles() {
+		if _, err := os.Stat(file); err == nil {
+			if err := watcher.Add(file); err != nil {
+				slog.Warn("couldn't watch config file", "file", file, "error", err)
+			}
+		}
+	}
+}
+
+// configFiles returns the config file and the overlays of the profiles
+func (s *configStore) configFiles() []string {
+	files := []string{filepath.Clean(s.configFilePath)}
+	for _, profile := range s.profiles {
+		if profile != "" {
+			files = append(files, filepath.Clean(OverlayPath(s.configFilePath, profile)))
+		}
+	}
+	return files
+}
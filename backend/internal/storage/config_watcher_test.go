@@ -0,0 +1,134 @@
+package storage
+
+import (
+	"context"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"omar-kada/autonas/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type watchRecorder struct {
+	mu      sync.Mutex
+	changes [][2]models.Config
+	errors  []error
+}
+
+func (r *watchRecorder) onChange(oldCfg, newCfg models.Config) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.changes = append(r.changes, [2]models.Config{oldCfg, newCfg})
+}
+
+func (r *watchRecorder) onError(err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.errors = append(r.errors, err)
+}
+
+func (r *watchRecorder) counts() (int, int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.changes), len(r.errors)
+}
+
+func startWatcher(t *testing.T, profiles ...string) (*configStore, string, *watchRecorder) {
+	t.Helper()
+	filePath := filepath.Join(t.TempDir(), "config.yaml")
+	writeFile(t, filePath, "environment:\n  HOST: old\n")
+	store := NewConfigStore(filePath, profiles...).(*configStore)
+	store.watchDelay = 20 * time.Millisecond
+
+	recorder := &watchRecorder{}
+	store.SetOnChange(recorder.onChange)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- store.Watch(ctx, recorder.onError) }()
+	t.Cleanup(func() {
+		cancel()
+		assert.NoError(t, <-done)
+	})
+	assert.Eventually(t, func() bool {
+		store.mu.Lock()
+		defer store.mu.Unlock()
+		return store.watched != nil
+	}, time.Second, 5*time.Millisecond)
+	return store, filePath, recorder
+}
+
+func TestWatch_ExternalEdit(t *testing.T) {
+	_, filePath, recorder := startWatcher(t)
+
+	writeFile(t, filePath, "environment:\n  HOST: new\n")
+
+	assert.Eventually(t, func() bool {
+		changes, _ := recorder.counts()
+		return changes == 1
+	}, 2*time.Second, 10*time.Millisecond)
+	assert.Equal(t, "old", recorder.changes[0][0].Environment["HOST"])
+	assert.Equal(t, "new", recorder.changes[0][1].Environment["HOST"])
+}
+
+func TestWatch_OverlayEdit(t *testing.T) {
+	_, filePath, recorder := startWatcher(t, "nas1")
+
+	writeFile(t, OverlayPath(filePath, "nas1"), "environment:\n  HOST: nas1\n")
+
+	assert.Eventually(t, func() bool {
+		changes, _ := recorder.counts()
+		return changes == 1
+	}, 2*time.Second, 10*time.Millisecond)
+	assert.Equal(t, "nas1", recorder.changes[0][1].Environment["HOST"])
+}
+
+func TestWatch_InvalidEditKeepsLastValidConfig(t *testing.T) {
+	store, filePath, recorder := startWatcher(t)
+	_, err := store.Get()
+	assert.NoError(t, err)
+
+	writeFile(t, filePath, "settings:\n  cron: not a cron\n")
+
+	assert.Eventually(t, func() bool {
+		_, errors := recorder.counts()
+		return errors == 1
+	}, 2*time.Second, 10*time.Millisecond)
+	assert.ErrorIs(t, recorder.errors[0], models.ErrInvalidConfig)
+	changes, _ := recorder.counts()
+	assert.Equal(t, 0, changes)
+	cfg, err := store.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, "old", cfg.Environment["HOST"])
+
+	// fixing the file notifies the change from the last valid config
+	writeFile(t, filePath, "environment:\n  HOST: fixed\n")
+	assert.Eventually(t, func() bool {
+		changes, _ := recorder.counts()
+		return changes == 1
+	}, 2*time.Second, 10*time.Millisecond)
+	assert.Equal(t, "old", recorder.changes[0][0].Environment["HOST"])
+	assert.Equal(t, "fixed", recorder.changes[0][1].Environment["HOST"])
+}
+
+func TestWatch_UpdateNotifiedOnce(t *testing.T) {
+	store, _, recorder := startWatcher(t)
+
+	err := store.Update(models.Config{Environment: models.Environment{"HOST": "api"}})
+	assert.NoError(t, err)
+
+	time.Sleep(10 * store.watchDelay)
+	changes, errors := recorder.counts()
+	assert.Equal(t, 1, changes)
+	assert.Equal(t, 0, errors)
+}
+
+func TestUpdate_InvalidCron(t *testing.T) {
+	store := NewConfigStore(filepath.Join(t.TempDir(), "config.yaml"))
+
+	err := store.Update(models.Config{Settings: models.Settings{Cron: "every minute"}})
+
+	assert.ErrorIs(t, err, models.ErrInvalidConfig)
+}
@@ -0,0 +1,44 @@
+package storage
+
+import (
+	"omar-kada/autonas/models"
+
+	"gorm.io/gorm"
+)
+
+// DeploymentLogStorage stores the output of the commands run by the deployments
+type DeploymentLogStorage interface {
+	StoreLog(log models.DeploymentLog) error
+	GetLogs(deploymentID uint64, c Cursor[uint64]) ([]models.DeploymentLog, error)
+}
+
+type gormDeploymentLogStorage struct {
+	db *gorm.DB
+}
+
+// NewDeploymentLogStorage creates a storage for deployment logs using gorm
+func NewDeploymentLogStorage(db *gorm.DB) (DeploymentLogStorage, error) {
+	if err := checkTables(db, &models.DeploymentLog{}); err != nil {
+		return nil, err
+	}
+	return &gormDeploymentLogStorage{db: db}, nil
+}
+
+// StoreLog stores a line of a deployment, the deployment must exist
+func (s *gormDeploymentLogStorage) StoreLog(log models.DeploymentLog) error {
+	var dep models.Deployment
+	if err := s.db.Select("id").First(&dep, log.DeploymentID).Error; err != nil {
+		return err
+	}
+	return s.db.Create(&log).Error
+}
+
+// GetLogs retrieves a page of the logs of a deployment, the latest first
+func (s *gormDeploymentLogStorage) GetLogs(deploymentID uint64, c Cursor[uint64]) ([]models.DeploymentLog, error) {
+	var logs []models.DeploymentLog
+	if err := s.db.Scopes(Paginate(c)).Order("id desc").
+		Where("deployment_id = ?", deploymentID).Find(&logs).Error; err != nil {
+		return nil, err
+	}
+	return logs, nil
+}
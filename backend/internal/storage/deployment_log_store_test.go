@@ -0,0 +1,53 @@
+package storage
+
+import (
+	"fmt"
+	"testing"
+
+	"omar-kada/autonas/models"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func setupDeploymentLogStorage(t *testing.T, db *gorm.DB) (DeploymentLogStorage, models.Deployment) {
+	newTestEventStorage(t, db)
+	store, err := NewDeploymentLogStorage(db)
+	if err != nil {
+		t.Fatalf("new storage: %v", err)
+	}
+	return store, initDeployment(t, db, "title")
+}
+
+func TestStoreLogAndGetLogs(t *testing.T) {
+	forEachDialect(t, func(t *testing.T, db *gorm.DB) {
+		s, dep := setupDeploymentLogStorage(t, db)
+		for i := range 5 {
+			assert.NoError(t, s.StoreLog(models.DeploymentLog{
+				DeploymentID: dep.ID, Service: "web", Stream: models.LogStreamStderr, Line: fmt.Sprintf("line %d", i),
+			}))
+		}
+
+		page, err := s.GetLogs(dep.ID, NewIDCursor(3, 0))
+		assert.NoError(t, err)
+		assert.Len(t, page, 3)
+		assert.Equal(t, "line 4", page[0].Line)
+		assert.Equal(t, models.LogStreamStderr, page[0].Stream)
+
+		page, err = s.GetLogs(dep.ID, NewIDCursor(3, page[2].ID))
+		assert.NoError(t, err)
+		assert.Len(t, page, 2)
+		assert.Equal(t, "line 0", page[1].Line)
+
+		page, err = s.GetLogs(dep.ID+1, NewIDCursor(3, 0))
+		assert.NoError(t, err)
+		assert.Empty(t, page)
+	})
+}
+
+func TestStoreLog_NoDeployment(t *testing.T) {
+	forEachDialect(t, func(t *testing.T, db *gorm.DB) {
+		s, dep := setupDeploymentLogStorage(t, db)
+		assert.Error(t, s.StoreLog(models.DeploymentLog{DeploymentID: dep.ID + 1, Line: "lost"}))
+	})
+}
@@ -0,0 +1,209 @@
+package storage
+
+import (
+	"database/sql"
+	"maps"
+	"math"
+	"slices"
+	"time"
+
+	"omar-kada/autonas/models"
+
+	"gorm.io/gorm"
+)
+
+var finishedStatuses = []models.DeploymentStatus{models.DeploymentStatusSuccess, models.DeploymentStatusError}
+
+// GetStats aggregates the deployments started between from and to
+func (s *gormDeploymentStorage) GetStats(from, to time.Time) (models.DeploymentStats, error) {
+	var stats models.DeploymentStats
+	var counts []struct {
+		Status models.DeploymentStatus
+		Count  int32
+	}
+	if err := s.window(from, to).Select("status, COUNT(*) AS count").Group("status").Scan(&counts).Error; err != nil {
+		return stats, err
+	}
+	for _, count := range counts {
+		switch count.Status {
+		case models.DeploymentStatusSuccess:
+			stats.Success = count.Count
+		case models.DeploymentStatusError:
+			stats.Error = count.Count
+		}
+	}
+
+	var err error
+	if stats.MeanDuration, stats.P95Duration, err = s.getDurations(from, to, stats.Success+stats.Error); err != nil {
+		return stats, err
+	}
+	if stats.MeanTimeToRecovery, err = s.getMeanTimeToRecovery(from, to); err != nil {
+		return stats, err
+	}
+	if stats.Services, err = s.getServiceStats(from, to); err != nil {
+		return stats, err
+	}
+	return stats, nil
+}
+
+// GetDailyStats counts the finished deployments of each day (UTC) between from and to,
+// days without deployments are included
+func (s *gormDeploymentStorage) GetDailyStats(from, to time.Time) ([]models.DailyStats, error) {
+	var rows []struct {
+		Day    string
+		Status models.DeploymentStatus
+		Count  int32
+	}
+	if err := s.finished(from, to).
+		Select(dayExpr(s.db) + " AS day, status, COUNT(*) AS count").
+		Group("day, status").Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	perDay := make(map[string]*models.DailyStats)
+	var days []models.DailyStats
+	for day := truncateDay(from); day.Before(to); day = day.AddDate(0, 0, 1) {
+		days = append(days, models.DailyStats{Day: day})
+	}
+	for i := range days {
+		perDay[days[i].Day.Format(time.DateOnly)] = &days[i]
+	}
+	for _, row := range rows {
+		day, ok := perDay[row.Day]
+		if !ok {
+			continue
+		}
+		switch row.Status {
+		case models.DeploymentStatusSuccess:
+			day.Success = row.Count
+		case models.DeploymentStatusError:
+			day.Error = row.Count
+		}
+	}
+	return days, nil
+}
+
+// getDurations returns the mean and the 95th percentile of the durations of the finished deployments
+func (s *gormDeploymentStorage) getDurations(from, to time.Time, count int32) (mean, p95 time.Duration, err error) {
+	if count == 0 {
+		return 0, 0, nil
+	}
+	duration := durationExpr(s.db)
+	var avg sql.NullFloat64
+	if err := s.finished(from, to).Select("AVG(" + duration + ")").Row().Scan(&avg); err != nil {
+		return 0, 0, err
+	}
+	var percentile sql.NullFloat64
+	offset := int(math.Ceil(0.95*float64(count))) - 1
+	if err := s.finished(from, to).Select(duration + " AS duration").
+		Order("duration").Offset(offset).Limit(1).Row().Scan(&percentile); err != nil && err != sql.ErrNoRows {
+		return 0, 0, err
+	}
+	return seconds(avg.Float64), seconds(percentile.Float64), nil
+}
+
+// getMeanTimeToRecovery measures, for each series of failed deployments, the time between
+// the end of the first one and the end of the next successful deployment
+func (s *gormDeploymentStorage) getMeanTimeToRecovery(from, to time.Time) (time.Duration, error) {
+	var deps []models.Deployment
+	if err := s.finished(from, to).Select("status, end_time").Order("deployments.time").Find(&deps).Error; err != nil {
+		return 0, err
+	}
+	var failedAt time.Time
+	var total time.Duration
+	recoveries := 0
+	for _, dep := range deps {
+		switch {
+		case dep.Status == models.DeploymentStatusError && failedAt.IsZero():
+			failedAt = dep.EndTime
+		case dep.Status == models.DeploymentStatusSuccess && !failedAt.IsZero():
+			total += dep.EndTime.Sub(failedAt)
+			recoveries++
+			failedAt = time.Time{}
+		}
+	}
+	if recoveries == 0 {
+		return 0, nil
+	}
+	return total / time.Duration(recoveries), nil
+}
+
+// getServiceStats counts the finished deployments per service, a deployment is attributed
+// to the services whose files it changed
+func (s *gormDeploymentStorage) getServiceStats(from, to time.Time) ([]models.ServiceStats, error) {
+	var rows []struct {
+		ID      uint64
+		Status  models.DeploymentStatus
+		NewFile string
+		OldFile string
+	}
+	if err := s.finished(from, to).
+		Select("DISTINCT deployments.id, deployments.status, file_diffs.new_file, file_diffs.old_file").
+		Joins("JOIN file_diffs ON file_diffs.deployment_id = deployments.id").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	type deploymentService struct {
+		id      uint64
+		service string
+	}
+	counted := make(map[deploymentService]bool)
+	perService := make(map[string]*models.ServiceStats)
+	for _, row := range rows {
+		for _, file := range []string{row.NewFile, row.OldFile} {
+			service := models.ServiceOfFile(file)
+			key := deploymentService{row.ID, service}
+			if service == "" || counted[key] {
+				continue
+			}
+			counted[key] = true
+			stats, ok := perService[service]
+			if !ok {
+				stats = &models.ServiceStats{Service: service}
+				perService[service] = stats
+			}
+			stats.Deployments++
+			if row.Status == models.DeploymentStatusError {
+				stats.Errors++
+			}
+		}
+	}
+	res := make([]models.ServiceStats, 0, len(perService))
+	for _, service := range slices.Sorted(maps.Keys(perService)) {
+		res = append(res, *perService[service])
+	}
+	return res, nil
+}
+
+func (s *gormDeploymentStorage) window(from, to time.Time) *gorm.DB {
+	// sqlite compares the times as text, they are stored in local time
+	return s.db.Model(&models.Deployment{}).Where("deployments.time >= ? AND deployments.time < ?", from.Local(), to.Local())
+}
+
+func (s *gormDeploymentStorage) finished(from, to time.Time) *gorm.DB {
+	return s.window(from, to).Where("deployments.status IN ?", finishedStatuses)
+}
+
+// durationExpr returns the SQL expression of the duration of a deployment in seconds
+func durationExpr(db *gorm.DB) string {
+	if IsPostgres(db) {
+		return "EXTRACT(EPOCH FROM (deployments.end_time - deployments.time))"
+	}
+	return "(julianday(deployments.end_time) - julianday(deployments.time)) * 86400"
+}
+
+// dayExpr returns the SQL expression of the day (YYYY-MM-DD, UTC) a deployment finished
+func dayExpr(db *gorm.DB) string {
+	if IsPostgres(db) {
+		return "to_char(deployments.end_time AT TIME ZONE 'UTC', 'YYYY-MM-DD')"
+	}
+	return "date(deployments.end_time)"
+}
+
+func truncateDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func seconds(s float64) time.Duration {
+	return time.Duration(s * float64(time.Second)).Round(time.Millisecond)
+}
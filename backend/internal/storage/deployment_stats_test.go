@@ -0,0 +1,78 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"omar-kada/autonas/models"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func createDeployment(t *testing.T, db *gorm.DB, start time.Time, duration time.Duration,
+	status models.DeploymentStatus, files ...string,
+) {
+	dep := models.Deployment{Time: start, EndTime: start.Add(duration), Status: status}
+	for _, file := range files {
+		dep.Files = append(dep.Files, models.FileDiff{OldFile: file, NewFile: file})
+	}
+	if status == models.DeploymentStatusRunning {
+		dep.EndTime = time.Time{}
+	}
+	assert.NoError(t, db.Create(&dep).Error)
+}
+
+func TestGetStats(t *testing.T) {
+	s, db := setupDeploymentStorage(t)
+	day := time.Date(2025, 3, 10, 10, 0, 0, 0, time.UTC)
+
+	// outside of the window
+	createDeployment(t, db, day.AddDate(0, 0, -10), time.Hour, models.DeploymentStatusError, "services/web/compose.yaml")
+
+	createDeployment(t, db, day, 10*time.Second, models.DeploymentStatusSuccess, "services/web/compose.yaml", "services/web/.env")
+	createDeployment(t, db, day.Add(time.Hour), 20*time.Second, models.DeploymentStatusError, "services/db/compose.yaml")
+	createDeployment(t, db, day.Add(2*time.Hour), 30*time.Second, models.DeploymentStatusError, "services/db/compose.yaml", "README.md")
+	createDeployment(t, db, day.Add(3*time.Hour), 40*time.Second, models.DeploymentStatusSuccess, "services/db/compose.yaml", "services/web/compose.yaml")
+	createDeployment(t, db, day.Add(4*time.Hour), 0, models.DeploymentStatusRunning)
+
+	stats, err := s.GetStats(day.AddDate(0, 0, -1), day.AddDate(0, 0, 1))
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), stats.Success)
+	assert.Equal(t, int32(2), stats.Error)
+	assert.Equal(t, 25*time.Second, stats.MeanDuration)
+	assert.Equal(t, 40*time.Second, stats.P95Duration)
+	// from the end of the first failure to the end of the next success
+	assert.Equal(t, 2*time.Hour+20*time.Second, stats.MeanTimeToRecovery)
+	assert.Equal(t, []models.ServiceStats{
+		{Service: "db", Deployments: 3, Errors: 2},
+		{Service: "web", Deployments: 2, Errors: 0},
+	}, stats.Services)
+}
+
+func TestGetStats_Empty(t *testing.T) {
+	s, _ := setupDeploymentStorage(t)
+
+	stats, err := s.GetStats(time.Now().AddDate(0, 0, -7), time.Now())
+	assert.NoError(t, err)
+	assert.Equal(t, models.DeploymentStats{Services: []models.ServiceStats{}}, stats)
+}
+
+func TestGetDailyStats(t *testing.T) {
+	s, db := setupDeploymentStorage(t)
+	day := time.Date(2025, 3, 10, 10, 0, 0, 0, time.UTC)
+
+	createDeployment(t, db, day, time.Minute, models.DeploymentStatusSuccess)
+	createDeployment(t, db, day.Add(time.Hour), time.Minute, models.DeploymentStatusSuccess)
+	createDeployment(t, db, day.AddDate(0, 0, 2), time.Minute, models.DeploymentStatusError)
+
+	days, err := s.GetDailyStats(day.AddDate(0, 0, -1), day.AddDate(0, 0, 3))
+	assert.NoError(t, err)
+	assert.Equal(t, []models.DailyStats{
+		{Day: time.Date(2025, 3, 9, 0, 0, 0, 0, time.UTC)},
+		{Day: time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC), Success: 2},
+		{Day: time.Date(2025, 3, 11, 0, 0, 0, 0, time.UTC)},
+		{Day: time.Date(2025, 3, 12, 0, 0, 0, 0, time.UTC), Error: 1},
+		{Day: time.Date(2025, 3, 13, 0, 0, 0, 0, time.UTC)},
+	}, days)
+}
@@ -21,12 +21,14 @@ type DeploymentStorage interface {
 	InitDeployment(title string, author string, diff string, files []models.FileDiff) (models.Deployment, error)
 	EndDeployment(deploymentID uint64, status models.DeploymentStatus) error
 	GetLastDeployment() (models.Deployment, error)
+	GetLastDeploymentWithStatus(status models.DeploymentStatus) (models.Deployment, error)
+	GetStats(from, to time.Time) (models.DeploymentStats, error)
+	GetDailyStats(from, to time.Time) ([]models.DailyStats, error)
 }
 
 // NewDeploymentStorage creates a storage for deployments using gorm
 func NewDeploymentStorage(db *gorm.DB) (DeploymentStorage, error) {
-	// Auto-migrate models types
-	if err := db.AutoMigrate(&models.Deployment{}, &models.FileDiff{}); err != nil {
+	if err := checkTables(db, &models.Deployment{}, &models.FileDiff{}); err != nil {
 		return nil, err
 	}
 	return &gormDeploymentStorage{db: db}, nil
@@ -39,6 +41,11 @@ func (s *gormDeploymentStorage) GetDeployments(c Cursor[uint64]) ([]models.Deplo
 		Scopes(Paginate(c)).Order("Time desc").Find(&deps).Error; err != nil {
 		return nil, err
 	}
+	for i := range deps {
+		if err := decompressDiffs(&deps[i]); err != nil {
+			return nil, err
+		}
+	}
 	return deps, nil
 }
 
@@ -51,6 +58,9 @@ func (s *gormDeploymentStorage) GetDeployment(id uint64) (models.Deployment, err
 		}
 		return models.Deployment{}, err
 	}
+	if err := decompressDiffs(&dep); err != nil {
+		return models.Deployment{}, err
+	}
 	return dep, nil
 }
 
@@ -89,5 +99,18 @@ func (s *gormDeploymentStorage) GetLastDeployment() (models.Deployment, error) {
 	if err := req.First(&dep).Error; err != nil {
 		return models.Deployment{}, err
 	}
+	if err := decompressDiffs(&dep); err != nil {
+		return models.Deployment{}, err
+	}
+	return dep, nil
+}
+
+// GetLastDeploymentWithStatus returns the most recent deployment that ended with status,
+// without its files and events
+func (s *gormDeploymentStorage) GetLastDeploymentWithStatus(status models.DeploymentStatus) (models.Deployment, error) {
+	var dep models.Deployment
+	if err := s.db.Where("status = ?", status).Order("time DESC").First(&dep).Error; err != nil {
+		return models.Deployment{}, err
+	}
 	return dep, nil
 }
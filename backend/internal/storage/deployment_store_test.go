@@ -79,6 +79,30 @@ func TestGetLastAndGetDeploymentsOrdering(t *testing.T) {
 	assert.Equal(t, dep2.ID, deps[1].ID)
 }
 
+func TestGetLastDeploymentWithStatus(t *testing.T) {
+	s, _ := setupDeploymentStorage(t)
+
+	_, err := s.GetLastDeploymentWithStatus(models.DeploymentStatusSuccess)
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+
+	dep1, _ := s.InitDeployment("title1", "author1", "diff1", nil)
+	assert.NoError(t, s.EndDeployment(dep1.ID, models.DeploymentStatusSuccess))
+	time.Sleep(2 * time.Millisecond)
+	dep2, _ := s.InitDeployment("title2", "author2", "diff2", nil)
+	assert.NoError(t, s.EndDeployment(dep2.ID, models.DeploymentStatusError))
+	time.Sleep(2 * time.Millisecond)
+	_, _ = s.InitDeployment("title3", "author3", "diff3", nil)
+
+	last, err := s.GetLastDeploymentWithStatus(models.DeploymentStatusSuccess)
+	assert.NoError(t, err)
+	assert.Equal(t, dep1.ID, last.ID)
+	assert.False(t, last.EndTime.IsZero())
+
+	last, err = s.GetLastDeploymentWithStatus(models.DeploymentStatusError)
+	assert.NoError(t, err)
+	assert.Equal(t, dep2.ID, last.ID)
+}
+
 func TestEndDeploymentAndErrorCases(t *testing.T) {
 	s, _ := setupDeploymentStorage(t)
 	dep, err := s.InitDeployment("title1", "author1", "diff1", nil)
@@ -16,25 +16,33 @@ type EventStorage interface {
 	StoreEvent(event models.Event) error
 	GetEvents(objectID uint64) ([]models.Event, error)
 	GetNotifications(c Cursor[uint64]) ([]models.Event, error)
+	GetEventsAfter(afterID uint64, types []models.EventType, limit int) ([]models.Event, error)
+	LastEventID() (uint64, error)
 }
 
 // NewEventStorage creates a storage for events using gorm
 func NewEventStorage(db *gorm.DB) (EventStorage, error) {
-	// Auto-migrate models types
-	if err := db.AutoMigrate(&models.Event{}); err != nil {
+	if err := checkTables(db, &models.Event{}); err != nil {
 		return nil, err
 	}
 	return &gormEventStorage{db: db}, nil
 }
 
-// StoreEvent creates a new event and associates it with an existing deployment
+// StoreEvent creates a new event and associates it with an existing deployment, events
+// without ObjectID aren't related to a deployment
 func (s *gormEventStorage) StoreEvent(event models.Event) error {
-	// verify deployment exists
-	var dep models.Deployment
-	if err := s.db.First(&dep, event.ObjectID).Error; err != nil {
-		return err
+	db := s.db
+	if event.ObjectID == 0 {
+		// stored as NULL to satisfy the foreign key
+		db = db.Omit("ObjectID")
+	} else {
+		// verify deployment exists
+		var dep models.Deployment
+		if err := s.db.First(&dep, event.ObjectID).Error; err != nil {
+			return err
+		}
 	}
-	if err := s.db.Create(&event).Error; err != nil {
+	if err := db.Create(&event).Error; err != nil {
 		return err
 	}
 	return nil
@@ -58,3 +66,22 @@ func (s *gormEventStorage) GetNotifications(c Cursor[uint64]) ([]models.Event, e
 	}
 	return notifs, nil
 }
+
+// GetEventsAfter retrieves the events stored after afterID, oldest first, that have one of
+// the types or are notifications
+func (s *gormEventStorage) GetEventsAfter(afterID uint64, types []models.EventType, limit int) ([]models.Event, error) {
+	var events []models.Event
+	if err := s.db.
+		Where("id > ?", afterID).Where(s.db.Where("type IN ?", types).Or("is_notification = true")).
+		Order("id").Limit(limit).Find(&events).Error; err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// LastEventID returns the ID of the last stored event, 0 when there are none
+func (s *gormEventStorage) LastEventID() (uint64, error) {
+	var id uint64
+	err := s.db.Model(&models.Event{}).Select("COALESCE(MAX(id), 0)").Row().Scan(&id)
+	return id, err
+}
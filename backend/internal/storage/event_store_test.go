@@ -15,11 +15,15 @@ func setupEventStorage(t *testing.T) (EventStorage, *gorm.DB) {
 	if err != nil {
 		t.Fatalf("new db: %v", err)
 	}
+	return newTestEventStorage(t, db), db
+}
+
+func newTestEventStorage(t *testing.T, db *gorm.DB) EventStorage {
 	eventStore, err := NewEventStorage(db)
 	if err != nil {
 		t.Fatalf("new storage: %v", err)
 	}
-	return eventStore, db
+	return eventStore
 }
 
 func TestEventStorage_Migrates(t *testing.T) {
@@ -44,23 +48,96 @@ func initDeployment(t *testing.T, db *gorm.DB, title string) models.Deployment {
 }
 
 func TestStoreEventAndGetEvents(t *testing.T) {
-	s, db := setupEventStorage(t)
-	dep := initDeployment(t, db, "title1")
-
-	ev := models.Event{Type: models.EventMisc, Msg: "ok", ObjectID: dep.ID}
-	assert.NoError(t, s.StoreEvent(ev))
-	events, err := s.GetEvents(dep.ID)
-	assert.NoError(t, err)
-	assert.Len(t, events, 1)
-	assert.Equal(t, "ok", events[0].Msg)
+	forEachDialect(t, func(t *testing.T, db *gorm.DB) {
+		s := newTestEventStorage(t, db)
+		dep := initDeployment(t, db, "title1")
+
+		ev := models.Event{Type: models.EventMisc, Msg: "ok", ObjectID: dep.ID}
+		assert.NoError(t, s.StoreEvent(ev))
+		events, err := s.GetEvents(dep.ID)
+		assert.NoError(t, err)
+		assert.Len(t, events, 1)
+		assert.Equal(t, "ok", events[0].Msg)
+	})
 }
 
 func TestStoreEvent_NoDeployment(t *testing.T) {
-	s, _ := setupEventStorage(t)
+	forEachDialect(t, func(t *testing.T, db *gorm.DB) {
+		s := newTestEventStorage(t, db)
+
+		ev := models.Event{Type: models.EventMisc, Msg: "ok", ObjectID: 1}
+		assert.Error(t, s.StoreEvent(ev))
+		events, err := s.GetEvents(1)
+		assert.NoError(t, err)
+		assert.Len(t, events, 0)
+	})
+}
+
+func TestGetNotifications(t *testing.T) {
+	forEachDialect(t, func(t *testing.T, db *gorm.DB) {
+		s := newTestEventStorage(t, db)
+		dep := initDeployment(t, db, "title1")
+
+		now := time.Now()
+		for i, event := range []models.Event{
+			{Type: models.EventConfigurationUpdated, IsNotification: true},
+			{Type: models.EventMisc, ObjectID: dep.ID},
+			{Type: models.EventDeploymentError, ObjectID: dep.ID, IsNotification: true},
+			{Type: models.EventStackUnhealthy, ObjectName: "web", IsNotification: true},
+		} {
+			event.Time = now.Add(time.Duration(i) * time.Second)
+			assert.NoError(t, s.StoreEvent(event))
+		}
+
+		notifs, err := s.GetNotifications(NewIDCursor(2, 0))
+		assert.NoError(t, err)
+		if assert.Len(t, notifs, 2) {
+			assert.Equal(t, models.EventStackUnhealthy, notifs[0].Type)
+			assert.Equal(t, models.EventDeploymentError, notifs[1].Type)
+		}
+
+		notifs, err = s.GetNotifications(NewIDCursor(2, notifs[len(notifs)-1].ID))
+		assert.NoError(t, err)
+		if assert.Len(t, notifs, 1) {
+			assert.Equal(t, models.EventConfigurationUpdated, notifs[0].Type)
+			assert.Zero(t, notifs[0].ObjectID)
+		}
+	})
+}
+
+func TestGetEventsAfter(t *testing.T) {
+	forEachDialect(t, func(t *testing.T, db *gorm.DB) {
+		s := newTestEventStorage(t, db)
+		dep := initDeployment(t, db, "title1")
+
+		id, err := s.LastEventID()
+		assert.NoError(t, err)
+		assert.Zero(t, id)
+
+		for _, event := range []models.Event{
+			{Type: models.EventDeploymentStarted, ObjectID: dep.ID},
+			{Type: models.EventMisc, Msg: "skipped", ObjectID: dep.ID},
+			{Type: models.EventMisc, Msg: "notified", ObjectID: dep.ID, IsNotification: true},
+			{Type: models.EventStackStatusChanged, ObjectName: "web"},
+			{Type: models.EventDeploymentSuccess, ObjectID: dep.ID},
+		} {
+			assert.NoError(t, s.StoreEvent(event))
+		}
+		types := []models.EventType{models.EventDeploymentStarted, models.EventDeploymentSuccess, models.EventStackStatusChanged}
+
+		events, err := s.GetEventsAfter(0, types, 2)
+		assert.NoError(t, err)
+		assert.Len(t, events, 2)
+		assert.Equal(t, models.EventDeploymentStarted, events[0].Type)
+		assert.Equal(t, "notified", events[1].Msg)
+
+		events, err = s.GetEventsAfter(events[1].ID, types, 10)
+		assert.NoError(t, err)
+		assert.Len(t, events, 2)
+		assert.Equal(t, models.EventStackStatusChanged, events[0].Type)
 
-	ev := models.Event{Type: models.EventMisc, Msg: "ok", ObjectID: 1}
-	assert.Error(t, s.StoreEvent(ev))
-	events, err := s.GetEvents(1)
-	assert.NoError(t, err)
-	assert.Len(t, events, 0)
+		id, err = s.LastEventID()
+		assert.NoError(t, err)
+		assert.Equal(t, events[1].ID, id)
+	})
 }
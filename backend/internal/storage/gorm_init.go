@@ -8,15 +8,26 @@ import (
 	"path/filepath"
 	"time"
 
-	"omar-kada/autonas/models"
-
+	"gorm.io/driver/postgres"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
 )
 
-// NewGormDb creates a new instance of gorm database and runs migrations
+// NewGormDb creates a new instance of gorm database and applies the pending migrations,
+// it fails when the database schema is newer than the supported one
 func NewGormDb(dbFile string, addPerm os.FileMode) (*gorm.DB, error) {
+	return migrated(OpenGormDb(dbFile, addPerm))
+}
+
+// NewPostgresDb creates a new instance of gorm database connected to a postgres server, and
+// applies the pending migrations
+func NewPostgresDb(dsn string) (*gorm.DB, error) {
+	return migrated(OpenPostgresDb(dsn))
+}
+
+// OpenGormDb creates a new instance of gorm database without migrating it
+func OpenGormDb(dbFile string, addPerm os.FileMode) (*gorm.DB, error) {
 	if dbFile != ":memory:" {
 		if _, err := os.Stat(dbFile); os.IsNotExist(err) {
 			if err := os.MkdirAll(filepath.Dir(dbFile), 0o700|addPerm); err != nil {
@@ -26,17 +37,8 @@ func NewGormDb(dbFile string, addPerm os.FileMode) (*gorm.DB, error) {
 			return nil, err
 		}
 	}
-	newLogger := logger.New(
-		log.New(os.Stdout, "\r\n", log.LstdFlags), // io writer
-		logger.Config{
-			SlowThreshold: time.Second, // Slow SQL threshold
-			LogLevel:      logger.Warn, // Log level
-			Colorful:      true,        // Enable color
-		},
-	)
-
 	db, err := gorm.Open(sqlite.Open(dbFile), &gorm.Config{
-		Logger: newLogger,
+		Logger: newLogger(),
 	})
 	if err != nil {
 		return nil, err
@@ -50,10 +52,43 @@ func NewGormDb(dbFile string, addPerm os.FileMode) (*gorm.DB, error) {
 	}
 	sqlDB.SetMaxOpenConns(1)
 	sqlDB.SetMaxIdleConns(1)
+	return db, nil
+}
 
-	// Auto-migrate models types
-	if err := db.AutoMigrate(&models.Deployment{}, &models.FileDiff{}, &models.Event{}, &models.User{}); err != nil {
+// OpenPostgresDb creates a new instance of gorm database connected to a postgres server
+// without migrating it, dsn is a postgres url or a key=value connection string
+func OpenPostgresDb(dsn string) (*gorm.DB, error) {
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{
+		Logger: newLogger(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("couldn't connect to postgres %w", err)
+	}
+	return db, nil
+}
+
+// IsPostgres checks if db is connected to a postgres server
+func IsPostgres(db *gorm.DB) bool {
+	return db.Dialector.Name() == "postgres"
+}
+
+func migrated(db *gorm.DB, err error) (*gorm.DB, error) {
+	if err != nil {
+		return nil, err
+	}
+	if err := NewMigrator(db).Up(); err != nil {
 		return nil, err
 	}
 	return db, nil
 }
+
+func newLogger() logger.Interface {
+	return logger.New(
+		log.New(os.Stdout, "\r\n", log.LstdFlags), // io writer
+		logger.Config{
+			SlowThreshold: time.Second, // Slow SQL threshold
+			LogLevel:      logger.Warn, // Log level
+			Colorful:      true,        // Enable color
+		},
+	)
+}
@@ -0,0 +1,129 @@
+package storage
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// migrations lists the steps of the database schema in order
+var migrations = []Migration{
+	{
+		Version:     1,
+		Description: "initial schema",
+		// the tables match the ones created by AutoMigrate, the databases created before
+		// the migrations are updated in place
+		Up: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&v1Deployment{}, &v1FileDiff{}, &v1Event{}, &v1User{}, &v1Session{},
+				&v1Secret{}, &v1ConfigRevision{})
+		},
+		Down: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable(&v1Event{}, &v1FileDiff{}, &v1Deployment{}, &v1Session{}, &v1User{},
+				&v1Secret{}, &v1ConfigRevision{})
+		},
+	},
+	{
+		Version:     2,
+		Description: "index the time of deployments",
+		Up: func(tx *gorm.DB) error {
+			return tx.Exec("CREATE INDEX IF NOT EXISTS idx_deployments_time ON deployments (time)").Error
+		},
+		Down: func(tx *gorm.DB) error {
+			return tx.Exec("DROP INDEX IF EXISTS idx_deployments_time").Error
+		},
+	},
+	{
+		Version:     3,
+		Description: "deployment logs",
+		Up: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&v3DeploymentLog{})
+		},
+		Down: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable(&v3DeploymentLog{})
+		},
+	},
+}
+
+// The models of the initial schema, frozen so later changes of the models don't change migration 1
+
+type v1Deployment struct {
+	ID      uint64 `gorm:"primaryKey;autoIncrement:true"`
+	Author  string
+	Diff    string
+	Status  string    `gorm:"type:varchar(32)"`
+	Time    time.Time `gorm:"autoCreateTime"`
+	EndTime time.Time
+	Title   string
+	Files   []v1FileDiff `gorm:"foreignKey:DeploymentID;constraint:OnDelete:CASCADE;"`
+	Events  []v1Event    `gorm:"foreignKey:ObjectID;constraint:OnDelete:CASCADE;"`
+}
+
+func (v1Deployment) TableName() string { return "deployments" }
+
+type v1FileDiff struct {
+	ID           uint64 `gorm:"primaryKey;autoIncrement:true"`
+	Diff         string
+	NewFile      string
+	OldFile      string
+	DeploymentID uint64 `gorm:"index"`
+}
+
+func (v1FileDiff) TableName() string { return "file_diffs" }
+
+type v1Event struct {
+	ID             uint64 `gorm:"primaryKey;autoIncrement:true"`
+	Type           string
+	Msg            string
+	Time           time.Time `gorm:"autoCreateTime"`
+	ObjectID       uint64    `gorm:"index"`
+	ObjectName     string
+	IsNotification bool
+}
+
+func (v1Event) TableName() string { return "events" }
+
+type v1User struct {
+	Username       string `gorm:"primaryKey"`
+	HashedPassword string
+}
+
+func (v1User) TableName() string { return "users" }
+
+type v1Session struct {
+	SessionID      uint64 `gorm:"primaryKey;autoGenerate"`
+	RefreshToken   string `gorm:"index"`
+	RefreshExpires time.Time
+	Revoked        bool
+	Username       string `gorm:"not null"`
+}
+
+func (v1Session) TableName() string { return "sessions" }
+
+type v1Secret struct {
+	Name       string `gorm:"primaryKey"`
+	Ciphertext []byte `gorm:"not null"`
+	UpdatedAt  time.Time
+}
+
+func (v1Secret) TableName() string { return "secrets" }
+
+type v1ConfigRevision struct {
+	ID      uint64 `gorm:"primaryKey;autoIncrement:true"`
+	Author  string
+	Message string
+	Time    time.Time `gorm:"autoCreateTime"`
+	Content string
+}
+
+func (v1ConfigRevision) TableName() string { return "config_revisions" }
+
+type v3DeploymentLog struct {
+	ID           uint64 `gorm:"primaryKey;autoIncrement:true"`
+	DeploymentID uint64 `gorm:"index"`
+	Time         time.Time
+	Service      string
+	Stream       string `gorm:"type:varchar(8)"`
+	Line         string
+}
+
+func (v3DeploymentLog) TableName() string { return "deployment_logs" }
@@ -0,0 +1,177 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// ErrNewerSchema is returned when the database was migrated by a newer version of autonas
+var ErrNewerSchema = errors.New("the database schema is newer than the one supported by this version of autonas")
+
+// Migration is a numbered step of the database schema, Down reverts Up.
+// A released migration must never change, schema changes are new migrations.
+type Migration struct {
+	Version     uint
+	Description string
+	Up          func(tx *gorm.DB) error
+	Down        func(tx *gorm.DB) error
+}
+
+// MigrationStatus describes a migration and when it was applied, AppliedAt is zero when pending
+type MigrationStatus struct {
+	Version     uint
+	Description string
+	AppliedAt   time.Time
+}
+
+// schemaVersion records an applied migration
+type schemaVersion struct {
+	Version     uint `gorm:"primaryKey;autoIncrement:false"`
+	Description string
+	AppliedAt   time.Time
+}
+
+func (schemaVersion) TableName() string {
+	return "schema_version"
+}
+
+// Migrator applies the migrations of the database schema
+type Migrator struct {
+	db         *gorm.DB
+	migrations []Migration
+}
+
+// NewMigrator creates a migrator of db to the migrations of this version of autonas
+func NewMigrator(db *gorm.DB) *Migrator {
+	return &Migrator{db: db, migrations: migrations}
+}
+
+// Latest returns the version of the last known migration
+func (m *Migrator) Latest() uint {
+	if len(m.migrations) == 0 {
+		return 0
+	}
+	return m.migrations[len(m.migrations)-1].Version
+}
+
+// Version returns the version of the database schema, 0 when no migration was applied
+func (m *Migrator) Version() (uint, error) {
+	if !m.db.Migrator().HasTable(&schemaVersion{}) {
+		return 0, nil
+	}
+	var version uint
+	err := m.db.Model(&schemaVersion{}).Select("COALESCE(MAX(version), 0)").Row().Scan(&version)
+	return version, err
+}
+
+// Status lists the known migrations, and the applied migrations unknown to this version
+func (m *Migrator) Status() ([]MigrationStatus, error) {
+	var applied []schemaVersion
+	if m.db.Migrator().HasTable(&schemaVersion{}) {
+		if err := m.db.Order("version").Find(&applied).Error; err != nil {
+			return nil, err
+		}
+	}
+	appliedAt := make(map[uint]time.Time)
+	for _, version := range applied {
+		appliedAt[version.Version] = version.AppliedAt
+	}
+	res := make([]MigrationStatus, 0, len(m.migrations))
+	for _, migration := range m.migrations {
+		res = append(res, MigrationStatus{
+			Version:     migration.Version,
+			Description: migration.Description,
+			AppliedAt:   appliedAt[migration.Version],
+		})
+	}
+	for _, version := range applied {
+		if version.Version > m.Latest() {
+			res = append(res, MigrationStatus(version))
+		}
+	}
+	return res, nil
+}
+
+// Up applies the pending migrations
+func (m *Migrator) Up() error {
+	return m.MigrateTo(m.Latest())
+}
+
+// MigrateTo applies or reverts the migrations to reach the target version, each migration
+// runs in its own transaction
+func (m *Migrator) MigrateTo(target uint) error {
+	if err := m.db.AutoMigrate(&schemaVersion{}); err != nil {
+		return err
+	}
+	current, err := m.Version()
+	if err != nil {
+		return err
+	}
+	if current > m.Latest() {
+		return fmt.Errorf("%w : version %d, supported %d", ErrNewerSchema, current, m.Latest())
+	}
+	if target > m.Latest() {
+		return fmt.Errorf("unknown schema version %d, the latest is %d", target, m.Latest())
+	}
+	for _, migration := range m.migrations {
+		if migration.Version > current && migration.Version <= target {
+			if err := m.apply(migration); err != nil {
+				return err
+			}
+		}
+	}
+	for i := len(m.migrations) - 1; i >= 0; i-- {
+		if migration := m.migrations[i]; migration.Version <= current && migration.Version > target {
+			if err := m.revert(migration); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (m *Migrator) apply(migration Migration) error {
+	slog.Info("migrating the database", "version", migration.Version, "description", migration.Description)
+	err := m.db.Transaction(func(tx *gorm.DB) error {
+		if err := migration.Up(tx); err != nil {
+			return err
+		}
+		return tx.Create(&schemaVersion{
+			Version:     migration.Version,
+			Description: migration.Description,
+			AppliedAt:   time.Now(),
+		}).Error
+	})
+	if err != nil {
+		return fmt.Errorf("error applying migration %d (%s): %w", migration.Version, migration.Description, err)
+	}
+	return nil
+}
+
+func (m *Migrator) revert(migration Migration) error {
+	slog.Info("reverting a database migration", "version", migration.Version, "description", migration.Description)
+	err := m.db.Transaction(func(tx *gorm.DB) error {
+		if err := migration.Down(tx); err != nil {
+			return err
+		}
+		return tx.Delete(&schemaVersion{Version: migration.Version}).Error
+	})
+	if err != nil {
+		return fmt.Errorf("error reverting migration %d (%s): %w", migration.Version, migration.Description, err)
+	}
+	return nil
+}
+
+// checkTables returns an error when the tables of the models don't exist
+func checkTables(db *gorm.DB, models ...any) error {
+	for _, model := range models {
+		if !db.Migrator().HasTable(model) {
+			return fmt.Errorf("missing table of %T, the database isn't migrated", model)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,161 @@
+package storage
+
+import (
+	"errors"
+	"testing"
+
+	"omar-kada/autonas/models"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+var modelTables = []any{
+	&models.Deployment{}, &models.FileDiff{}, &models.Event{}, &models.User{}, &models.Session{},
+	&models.Secret{}, &models.ConfigRevision{}, &models.DeploymentLog{},
+}
+
+func openMemoryDb(t *testing.T) *gorm.DB {
+	db, err := OpenGormDb(":memory:", 0o000)
+	if err != nil {
+		t.Fatalf("open db: %v", err)
+	}
+	return db
+}
+
+func hasIndex(db *gorm.DB, name string) bool {
+	return db.Migrator().HasIndex(&models.Deployment{}, name)
+}
+
+func TestMigrator_Up(t *testing.T) {
+	db := openMemoryDb(t)
+	migrator := NewMigrator(db)
+
+	version, err := migrator.Version()
+	assert.NoError(t, err)
+	assert.Zero(t, version)
+
+	assert.NoError(t, migrator.Up())
+	version, err = migrator.Version()
+	assert.NoError(t, err)
+	assert.Equal(t, migrator.Latest(), version)
+	for _, model := range modelTables {
+		assert.True(t, db.Migrator().HasTable(model), "%T", model)
+	}
+	assert.True(t, hasIndex(db, "idx_deployments_time"))
+
+	// nothing to apply
+	assert.NoError(t, migrator.Up())
+}
+
+func TestMigrator_DownAndUp(t *testing.T) {
+	db := openMemoryDb(t)
+	migrator := NewMigrator(db)
+	assert.NoError(t, migrator.Up())
+
+	for version := migrator.Latest(); version > 0; version-- {
+		assert.NoError(t, migrator.MigrateTo(version-1), "reverting %d", version)
+		current, err := migrator.Version()
+		assert.NoError(t, err)
+		assert.Equal(t, version-1, current)
+	}
+	for _, model := range modelTables {
+		assert.False(t, db.Migrator().HasTable(model), "%T", model)
+	}
+
+	assert.NoError(t, migrator.Up())
+	assert.True(t, hasIndex(db, "idx_deployments_time"))
+}
+
+func TestMigrator_RevertKeepsOtherVersions(t *testing.T) {
+	db := openMemoryDb(t)
+	migrator := NewMigrator(db)
+	assert.NoError(t, migrator.Up())
+	assert.NoError(t, db.Create(&models.Deployment{Title: "kept"}).Error)
+
+	assert.NoError(t, migrator.MigrateTo(1))
+	assert.False(t, hasIndex(db, "idx_deployments_time"))
+	var count int64
+	assert.NoError(t, db.Model(&models.Deployment{}).Count(&count).Error)
+	assert.Equal(t, int64(1), count)
+}
+
+func TestMigrator_ExistingAutoMigratedDatabase(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open(":memory:"))
+	assert.NoError(t, err)
+	// databases created before the migrations
+	assert.NoError(t, db.AutoMigrate(modelTables...))
+	assert.NoError(t, db.Create(&models.Deployment{Title: "old", Files: []models.FileDiff{{NewFile: "a"}}}).Error)
+
+	assert.NoError(t, NewMigrator(db).Up())
+
+	var dep models.Deployment
+	assert.NoError(t, db.Preload("Files").First(&dep).Error)
+	assert.Equal(t, "old", dep.Title)
+	assert.Len(t, dep.Files, 1)
+}
+
+func TestMigrator_NewerSchema(t *testing.T) {
+	db := openMemoryDb(t)
+	migrator := NewMigrator(db)
+	assert.NoError(t, migrator.Up())
+	assert.NoError(t, db.Create(&schemaVersion{Version: migrator.Latest() + 1, Description: "from the future"}).Error)
+
+	err := migrator.Up()
+	assert.True(t, errors.Is(err, ErrNewerSchema))
+
+	status, err := migrator.Status()
+	assert.NoError(t, err)
+	assert.Len(t, status, int(migrator.Latest())+1)
+	assert.Equal(t, "from the future", status[len(status)-1].Description)
+}
+
+func TestMigrator_Status(t *testing.T) {
+	db := openMemoryDb(t)
+	migrator := NewMigrator(db)
+
+	status, err := migrator.Status()
+	assert.NoError(t, err)
+	for _, migration := range status {
+		assert.True(t, migration.AppliedAt.IsZero())
+	}
+
+	assert.NoError(t, migrator.MigrateTo(1))
+	status, err = migrator.Status()
+	assert.NoError(t, err)
+	assert.Equal(t, "initial schema", status[0].Description)
+	assert.False(t, status[0].AppliedAt.IsZero())
+	assert.True(t, status[1].AppliedAt.IsZero())
+}
+
+func TestMigrator_UnknownVersion(t *testing.T) {
+	migrator := NewMigrator(openMemoryDb(t))
+
+	assert.ErrorContains(t, migrator.MigrateTo(migrator.Latest()+1), "unknown schema version")
+}
+
+func TestMigrator_FailedMigrationIsRolledBack(t *testing.T) {
+	db := openMemoryDb(t)
+	migrator := &Migrator{db: db, migrations: append(migrations, Migration{
+		Version:     migrations[len(migrations)-1].Version + 1,
+		Description: "broken",
+		Up: func(tx *gorm.DB) error {
+			if err := tx.Exec("CREATE TABLE partial (id integer)").Error; err != nil {
+				return err
+			}
+			return tx.Exec("NOT SQL").Error
+		},
+	})}
+
+	assert.ErrorContains(t, migrator.Up(), "error applying migration")
+	version, err := migrator.Version()
+	assert.NoError(t, err)
+	assert.Equal(t, migrator.Latest()-1, version)
+	assert.False(t, db.Migrator().HasTable("partial"))
+}
+
+func TestNewStorage_NotMigrated(t *testing.T) {
+	_, err := NewDeploymentStorage(openMemoryDb(t))
+	assert.ErrorContains(t, err, "isn't migrated")
+}
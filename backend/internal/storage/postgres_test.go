@@ -0,0 +1,139 @@
+package storage
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"omar-kada/autonas/models"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// postgresDSNEnv is the connection string of a disposable postgres database used by the tests,
+// its public schema is dropped
+const postgresDSNEnv = "AUTONAS_TEST_POSTGRES_DSN"
+
+func newPostgresTestDb(t *testing.T) *gorm.DB {
+	dsn := os.Getenv(postgresDSNEnv)
+	if dsn == "" {
+		t.Skipf("%s isn't set", postgresDSNEnv)
+	}
+	db, err := OpenPostgresDb(dsn)
+	if err != nil {
+		t.Fatalf("open postgres: %v", err)
+	}
+	assert.NoError(t, db.Exec("DROP SCHEMA public CASCADE").Error)
+	assert.NoError(t, db.Exec("CREATE SCHEMA public").Error)
+	return db
+}
+
+// testDialects are the databases the store tests run on, migrated
+var testDialects = []struct {
+	name string
+	open func(t *testing.T) *gorm.DB
+}{
+	{name: "sqlite", open: func(t *testing.T) *gorm.DB {
+		db, err := NewGormDb(":memory:", 0o000)
+		if err != nil {
+			t.Fatalf("new db: %v", err)
+		}
+		return db
+	}},
+	{name: "postgres", open: func(t *testing.T) *gorm.DB {
+		db := newPostgresTestDb(t)
+		if err := NewMigrator(db).Up(); err != nil {
+			t.Fatalf("migrate postgres: %v", err)
+		}
+		return db
+	}},
+}
+
+// forEachDialect runs test on a new database of each dialect, postgres is skipped
+// when AUTONAS_TEST_POSTGRES_DSN isn't set
+func forEachDialect(t *testing.T, test func(t *testing.T, db *gorm.DB)) {
+	for _, dialect := range testDialects {
+		t.Run(dialect.name, func(t *testing.T) {
+			test(t, dialect.open(t))
+		})
+	}
+}
+
+func TestPostgres_Dialect(t *testing.T) {
+	// the connection is only opened by the queries
+	db, err := gorm.Open(postgres.Open("host=localhost dbname=autonas"), &gorm.Config{
+		DryRun:               true,
+		DisableAutomaticPing: true,
+	})
+	assert.NoError(t, err)
+
+	assert.True(t, IsPostgres(db))
+	assert.False(t, IsPostgres(openMemoryDb(t)))
+	assert.Contains(t, durationExpr(db), "EXTRACT(EPOCH")
+	assert.Contains(t, dayExpr(db), "to_char")
+
+	_, err = NewBackupStorage(db, BackupFiles{ConfigFile: "config.yaml"}).Snapshot()
+	assert.ErrorIs(t, err, ErrBackupNotSupported)
+}
+
+func TestPostgres_Migrations(t *testing.T) {
+	db := newPostgresTestDb(t)
+	migrator := NewMigrator(db)
+
+	assert.NoError(t, migrator.Up())
+	for _, model := range modelTables {
+		assert.True(t, db.Migrator().HasTable(model), "%T", model)
+	}
+	assert.True(t, hasIndex(db, "idx_deployments_time"))
+
+	assert.NoError(t, migrator.MigrateTo(0))
+	for _, model := range modelTables {
+		assert.False(t, db.Migrator().HasTable(model), "%T", model)
+	}
+	assert.NoError(t, migrator.Up())
+}
+
+func TestPostgres_Deployments(t *testing.T) {
+	db := newPostgresTestDb(t)
+	assert.NoError(t, NewMigrator(db).Up())
+	store, err := NewDeploymentStorage(db)
+	assert.NoError(t, err)
+
+	for i := range 5 {
+		dep, err := store.InitDeployment("dep", "me", "diff", []models.FileDiff{{NewFile: "a", Diff: "+a"}})
+		assert.NoError(t, err, "deployment %d", i)
+		status := models.DeploymentStatusSuccess
+		if i%2 == 1 {
+			status = models.DeploymentStatusError
+		}
+		assert.NoError(t, store.EndDeployment(dep.ID, status))
+	}
+
+	page, err := store.GetDeployments(Cursor[uint64]{Limit: 2})
+	assert.NoError(t, err)
+	assert.Len(t, page, 2)
+	assert.Greater(t, page[0].ID, page[1].ID)
+
+	last, err := store.GetLastDeployment()
+	assert.NoError(t, err)
+	assert.Equal(t, page[0].ID, last.ID)
+	lastError, err := store.GetLastDeploymentWithStatus(models.DeploymentStatusError)
+	assert.NoError(t, err)
+	assert.Equal(t, page[1].ID, lastError.ID)
+
+	now := time.Now()
+	stats, err := store.GetStats(now.Add(-time.Hour), now.Add(time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), stats.Success)
+	assert.Equal(t, int32(2), stats.Error)
+
+	daily, err := store.GetDailyStats(now.Add(-time.Hour), now.Add(time.Hour))
+	assert.NoError(t, err)
+	assert.NotEmpty(t, daily)
+
+	res, err := NewPruner(db).Prune(models.Retention{MaxCount: 1}, now)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), res.Deployments)
+}
@@ -0,0 +1,199 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"omar-kada/autonas/models"
+
+	"gorm.io/gorm"
+)
+
+// pruneBatchSize bounds the number of ids in a single delete query
+const pruneBatchSize = 500
+
+// PruneResult counts the rows deleted or compressed by a prune
+type PruneResult struct {
+	Deployments int64
+	Files       int64
+	Events      int64
+	Logs        int64
+	Compressed  int64
+}
+
+// Changed checks if the prune modified the database
+func (r PruneResult) Changed() bool {
+	return r.Deployments+r.Files+r.Events+r.Logs+r.Compressed > 0
+}
+
+// Pruner deletes the deployments beyond the retention, with their files, events and logs
+type Pruner struct {
+	db *gorm.DB
+}
+
+// NewPruner creates a pruner of the deployments history
+func NewPruner(db *gorm.DB) *Pruner {
+	return &Pruner{db: db}
+}
+
+// Prune deletes the finished deployments older than the max age or beyond the max count,
+// and compresses the large diffs when enabled
+func (p *Pruner) Prune(retention models.Retention, now time.Time) (PruneResult, error) {
+	var res PruneResult
+	maxAge, err := retention.GetMaxAge()
+	if err != nil {
+		return res, fmt.Errorf("invalid retention max age : %w", err)
+	}
+	var ids []uint64
+	if maxAge > 0 || retention.MaxCount > 0 {
+		if ids, err = p.expiredDeployments(maxAge, retention.MaxCount, now); err != nil {
+			return res, err
+		}
+	}
+	for batch := range slices.Chunk(ids, pruneBatchSize) {
+		err := p.db.Transaction(func(tx *gorm.DB) error {
+			// deleted explicitly, the cascade isn't enforced on tables created before the constraints
+			events := tx.Where("object_id IN ?", batch).Delete(&models.Event{})
+			if events.Error != nil {
+				return events.Error
+			}
+			files := tx.Where("deployment_id IN ?", batch).Delete(&models.FileDiff{})
+			if files.Error != nil {
+				return files.Error
+			}
+			logs := tx.Where("deployment_id IN ?", batch).Delete(&models.DeploymentLog{})
+			if logs.Error != nil {
+				return logs.Error
+			}
+			deps := tx.Where("id IN ?", batch).Delete(&models.Deployment{})
+			if deps.Error != nil {
+				return deps.Error
+			}
+			res.Events += events.RowsAffected
+			res.Files += files.RowsAffected
+			res.Logs += logs.RowsAffected
+			res.Deployments += deps.RowsAffected
+			return nil
+		})
+		if err != nil {
+			return res, err
+		}
+	}
+	if maxAge > 0 {
+		// the events without deployment (config updates, stack status changes...) only expire with age
+		events := p.db.Where("object_id IS NULL AND time < ?", expiryTime(now, maxAge)).
+			Delete(&models.Event{})
+		if events.Error != nil {
+			return res, events.Error
+		}
+		res.Events += events.RowsAffected
+	}
+	if retention.CompressDiffs {
+		if res.Compressed, err = p.compressDiffs(); err != nil {
+			return res, err
+		}
+	}
+	return res, nil
+}
+
+// Vacuum rebuilds the database file to reclaim the space of the deleted rows
+func (p *Pruner) Vacuum() error {
+	return p.db.Exec("VACUUM").Error
+}
+
+// Run prunes the history at start then at every interval, with the retention returned by
+// getRetention, until ctx is done. The database is vacuumed when rows were deleted.
+func (p *Pruner) Run(ctx context.Context, interval time.Duration, getRetention func() (models.Retention, error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		p.runOnce(getRetention)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (p *Pruner) runOnce(getRetention func() (models.Retention, error)) {
+	retention, err := getRetention()
+	if err != nil {
+		slog.Warn("couldn't get the retention settings", "error", err)
+		return
+	}
+	if !retention.IsEnabled() {
+		return
+	}
+	res, err := p.Prune(retention, time.Now())
+	if err != nil {
+		slog.Error("couldn't prune the deployments history", "error", err)
+		return
+	}
+	if !res.Changed() {
+		return
+	}
+	slog.Info("deployments history pruned", "deployments", res.Deployments, "files", res.Files,
+		"events", res.Events, "logs", res.Logs, "compressed", res.Compressed)
+	if err := p.Vacuum(); err != nil {
+		slog.Warn("couldn't vacuum the database", "error", err)
+	}
+}
+
+// expiredDeployments returns the ids of the finished deployments to delete
+func (p *Pruner) expiredDeployments(maxAge time.Duration, maxCount int, now time.Time) ([]uint64, error) {
+	expired := p.db.Where("1 = 0")
+	if maxAge > 0 {
+		expired = expired.Or("time < ?", expiryTime(now, maxAge))
+	}
+	if maxCount > 0 {
+		kept := p.db.Model(&models.Deployment{}).Select("id").Order("time DESC").Limit(maxCount)
+		expired = expired.Or("id NOT IN (?)", kept)
+	}
+	var ids []uint64
+	err := p.db.Model(&models.Deployment{}).
+		Where("status IN ?", finishedStatuses).Where(expired).
+		Order("id").Pluck("id", &ids).Error
+	return ids, err
+}
+
+// expiryTime returns the time before which the rows expire, sqlite compares the times
+// as text and they are stored in local time
+func expiryTime(now time.Time, maxAge time.Duration) time.Time {
+	return now.Add(-maxAge).Local()
+}
+
+// compressDiffs compresses the large diffs of the deployments and their files
+func (p *Pruner) compressDiffs() (int64, error) {
+	var count int64
+	for _, model := range []any{&models.Deployment{}, &models.FileDiff{}} {
+		for {
+			var rows []struct {
+				ID   uint64
+				Diff string
+			}
+			if err := p.db.Model(model).Select("id, diff").
+				Where("LENGTH(diff) > ? AND diff NOT LIKE ?", compressThreshold, compressedPrefix+"%").
+				Limit(pruneBatchSize).Scan(&rows).Error; err != nil {
+				return count, err
+			}
+			if len(rows) == 0 {
+				break
+			}
+			for _, row := range rows {
+				compressed, err := compressText(row.Diff)
+				if err != nil {
+					return count, err
+				}
+				if err := p.db.Model(model).Where("id = ?", row.ID).Update("diff", compressed).Error; err != nil {
+					return count, err
+				}
+				count++
+			}
+		}
+	}
+	return count, nil
+}
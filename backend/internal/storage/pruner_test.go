@@ -0,0 +1,143 @@
+package storage
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"omar-kada/autonas/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func countRows(t *testing.T, s DeploymentStorage, model any) int64 {
+	var count int64
+	assert.NoError(t, s.(*gormDeploymentStorage).db.Model(model).Count(&count).Error)
+	return count
+}
+
+func TestPrune_MaxAge(t *testing.T) {
+	s, db := setupDeploymentStorage(t)
+	now := time.Date(2025, 3, 10, 10, 0, 0, 0, time.UTC)
+	createDeployment(t, db, now.AddDate(0, 0, -40), time.Minute, models.DeploymentStatusSuccess, "a", "b")
+	createDeployment(t, db, now.AddDate(0, 0, -31), time.Minute, models.DeploymentStatusError, "a")
+	createDeployment(t, db, now.AddDate(0, 0, -1), time.Minute, models.DeploymentStatusSuccess, "a")
+	assert.NoError(t, db.Create(&models.Event{ObjectID: 1, Msg: "old"}).Error)
+	assert.NoError(t, db.Create(&models.Event{ObjectID: 3, Msg: "recent"}).Error)
+	// events without deployment only expire with age
+	assert.NoError(t, db.Omit("ObjectID").Create(&models.Event{Msg: "old standalone", Time: now.AddDate(0, 0, -31)}).Error)
+	assert.NoError(t, db.Omit("ObjectID").Create(&models.Event{Msg: "recent standalone", Time: now}).Error)
+	assert.NoError(t, db.Create(&models.DeploymentLog{DeploymentID: 2, Line: "old"}).Error)
+	assert.NoError(t, db.Create(&models.DeploymentLog{DeploymentID: 3, Line: "recent"}).Error)
+
+	res, err := NewPruner(db).Prune(models.Retention{MaxAge: "30d"}, now)
+	assert.NoError(t, err)
+	assert.Equal(t, PruneResult{Deployments: 2, Files: 3, Events: 2, Logs: 1}, res)
+	assert.Equal(t, int64(1), countRows(t, s, &models.Deployment{}))
+	assert.Equal(t, int64(1), countRows(t, s, &models.FileDiff{}))
+	assert.Equal(t, int64(2), countRows(t, s, &models.Event{}))
+	assert.Equal(t, int64(1), countRows(t, s, &models.DeploymentLog{}))
+}
+
+func TestPrune_MaxCount(t *testing.T) {
+	s, db := setupDeploymentStorage(t)
+	now := time.Now()
+	for i := 5; i > 0; i-- {
+		createDeployment(t, db, now.Add(-time.Duration(i)*time.Hour), time.Minute, models.DeploymentStatusSuccess)
+	}
+	createDeployment(t, db, now.Add(-10*time.Hour), 0, models.DeploymentStatusRunning)
+
+	res, err := NewPruner(db).Prune(models.Retention{MaxCount: 2}, now)
+	assert.NoError(t, err)
+	// running deployments are never deleted
+	assert.Equal(t, int64(3), res.Deployments)
+	deps, err := s.GetDeployments(NewIDCursor(10, 0))
+	assert.NoError(t, err)
+	var ids []uint64
+	for _, dep := range deps {
+		ids = append(ids, dep.ID)
+	}
+	assert.Equal(t, []uint64{5, 4, 6}, ids)
+}
+
+func TestPrune_Disabled(t *testing.T) {
+	s, db := setupDeploymentStorage(t)
+	createDeployment(t, db, time.Now().AddDate(-1, 0, 0), time.Minute, models.DeploymentStatusSuccess)
+
+	res, err := NewPruner(db).Prune(models.Retention{}, time.Now())
+	assert.NoError(t, err)
+	assert.False(t, res.Changed())
+	assert.Equal(t, int64(1), countRows(t, s, &models.Deployment{}))
+}
+
+func TestPrune_InvalidMaxAge(t *testing.T) {
+	_, db := setupDeploymentStorage(t)
+
+	_, err := NewPruner(db).Prune(models.Retention{MaxAge: "soon"}, time.Now())
+	assert.ErrorContains(t, err, "invalid retention max age")
+}
+
+func TestPrune_CompressDiffs(t *testing.T) {
+	s, db := setupDeploymentStorage(t)
+	largeDiff := strings.Repeat("+ line\n", 5000)
+	dep, err := s.InitDeployment("title", "author", largeDiff, []models.FileDiff{
+		{Diff: largeDiff, NewFile: "big"},
+		{Diff: "+ small", NewFile: "small"},
+	})
+	assert.NoError(t, err)
+
+	pruner := NewPruner(db)
+	res, err := pruner.Prune(models.Retention{CompressDiffs: true}, time.Now())
+	assert.NoError(t, err)
+	assert.Equal(t, PruneResult{Compressed: 2}, res)
+
+	var stored models.Deployment
+	assert.NoError(t, db.First(&stored, dep.ID).Error)
+	assert.True(t, strings.HasPrefix(stored.Diff, compressedPrefix))
+	assert.Less(t, len(stored.Diff), len(largeDiff))
+
+	// compressed diffs are read transparently
+	got, err := s.GetDeployment(dep.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, largeDiff, got.Diff)
+	assert.Equal(t, largeDiff, got.Files[0].Diff)
+	assert.Equal(t, "+ small", got.Files[1].Diff)
+
+	// already compressed diffs are skipped
+	res, err = pruner.Prune(models.Retention{CompressDiffs: true}, time.Now())
+	assert.NoError(t, err)
+	assert.Zero(t, res.Compressed)
+	assert.NoError(t, pruner.Vacuum())
+}
+
+func TestPrunerRun(t *testing.T) {
+	s, db := setupDeploymentStorage(t)
+	createDeployment(t, db, time.Now().AddDate(0, 0, -10), time.Minute, models.DeploymentStatusSuccess)
+	createDeployment(t, db, time.Now(), time.Minute, models.DeploymentStatusSuccess)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		NewPruner(db).Run(ctx, time.Hour, func() (models.Retention, error) {
+			defer cancel()
+			return models.Retention{MaxAge: "1d"}, nil
+		})
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("pruner didn't stop")
+	}
+	assert.Equal(t, int64(1), countRows(t, s, &models.Deployment{}))
+}
+
+func TestDecompressText(t *testing.T) {
+	text, err := decompressText("+ plain")
+	assert.NoError(t, err)
+	assert.Equal(t, "+ plain", text)
+
+	_, err = decompressText(compressedPrefix + "not base64")
+	assert.Error(t, err)
+}
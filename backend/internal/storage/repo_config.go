@@ -0,0 +1,158 @@
+package storage
+
+import (
+	"bytes"
+	"fmt"
+	"maps"
+	"slices"
+
+	"omar-kada/autonas/models"
+
+	"go.yaml.in/yaml/v3"
+)
+
+// PatchRepoConfig updates the environment and services of content, the config file of the repo,
+// with the ones of cfg. The other keys, the comments and the order of the existing keys are kept.
+// The sensitive variables are only written as secret references, their values stay in the local config.
+func PatchRepoConfig(content []byte, cfg models.Config) ([]byte, error) {
+	var root yaml.Node
+	if err := yaml.Unmarshal(content, &root); err != nil {
+		return nil, fmt.Errorf("error unmarshaling the config of the repo: %w", err)
+	}
+	if len(root.Content) == 0 {
+		root = yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{newMappingNode()}}
+	}
+	doc := root.Content[0]
+	if doc.Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("the config of the repo isn't a mapping")
+	}
+
+	patchVariables(mappingChild(doc, "environment"), cfg.Environment)
+	services := mappingChild(doc, "services")
+	removeMissingKeys(services, func(name string) bool {
+		_, ok := cfg.Services[name]
+		return ok
+	})
+	for _, name := range slices.Sorted(maps.Keys(cfg.Services)) {
+		patchVariables(mappingChild(services, name), cfg.Services[name])
+	}
+
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&root); err != nil {
+		return nil, fmt.Errorf("error marshaling the config of the repo: %w", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, fmt.Errorf("error marshaling the config of the repo: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// MergeRepoConfig returns cfg with the environment and services of content, the config file of the repo.
+// The sections missing from the repo are kept, and so are the local values of the sensitive
+// variables since they aren't written back.
+func MergeRepoConfig(cfg models.Config, content []byte) (models.Config, error) {
+	repoMap, err := parseConfigMap("config of the repo", content)
+	if err != nil {
+		return cfg, err
+	}
+	repo, err := decodeConfig(repoMap)
+	if err != nil {
+		return cfg, err
+	}
+	if _, ok := repoMap["environment"]; ok {
+		cfg.Environment = mergeVariables(repo.Environment, cfg.Environment)
+	}
+	if _, ok := repoMap["services"]; ok {
+		services := make(map[string]models.ServiceConfig, len(repo.Services))
+		for name, vars := range repo.Services {
+			services[name] = mergeVariables(vars, cfg.Services[name])
+		}
+		cfg.Services = services
+	}
+	return cfg, nil
+}
+
+// isWrittenBack returns true when the variable can be committed to the repo,
+// the sensitive values are only committed as secret references
+func isWrittenBack(key, value string) bool {
+	_, _, isReference := models.ParseSecretReference(value)
+	return isReference || !models.IsSensitiveKey(key)
+}
+
+// patchVariables sets the variables that are written back on the mapping node, the variables
+// missing from vars are removed while the sensitive ones that aren't written back are left as is
+func patchVariables(node *yaml.Node, vars map[string]string) {
+	removeMissingKeys(node, func(key string) bool {
+		_, ok := vars[key]
+		return ok
+	})
+	for _, key := range slices.Sorted(maps.Keys(vars)) {
+		value := vars[key]
+		if !isWrittenBack(key, value) {
+			continue
+		}
+		if existing := mappingValue(node, key); existing != nil && existing.Kind == yaml.ScalarNode {
+			if existing.Value != value {
+				existing.Value = value
+				existing.Tag = "!!str"
+			}
+			continue
+		}
+		setMappingValue(node, key, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: value})
+	}
+}
+
+// mergeVariables returns the variables of the repo with the local sensitive values that aren't written back
+func mergeVariables[M ~map[string]string](repoVars, localVars M) M {
+	merged := maps.Clone(repoVars)
+	for key, value := range localVars {
+		if _, ok := merged[key]; !ok && !isWrittenBack(key, value) {
+			if merged == nil {
+				merged = M{}
+			}
+			merged[key] = value
+		}
+	}
+	if len(merged) == 0 && len(localVars) == 0 {
+		return localVars
+	}
+	return merged
+}
+
+// mappingChild returns the mapping value of key, it's created when missing or when it isn't a mapping
+func mappingChild(node *yaml.Node, key string) *yaml.Node {
+	if child := mappingValue(node, key); child != nil && child.Kind == yaml.MappingNode {
+		return child
+	}
+	child := newMappingNode()
+	setMappingValue(node, key, child)
+	return child
+}
+
+// setMappingValue replaces the value of key, or appends it when missing
+func setMappingValue(node *yaml.Node, key string, value *yaml.Node) {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			node.Content[i+1] = value
+			return
+		}
+	}
+	node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key}, value)
+}
+
+// removeMissingKeys removes the keys of the mapping node for which keep returns false
+func removeMissingKeys(node *yaml.Node, keep func(key string) bool) {
+	content := node.Content[:0]
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if keep(node.Content[i].Value) {
+			content = append(content, node.Content[i], node.Content[i+1])
+		}
+	}
+	node.Content = content
+}
+
+func newMappingNode() *yaml.Node {
+	return &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+}
@@ -0,0 +1,78 @@
+package storage
+
+import (
+	"testing"
+
+	"omar-kada/autonas/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPatchRepoConfig(t *testing.T) {
+	content := `# shared configuration
+extra: kept
+environment:
+  TZ: Europe/Paris # the timezone
+  OLD: removed
+  DB_PASSWORD: secret://db-password
+services:
+  web:
+    PORT: 8080
+  removed:
+    A: "1"
+`
+	cfg := models.Config{
+		Settings:    models.Settings{Token: "secret-token"},
+		Environment: models.Environment{"TZ": "Europe/Paris", "DB_PASSWORD": "secret://db-password", "API_KEY": "plain", "DEBUG": "true"},
+		Services:    map[string]models.ServiceConfig{"web": {"PORT": "8081"}, "db": {"ROOT_PASSWORD": "plain"}},
+	}
+
+	patched, err := PatchRepoConfig([]byte(content), cfg)
+
+	assert.NoError(t, err)
+	assert.Equal(t, `# shared configuration
+extra: kept
+environment:
+  TZ: Europe/Paris # the timezone
+  DB_PASSWORD: secret://db-password
+  DEBUG: "true"
+services:
+  web:
+    PORT: "8081"
+  db: {}
+`, string(patched))
+}
+
+func TestPatchRepoConfig_MissingFile(t *testing.T) {
+	cfg := models.Config{Environment: models.Environment{"A": "1"}}
+
+	patched, err := PatchRepoConfig(nil, cfg)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "environment:\n  A: \"1\"\nservices: {}\n", string(patched))
+
+	_, err = PatchRepoConfig([]byte("- a list"), cfg)
+	assert.Error(t, err)
+}
+
+func TestMergeRepoConfig(t *testing.T) {
+	cfg := models.Config{
+		Settings:    models.Settings{Repo: "https://example.com/repo.git"},
+		Environment: models.Environment{"TZ": "UTC", "API_KEY": "plain"},
+		Services:    map[string]models.ServiceConfig{"web": {"PORT": "80", "ADMIN_PASSWORD": "plain"}, "old": {}},
+	}
+
+	merged, err := MergeRepoConfig(cfg, []byte("environment:\n  TZ: Europe/Paris\nservices:\n  web:\n    PORT: 8081\n"))
+
+	assert.NoError(t, err)
+	assert.Equal(t, models.Config{
+		Settings:    cfg.Settings,
+		Environment: models.Environment{"TZ": "Europe/Paris", "API_KEY": "plain"},
+		Services:    map[string]models.ServiceConfig{"web": {"PORT": "8081", "ADMIN_PASSWORD": "plain"}},
+	}, merged)
+
+	// the sections missing from the repo are kept
+	merged, err = MergeRepoConfig(cfg, []byte("extra: true\n"))
+	assert.NoError(t, err)
+	assert.Equal(t, cfg, merged)
+}
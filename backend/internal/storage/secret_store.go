@@ -0,0 +1,152 @@
+package storage
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"omar-kada/autonas/models"
+
+	"gorm.io/gorm"
+)
+
+// MasterKeyEnv is the environment variable that can hold the master key used to encrypt secrets
+const MasterKeyEnv = "AUTONAS_MASTER_KEY"
+
+var (
+	// ErrInvalidSecretName is returned when a secret name contains unsupported characters
+	ErrInvalidSecretName = errors.New("invalid secret name")
+
+	// secret names are used as file names for docker secrets
+	secretNameRegexp = regexp.MustCompile(`^[A-Za-z0-9_][A-Za-z0-9_.-]*$`)
+)
+
+// SecretStorage is an abstraction of all secret database operations
+type SecretStorage interface {
+	GetSecret(name string) (string, error)
+	SetSecret(name, value string) (models.Secret, error)
+	DeleteSecret(name string) (bool, error)
+	ListSecrets() ([]models.Secret, error)
+}
+
+// gormSecretStorage implements the SecretStorage interface using GORM,
+// values are encrypted with AES-GCM before being stored
+type gormSecretStorage struct {
+	db   *gorm.DB
+	aead cipher.AEAD
+}
+
+// NewSecretStorage creates a secret storage encrypting values with masterKey, the database must be migrated
+func NewSecretStorage(db *gorm.DB, masterKey []byte) (SecretStorage, error) {
+	if err := checkTables(db, &models.Secret{}); err != nil {
+		return nil, err
+	}
+	key := sha256.Sum256(masterKey)
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &gormSecretStorage{db: db, aead: aead}, nil
+}
+
+// LoadMasterKey returns the master key from the AUTONAS_MASTER_KEY environment variable,
+// or from keyFile, which is generated when it doesn't exist
+func LoadMasterKey(keyFile string) ([]byte, error) {
+	if key := os.Getenv(MasterKeyEnv); key != "" {
+		return []byte(key), nil
+	}
+	content, err := os.ReadFile(keyFile)
+	if err == nil {
+		key := strings.TrimSpace(string(content))
+		if key == "" {
+			return nil, fmt.Errorf("master key file %s is empty", keyFile)
+		}
+		return []byte(key), nil
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	random := make([]byte, 32)
+	if _, err := rand.Read(random); err != nil {
+		return nil, err
+	}
+	key := base64.StdEncoding.EncodeToString(random)
+	if err := os.MkdirAll(filepath.Dir(keyFile), 0o700); err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(keyFile, []byte(key+"\n"), 0o600); err != nil {
+		return nil, fmt.Errorf("couldn't write master key file %s : %w", keyFile, err)
+	}
+	return []byte(key), nil
+}
+
+// GetSecret returns the decrypted value of a secret
+func (s *gormSecretStorage) GetSecret(name string) (string, error) {
+	var secret models.Secret
+	if err := s.db.Where("name = ?", name).First(&secret).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", fmt.Errorf("%w : %s", models.ErrSecretNotFound, name)
+		}
+		return "", err
+	}
+	nonceSize := s.aead.NonceSize()
+	if len(secret.Ciphertext) < nonceSize {
+		return "", fmt.Errorf("corrupted secret %s", name)
+	}
+	nonce, ciphertext := secret.Ciphertext[:nonceSize], secret.Ciphertext[nonceSize:]
+	plaintext, err := s.aead.Open(nil, nonce, ciphertext, []byte(name))
+	if err != nil {
+		return "", fmt.Errorf("couldn't decrypt secret %s, was the master key changed ? %w", name, err)
+	}
+	return string(plaintext), nil
+}
+
+// SetSecret encrypts and stores a secret, replacing any existing value
+func (s *gormSecretStorage) SetSecret(name, value string) (models.Secret, error) {
+	if !secretNameRegexp.MatchString(name) {
+		return models.Secret{}, fmt.Errorf("%w : %q", ErrInvalidSecretName, name)
+	}
+	nonce := make([]byte, s.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return models.Secret{}, err
+	}
+	secret := models.Secret{
+		Name:       name,
+		Ciphertext: s.aead.Seal(nonce, nonce, []byte(value), []byte(name)),
+	}
+	if err := s.db.Save(&secret).Error; err != nil {
+		return models.Secret{}, err
+	}
+	return secret, nil
+}
+
+// DeleteSecret deletes a secret and returns whether it existed
+func (s *gormSecretStorage) DeleteSecret(name string) (bool, error) {
+	tx := s.db.Where("name = ?", name).Delete(&models.Secret{})
+	if err := tx.Error; err != nil {
+		return false, err
+	}
+	return tx.RowsAffected > 0, nil
+}
+
+// ListSecrets returns all the secrets ordered by name, without their values
+func (s *gormSecretStorage) ListSecrets() ([]models.Secret, error) {
+	var secrets []models.Secret
+	if err := s.db.Omit("ciphertext").Order("name").Find(&secrets).Error; err != nil {
+		return nil, err
+	}
+	return secrets, nil
+}
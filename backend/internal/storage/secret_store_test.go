@@ -0,0 +1,120 @@
+package storage
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"omar-kada/autonas/models"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func setupSecretStorage(t *testing.T, masterKey string) (SecretStorage, *gorm.DB) {
+	db, err := NewGormDb(":memory:", 0o000)
+	if err != nil {
+		t.Fatalf("new db: %v", err)
+	}
+	secretStore, err := NewSecretStorage(db, []byte(masterKey))
+	if err != nil {
+		t.Fatalf("new storage: %v", err)
+	}
+	return secretStore, db
+}
+
+func TestSetAndGetSecret(t *testing.T) {
+	s, db := setupSecretStorage(t, "master")
+
+	_, err := s.SetSecret("db_password", "p@ss=word")
+	assert.NoError(t, err)
+
+	value, err := s.GetSecret("db_password")
+	assert.NoError(t, err)
+	assert.Equal(t, "p@ss=word", value)
+
+	// the value is not stored in plaintext
+	var stored models.Secret
+	assert.NoError(t, db.First(&stored, "name = ?", "db_password").Error)
+	assert.NotContains(t, string(stored.Ciphertext), "p@ss=word")
+
+	_, err = s.SetSecret("db_password", "updated")
+	assert.NoError(t, err)
+	value, err = s.GetSecret("db_password")
+	assert.NoError(t, err)
+	assert.Equal(t, "updated", value)
+}
+
+func TestGetSecret_NotFound(t *testing.T) {
+	s, _ := setupSecretStorage(t, "master")
+
+	_, err := s.GetSecret("missing")
+	assert.ErrorIs(t, err, models.ErrSecretNotFound)
+}
+
+func TestGetSecret_WrongMasterKey(t *testing.T) {
+	s, db := setupSecretStorage(t, "master")
+	_, err := s.SetSecret("db_password", "value")
+	assert.NoError(t, err)
+
+	other, err := NewSecretStorage(db, []byte("other"))
+	assert.NoError(t, err)
+	_, err = other.GetSecret("db_password")
+	assert.ErrorContains(t, err, "couldn't decrypt secret")
+}
+
+func TestSetSecret_InvalidName(t *testing.T) {
+	s, _ := setupSecretStorage(t, "master")
+
+	for _, name := range []string{"", "../escape", "with space", ".hidden"} {
+		_, err := s.SetSecret(name, "value")
+		assert.ErrorIs(t, err, ErrInvalidSecretName, name)
+	}
+}
+
+func TestListAndDeleteSecrets(t *testing.T) {
+	s, _ := setupSecretStorage(t, "master")
+	_, err := s.SetSecret("b", "1")
+	assert.NoError(t, err)
+	_, err = s.SetSecret("a", "2")
+	assert.NoError(t, err)
+
+	secrets, err := s.ListSecrets()
+	assert.NoError(t, err)
+	assert.Len(t, secrets, 2)
+	assert.Equal(t, "a", secrets[0].Name)
+	assert.Empty(t, secrets[0].Ciphertext)
+
+	deleted, err := s.DeleteSecret("a")
+	assert.NoError(t, err)
+	assert.True(t, deleted)
+	deleted, err = s.DeleteSecret("a")
+	assert.NoError(t, err)
+	assert.False(t, deleted)
+
+	secrets, err = s.ListSecrets()
+	assert.NoError(t, err)
+	assert.Len(t, secrets, 1)
+}
+
+func TestLoadMasterKey(t *testing.T) {
+	keyFile := filepath.Join(t.TempDir(), "keys", "master.key")
+
+	key, err := LoadMasterKey(keyFile)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, key)
+	info, err := os.Stat(keyFile)
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	// the generated key is reused
+	again, err := LoadMasterKey(keyFile)
+	assert.NoError(t, err)
+	assert.Equal(t, key, again)
+
+	// the environment variable takes priority
+	t.Setenv(MasterKeyEnv, "from-env")
+	key, err = LoadMasterKey(keyFile)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("from-env"), key)
+}
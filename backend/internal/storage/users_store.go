@@ -34,9 +34,9 @@ type gormUserStorage struct {
 	db *gorm.DB
 }
 
-// NewUsersStorage creates a users storage and run migrations
+// NewUsersStorage creates a users storage, the database must be migrated
 func NewUsersStorage(db *gorm.DB) (UserStorage, error) {
-	if err := db.AutoMigrate(&models.User{}, &models.Session{}); err != nil {
+	if err := checkTables(db, &models.User{}, &models.Session{}); err != nil {
 		return nil, err
 	}
 	return &gormUserStorage{db: db}, nil
@@ -12,15 +12,39 @@ import (
 // DefaultBranch is the default branch name used when no branch is specified in the configuration.
 const DefaultBranch = "main"
 
+// WriteBackMode defines how configuration edits are written back to the config repo
+type WriteBackMode string
+
+// Write back modes
+const (
+	// WriteBackCommit commits the edits to the configured branch
+	WriteBackCommit WriteBackMode = "commit"
+	// WriteBackBranch commits the edits to a new branch, to be merged manually
+	WriteBackBranch WriteBackMode = "branch"
+)
+
+// IsValid checks if the mode is a known write back mode, an empty mode disables the write back
+func (m WriteBackMode) IsValid() bool {
+	return m == "" || m == WriteBackCommit || m == WriteBackBranch
+}
+
 // Settings represents configuration of autonas.
 type Settings struct {
-	Repo              string      `mapstructure:"repo"`
-	Branch            string      `mapstructure:"branch"`
-	Username          string      `mapstructure:"username"`
-	Token             string      `mapstructure:"token"`
-	Cron              string      `mapstructure:"cron"`
-	NotificationURL   string      `mapstructure:"notificationURL"`
-	NotificationTypes []EventType `mapstructure:"notificationTypes"`
+	Repo              string        `mapstructure:"repo"`
+	Branch            string        `mapstructure:"branch"`
+	Username          string        `mapstructure:"username"`
+	Token             string        `mapstructure:"token"`
+	Cron              string        `mapstructure:"cron"`
+	NotificationURL   string        `mapstructure:"notificationURL"`
+	NotificationTypes []EventType   `mapstructure:"notificationTypes"`
+	WriteBack         WriteBackMode `mapstructure:"writeBack,omitempty"`
+
+	Timezone           string                            `mapstructure:"timezone,omitempty"`
+	Schedules          map[ScheduleName]ScheduleSettings `mapstructure:"schedules,omitempty"`
+	MaintenanceWindows []MaintenanceWindow               `mapstructure:"maintenanceWindows,omitempty"`
+
+	Retention *Retention `mapstructure:"retention,omitempty"`
+	Watchdog  *Watchdog  `mapstructure:"watchdog,omitempty"`
 }
 
 // Environment represents global environment variables.
@@ -36,21 +60,37 @@ type Config struct {
 	Services    map[string]ServiceConfig `mapstructure:"services"`
 }
 
-// PerService generates a slice of configuration variables for a specific service
-func (cfg Config) PerService(service string) *orderedmap.OrderedMap[string, string] {
+// ConfigOrigins maps the path of each configuration value (ex : services.db.PORT)
+// to the name of the file it comes from
+type ConfigOrigins map[string]string
+
+// PerService generates the configuration variables of a specific service, global values first,
+// with ${...} expressions interpolated using funcs
+func (cfg Config) PerService(service string, funcs TemplateFuncs) (*orderedmap.OrderedMap[string, string], error) {
+	return newInterpolator(cfg, funcs).resolveAll(service)
+}
+
+// rawPerService returns the configuration variables of a specific service without interpolation,
+// keys are sorted to keep the generated files stable
+func (cfg Config) rawPerService(service string) *orderedmap.OrderedMap[string, string] {
 	serviceConfig := orderedmap.NewOrderedMap[string, string]()
 
-	for key, value := range cfg.Environment {
-		serviceConfig.Set(strings.ToUpper(key), fmt.Sprint(value))
+	for _, key := range slices.Sorted(maps.Keys(cfg.Environment)) {
+		serviceConfig.Set(strings.ToUpper(key), fmt.Sprint(cfg.Environment[key]))
 	}
 	if svcVars, ok := cfg.Services[service]; ok {
-		for key, value := range svcVars {
-			serviceConfig.Set(strings.ToUpper(key), fmt.Sprint(value))
+		for _, key := range slices.Sorted(maps.Keys(svcVars)) {
+			serviceConfig.Set(strings.ToUpper(key), fmt.Sprint(svcVars[key]))
 		}
 	}
 	return serviceConfig
 }
 
+// ServiceKeys returns the names of the variables defined for a specific service
+func (cfg Config) ServiceKeys(service string) []string {
+	return slices.Collect(cfg.rawPerService(service).Keys())
+}
+
 // GetEnabledServices returns the list of enabled services on the configuration
 func (cfg Config) GetEnabledServices() []string {
 	return slices.Collect(maps.Keys(cfg.Services))
@@ -0,0 +1,12 @@
+package models
+
+import "time"
+
+// ConfigRevision is a version of the config file
+type ConfigRevision struct {
+	ID      uint64    `gorm:"primaryKey;autoIncrement:true"`
+	Author  string    // user who made the change, empty for edits made on disk
+	Message string    // what caused the change
+	Time    time.Time `gorm:"autoCreateTime"`
+	Content string    // full content of the config file
+}
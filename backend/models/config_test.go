@@ -21,7 +21,10 @@ func TestConfigPerService_BuildsCorrectArray(t *testing.T) {
 		},
 	}
 
-	got := cfg.PerService("svc")
+	got, err := cfg.PerService("svc", nil)
+	if err != nil {
+		t.Fatalf("PerService: %v", err)
+	}
 	want := orderedmap.NewOrderedMapWithElements(
 		&orderedmap.Element[string, string]{Key: "GLOBAL", Value: "g"},
 		&orderedmap.Element[string, string]{Key: "SVC_EXTRA", Value: "s"},
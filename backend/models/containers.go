@@ -15,7 +15,60 @@ type ContainerSummary struct {
 	State     container.ContainerState
 	Health    container.HealthStatus
 	StartedAt time.Time
+	// Usage is the last sample of the resources used by the container, nil until it's sampled
+	Usage *ResourceUsage
+}
+
+// ContainerEvent is a Docker event of a container of the compose stacks,
+// Action is the action of the event (ex: die, health_status: unhealthy)
+type ContainerEvent struct {
+	ContainerID string
+	Action      string
+}
+
+// ResourceUsage is a sample of the resources used by containers, the network and block I/O
+// are totals since the containers started
+type ResourceUsage struct {
+	Time time.Time
+	// CPUPercent is the percentage of one CPU, 200 for two full CPUs
+	CPUPercent  float64
+	MemoryUsage uint64
+	// MemoryLimit is the limit of the container, the memory of the host when unlimited
+	MemoryLimit uint64
+	NetworkRx   uint64
+	NetworkTx   uint64
+	BlockRead   uint64
+	BlockWrite  uint64
+}
+
+// Add sums the usage of two containers, the limit is the highest one
+func (u ResourceUsage) Add(other ResourceUsage) ResourceUsage {
+	u.CPUPercent += other.CPUPercent
+	u.MemoryUsage += other.MemoryUsage
+	u.MemoryLimit = max(u.MemoryLimit, other.MemoryLimit)
+	u.NetworkRx += other.NetworkRx
+	u.NetworkTx += other.NetworkTx
+	u.BlockRead += other.BlockRead
+	u.BlockWrite += other.BlockWrite
+	return u
 }
 
 // ContextKey is the type of keys used inside context
 type ContextKey string
+
+// ContainerLogsOptions selects the logs of a container
+type ContainerLogsOptions struct {
+	// Tail is the number of lines from the end of the logs, or "all"
+	Tail string
+	// Since excludes the older lines when it isn't zero
+	Since time.Time
+	// Follow keeps streaming the new lines until the context is canceled
+	Follow bool
+}
+
+// ContainerLogLine is a line printed by a container
+type ContainerLogLine struct {
+	Time   time.Time
+	Stream LogStream
+	Line   string
+}
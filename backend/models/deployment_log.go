@@ -0,0 +1,23 @@
+package models
+
+import "time"
+
+// LogStream is the output stream of a command
+type LogStream string
+
+const (
+	// LogStreamStdout is the standard output
+	LogStreamStdout LogStream = "stdout"
+	// LogStreamStderr is the standard error
+	LogStreamStderr LogStream = "stderr"
+)
+
+// DeploymentLog is a line printed by a command run during a deployment
+type DeploymentLog struct {
+	ID           uint64 `gorm:"primaryKey;autoIncrement:true"`
+	DeploymentID uint64 `gorm:"index"`
+	Time         time.Time
+	Service      string
+	Stream       LogStream `gorm:"type:varchar(8)"`
+	Line         string
+}
@@ -1,6 +1,9 @@
 package models
 
-import "time"
+import (
+	"slices"
+	"time"
+)
 
 // EventType represents the type of event
 type EventType string
@@ -29,8 +32,41 @@ const (
 
 	// EventSessionReused indicates that a refresh token has been reused
 	EventSessionReused EventType = "SESSION_REUSED"
+
+	// EventStackStatusChanged indicates that the status of a stack has changed
+	EventStackStatusChanged EventType = "STACK_STATUS_CHANGED"
+
+	// EventStackUnhealthy indicates that a stack stayed unhealthy for several checks of the watchdog
+	EventStackUnhealthy EventType = "STACK_UNHEALTHY"
+
+	// EventStackRecovered indicates that an unhealthy stack is healthy again
+	EventStackRecovered EventType = "STACK_RECOVERED"
+
+	// EventLog is a line printed by a command, it's stored as a deployment log
+	EventLog EventType = "LOG"
 )
 
+// EventTypes lists the known event types
+var EventTypes = []EventType{
+	EventMisc,
+	EventError,
+	EventDeploymentStarted,
+	EventDeploymentSuccess,
+	EventDeploymentError,
+	EventConfigurationUpdated,
+	EventPasswordUpdated,
+	EventSessionReused,
+	EventStackStatusChanged,
+	EventStackUnhealthy,
+	EventStackRecovered,
+	EventLog,
+}
+
+// IsValid checks if the event type is a known event type
+func (e EventType) IsValid() bool {
+	return slices.Contains(EventTypes, e)
+}
+
 // ToText returns a human-readable string representation of the event type,
 func (e EventType) ToText() string {
 	switch e {
@@ -50,6 +86,14 @@ func (e EventType) ToText() string {
 		return "Password updated"
 	case EventSessionReused:
 		return "Session reused"
+	case EventStackStatusChanged:
+		return "Stack status changed"
+	case EventStackUnhealthy:
+		return "Stack unhealthy"
+	case EventStackRecovered:
+		return "Stack recovered"
+	case EventLog:
+		return "Command output"
 	default:
 		return "Unknown event type: " + string(e)
 	}
@@ -74,6 +118,14 @@ func (e EventType) ToEmoji() string {
 		return "🔑"
 	case EventSessionReused:
 		return "🔐"
+	case EventStackStatusChanged:
+		return "🩺"
+	case EventStackUnhealthy:
+		return "🚑"
+	case EventStackRecovered:
+		return "💚"
+	case EventLog:
+		return "📄"
 	default:
 		return "❓"
 	}
@@ -88,4 +140,7 @@ type Event struct {
 	ObjectID       uint64    `gorm:"index"`
 	ObjectName     string
 	IsNotification bool
+	// Service and Stream are the origin of the log events, they aren't stored
+	Service string    `gorm:"-"`
+	Stream  LogStream `gorm:"-"`
 }
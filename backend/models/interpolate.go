@@ -0,0 +1,220 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/elliotchance/orderedmap/v3"
+)
+
+var (
+	// ErrInterpolation is returned when a configuration value can't be interpolated
+	ErrInterpolation = errors.New("interpolation error")
+	// errComposeExpression is returned by evaluate for the expressions left to docker compose
+	errComposeExpression = errors.New("docker compose expression")
+
+	functionRegexp = regexp.MustCompile(`^([A-Za-z_][A-Za-z0-9_]*)\((.*)\)$`)
+	// composeModifierRegexp matches the ${NAME:-default}, ${NAME?error}... expressions of docker compose
+	composeModifierRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*:?[-?+]`)
+	// variableRegexp matches the plain ${NAME} expressions
+	variableRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+)
+
+// SecretFunc is the name of the function returning the value of a stored secret,
+// it resolves the secret references embedded in other values
+const SecretFunc = "secret"
+
+// TemplateFunc computes the value of a ${name(args...)} expression in configuration values
+type TemplateFunc func(args ...string) (string, error)
+
+// TemplateFuncs are the functions available in configuration values, by name
+type TemplateFuncs map[string]TemplateFunc
+
+// interpolator resolves the ${...} expressions of configuration values,
+// resolved values are cached so that each expression is evaluated once
+type interpolator struct {
+	cfg      Config
+	funcs    TemplateFuncs
+	raw      map[string]*orderedmap.OrderedMap[string, string]
+	resolved map[string]string
+	// stack of the values being resolved, used to detect cycles
+	stack []string
+}
+
+func newInterpolator(cfg Config, funcs TemplateFuncs) *interpolator {
+	return &interpolator{
+		cfg:      cfg,
+		funcs:    funcs,
+		raw:      make(map[string]*orderedmap.OrderedMap[string, string]),
+		resolved: make(map[string]string),
+	}
+}
+
+// resolve returns the interpolated value of key, as seen by service
+func (in *interpolator) resolve(service, key string) (string, error) {
+	ref := service + "." + key
+	if value, ok := in.resolved[ref]; ok {
+		return value, nil
+	}
+	for i, visiting := range in.stack {
+		if visiting == ref {
+			cycle := append(slices.Clone(in.stack[i:]), ref)
+			return "", fmt.Errorf("cycle detected %s", strings.Join(cycle, " -> "))
+		}
+	}
+
+	raw, ok := in.rawValues(service).Get(key)
+	if !ok {
+		return "", fmt.Errorf("unknown variable %s", ref)
+	}
+	in.stack = append(in.stack, ref)
+	value, err := in.interpolate(service, raw)
+	in.stack = in.stack[:len(in.stack)-1]
+	if err != nil {
+		return "", err
+	}
+	in.resolved[ref] = value
+	return value, nil
+}
+
+func (in *interpolator) rawValues(service string) *orderedmap.OrderedMap[string, string] {
+	values, ok := in.raw[service]
+	if !ok {
+		values = in.cfg.rawPerService(service)
+		in.raw[service] = values
+	}
+	return values
+}
+
+// interpolate replaces the ${...} expressions of value. The result is read by docker compose :
+// the expressions AutoNAS doesn't resolve are kept for it, $${ is kept to write a literal ${
+// and the $ of the function results are escaped
+func (in *interpolator) interpolate(service, value string) (string, error) {
+	var result strings.Builder
+	for i := 0; i < len(value); i++ {
+		if strings.HasPrefix(value[i:], "$$") {
+			result.WriteString("$$")
+			i++
+			continue
+		}
+		if !strings.HasPrefix(value[i:], "${") {
+			result.WriteByte(value[i])
+			continue
+		}
+		end := closingBrace(value, i+2)
+		if end < 0 {
+			return "", fmt.Errorf("unterminated expression in %q", value)
+		}
+		resolved, err := in.evaluate(service, strings.TrimSpace(value[i+2:end]))
+		if errors.Is(err, errComposeExpression) {
+			resolved = value[i : end+1]
+		} else if err != nil {
+			return "", err
+		} else if i > 0 || end < len(value)-1 {
+			// a whole value keeps the reference, it's resolved when the files are generated
+			if resolved, err = in.embedSecret(resolved); err != nil {
+				return "", err
+			}
+		}
+		result.WriteString(resolved)
+		i = end
+	}
+	return result.String(), nil
+}
+
+// embedSecret returns the escaped value of the secret when value is a secret reference
+// embedded in another value, the other values are returned as is
+func (in *interpolator) embedSecret(value string) (string, error) {
+	name, asFile, ok := ParseSecretReference(value)
+	if !ok {
+		return value, nil
+	}
+	if asFile {
+		return "", fmt.Errorf("the secret file %s can't be embedded in a value, reference it as a whole value", name)
+	}
+	fn, ok := in.funcs[SecretFunc]
+	if !ok {
+		return "", fmt.Errorf("can't embed the secret %s : no secret store", name)
+	}
+	secret, err := fn(name)
+	return strings.ReplaceAll(secret, "$", "$$"), err
+}
+
+// closingBrace returns the index of the brace closing the expression starting at start,
+// the nested expressions (ex: ${NAME:-${OTHER}}) are skipped. It returns -1 when there is none
+func closingBrace(value string, start int) int {
+	depth := 0
+	for i := start; i < len(value); i++ {
+		switch value[i] {
+		case '{':
+			depth++
+		case '}':
+			if depth == 0 {
+				return i
+			}
+			depth--
+		}
+	}
+	return -1
+}
+
+// evaluate returns the value of an expression :
+// NAME, services.<service>.NAME or function(args...).
+// The unknown names and the expressions with a default value or an error message
+// (ex: ${UID:-1000}) are left to docker compose
+func (in *interpolator) evaluate(service, expr string) (string, error) {
+	if matches := functionRegexp.FindStringSubmatch(expr); matches != nil {
+		fn, ok := in.funcs[matches[1]]
+		if !ok {
+			return "", fmt.Errorf("unknown function %s", matches[1])
+		}
+		var args []string
+		if strings.TrimSpace(matches[2]) != "" {
+			for _, arg := range strings.Split(matches[2], ",") {
+				args = append(args, strings.TrimSpace(arg))
+			}
+		}
+		value, err := fn(args...)
+		return strings.ReplaceAll(value, "$", "$$"), err
+	}
+	if ref, ok := strings.CutPrefix(expr, "services."); ok {
+		refService, key, found := strings.Cut(ref, ".")
+		if !found || key == "" {
+			return "", fmt.Errorf("invalid reference %s, expected services.<service>.<variable>", expr)
+		}
+		if _, exists := in.cfg.Services[refService]; !exists {
+			return "", fmt.Errorf("unknown service %s", refService)
+		}
+		return in.resolve(refService, strings.ToUpper(key))
+	}
+	if expr == "" {
+		return "", errors.New("empty expression")
+	}
+	if composeModifierRegexp.MatchString(expr) {
+		return "", errComposeExpression
+	}
+	if !variableRegexp.MatchString(expr) {
+		return "", fmt.Errorf("invalid expression %s", expr)
+	}
+	if !in.rawValues(service).Has(strings.ToUpper(expr)) {
+		// not defined in the configuration, it's resolved by docker compose from its environment
+		return "", errComposeExpression
+	}
+	return in.resolve(service, strings.ToUpper(expr))
+}
+
+// resolveAll interpolates all the values of a service
+func (in *interpolator) resolveAll(service string) (*orderedmap.OrderedMap[string, string], error) {
+	serviceConfig := orderedmap.NewOrderedMap[string, string]()
+	for key := range in.rawValues(service).Keys() {
+		value, err := in.resolve(service, key)
+		if err != nil {
+			return nil, fmt.Errorf("%w : %s.%s : %w", ErrInterpolation, service, key, err)
+		}
+		serviceConfig.Set(key, value)
+	}
+	return serviceConfig, nil
+}
@@ -0,0 +1,117 @@
+package models
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPerService_Interpolation(t *testing.T) {
+	cfg := Config{
+		Environment: Environment{
+			"DATA_PATH": "/data",
+			"DOMAIN":    "nas.local",
+		},
+		Services: map[string]ServiceConfig{
+			"db": {
+				"PORT":   "5432",
+				"VOLUME": "${DATA_PATH}/db",
+			},
+			"app": {
+				"DB_URL":   "postgres://${domain}:${services.db.PORT}",
+				"VOLUME":   "${ DATA_PATH }/app",
+				"ESCAPED":  "$${DATA_PATH}",
+				"IP":       "${hostIP()}",
+				"ARGS":     "${join(a, b)}",
+				"APP_HOME": "${HOME}/app",
+				"USER_ID":  "${UID:-${DEFAULT_UID}}",
+				"PASS":     "${dollar()}",
+			},
+		},
+	}
+	funcs := TemplateFuncs{
+		"hostIP": func(_ ...string) (string, error) { return "10.0.0.2", nil },
+		"join": func(args ...string) (string, error) {
+			return args[0] + "+" + args[1], nil
+		},
+		"dollar": func(_ ...string) (string, error) { return "pa$word", nil },
+	}
+
+	got, err := cfg.PerService("app", funcs)
+
+	assert.NoError(t, err)
+	values := map[string]string{}
+	for key, value := range got.AllFromFront() {
+		values[key] = value
+	}
+	assert.Equal(t, map[string]string{
+		"DATA_PATH": "/data",
+		"DOMAIN":    "nas.local",
+		"ARGS":      "a+b",
+		"DB_URL":    "postgres://nas.local:5432",
+		"ESCAPED":   "$${DATA_PATH}",
+		"IP":        "10.0.0.2",
+		"VOLUME":    "/data/app",
+		"APP_HOME":  "${HOME}/app",
+		"USER_ID":   "${UID:-${DEFAULT_UID}}",
+		"PASS":      "pa$$word",
+	}, values)
+}
+
+func TestPerService_InterpolationErrors(t *testing.T) {
+	testCases := []struct {
+		name    string
+		values  ServiceConfig
+		wantErr string
+	}{
+		{name: "unknown variable", values: ServiceConfig{"A": "${services.svc.MISSING}"}, wantErr: "unknown variable svc.MISSING"},
+		{name: "unknown service", values: ServiceConfig{"A": "${services.other.B}"}, wantErr: "unknown service other"},
+		{name: "invalid reference", values: ServiceConfig{"A": "${services.svc}"}, wantErr: "invalid reference"},
+		{name: "unknown function", values: ServiceConfig{"A": "${nope()}"}, wantErr: "unknown function nope"},
+		{name: "unterminated", values: ServiceConfig{"A": "${B"}, wantErr: "unterminated expression"},
+		{name: "unterminated nested", values: ServiceConfig{"A": "${B:-${C}"}, wantErr: "unterminated expression"},
+		{name: "invalid expression", values: ServiceConfig{"A": "${B C}"}, wantErr: "invalid expression B C"},
+		{name: "cycle", values: ServiceConfig{"A": "${B}", "B": "${A}"}, wantErr: "cycle detected svc.A -> svc.B -> svc.A"},
+		{name: "function error", values: ServiceConfig{"A": "${fail()}"}, wantErr: "failed"},
+		{name: "embedded secret file", values: ServiceConfig{"A": "x${B}", "B": "secret-file://db"}, wantErr: "the secret file db can't be embedded"},
+		{name: "embedded secret without store", values: ServiceConfig{"A": "x${B}", "B": "secret://other"}, wantErr: "no secret store"},
+	}
+	funcs := TemplateFuncs{
+		"fail": func(_ ...string) (string, error) { return "", errors.New("failed") },
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := Config{Services: map[string]ServiceConfig{"svc": tc.values}}
+
+			_, err := cfg.PerService("svc", funcs)
+
+			assert.ErrorIs(t, err, ErrInterpolation)
+			assert.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestPerService_EmbeddedSecrets(t *testing.T) {
+	cfg := Config{
+		Environment: Environment{"DB_PASS": "secret://db"},
+		Services: map[string]ServiceConfig{
+			"app": {
+				"DB_URL":      "postgres://u:${DB_PASS}@db",
+				"DB_PASSWORD": "${DB_PASS}",
+			},
+		},
+	}
+	funcs := TemplateFuncs{
+		SecretFunc: func(args ...string) (string, error) { return args[0] + "-pa$word", nil },
+	}
+
+	got, err := cfg.PerService("app", funcs)
+
+	assert.NoError(t, err)
+	url, _ := got.Get("DB_URL")
+	assert.Equal(t, "postgres://u:db-pa$$word@db", url)
+	password, _ := got.Get("DB_PASSWORD")
+	assert.Equal(t, "secret://db", password, "the whole references are resolved by the generator")
+}
@@ -40,4 +40,6 @@ func (p DeploymentParams) GetDBDir() string {
 // ServerParams groups parameters related to the API server
 type ServerParams struct {
 	Port int
+	// MetricsToken is the bearer token required to scrape /metrics, the metrics are disabled when empty
+	MetricsToken string
 }
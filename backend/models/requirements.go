@@ -0,0 +1,11 @@
+package models
+
+// VariableRequirement describes a variable referenced by a compose file
+// that has no value in the stack's .env nor in the configuration.
+type VariableRequirement struct {
+	Name string
+	// Required is true when the variable is declared with ${VAR:?err} or ${VAR?err},
+	// in which case docker compose refuses to start the stack without it
+	Required bool
+	Message  string
+}
@@ -0,0 +1,55 @@
+package models
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Retention configures how long the deployment history is kept
+type Retention struct {
+	// MaxAge is the age after which deployments are deleted (ex: 90d, 720h), empty keeps them
+	MaxAge string `mapstructure:"maxAge,omitempty"`
+	// MaxCount is the number of deployments kept, 0 keeps them all
+	MaxCount int `mapstructure:"maxCount,omitempty"`
+	// CompressDiffs compresses the large diffs stored with the deployments
+	CompressDiffs bool `mapstructure:"compressDiffs,omitempty"`
+}
+
+// GetMaxAge returns the parsed max age, zero when not set. A number of days (ex: 30d) is accepted
+func (r Retention) GetMaxAge() (time.Duration, error) {
+	if r.MaxAge == "" {
+		return 0, nil
+	}
+	var maxAge time.Duration
+	if days, ok := strings.CutSuffix(r.MaxAge, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil {
+			return 0, fmt.Errorf("invalid number of days %q", r.MaxAge)
+		}
+		maxAge = time.Duration(n) * 24 * time.Hour
+	} else {
+		var err error
+		if maxAge, err = time.ParseDuration(r.MaxAge); err != nil {
+			return 0, err
+		}
+	}
+	if maxAge <= 0 {
+		return 0, fmt.Errorf("max age must be positive, got %s", r.MaxAge)
+	}
+	return maxAge, nil
+}
+
+// IsEnabled checks if old deployments are deleted or compressed
+func (r Retention) IsEnabled() bool {
+	return r.MaxAge != "" || r.MaxCount > 0 || r.CompressDiffs
+}
+
+// GetRetention returns the retention of the deployment history, everything is kept when not set
+func (settings Settings) GetRetention() Retention {
+	if settings.Retention == nil {
+		return Retention{}
+	}
+	return *settings.Retention
+}
@@ -0,0 +1,35 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetMaxAge(t *testing.T) {
+	maxAge, err := Retention{}.GetMaxAge()
+	assert.NoError(t, err)
+	assert.Zero(t, maxAge)
+
+	maxAge, err = Retention{MaxAge: "30d"}.GetMaxAge()
+	assert.NoError(t, err)
+	assert.Equal(t, 30*24*time.Hour, maxAge)
+
+	maxAge, err = Retention{MaxAge: "12h"}.GetMaxAge()
+	assert.NoError(t, err)
+	assert.Equal(t, 12*time.Hour, maxAge)
+
+	for _, invalid := range []string{"xd", "0d", "-1h", "month"} {
+		_, err = Retention{MaxAge: invalid}.GetMaxAge()
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestGetRetention(t *testing.T) {
+	assert.False(t, Settings{}.GetRetention().IsEnabled())
+
+	settings := Settings{Retention: &Retention{MaxCount: 100}}
+	assert.Equal(t, Retention{MaxCount: 100}, settings.GetRetention())
+	assert.True(t, settings.GetRetention().IsEnabled())
+}
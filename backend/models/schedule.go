@@ -0,0 +1,155 @@
+package models
+
+import (
+	"fmt"
+	"time"
+	// embeds the timezone database, the container image doesn't ship one
+	_ "time/tzdata"
+)
+
+// ScheduleName identifies a scheduled job
+type ScheduleName string
+
+// Scheduled jobs
+const (
+	// ScheduleSync checks the repo and the configuration and deploys the changes
+	ScheduleSync ScheduleName = "sync"
+	// ScheduleImageUpdate pulls the images of enabled services and recreates the updated containers
+	ScheduleImageUpdate ScheduleName = "imageUpdate"
+	// SchedulePrune removes the dangling images
+	SchedulePrune ScheduleName = "prune"
+)
+
+// ScheduleNames lists the known scheduled jobs, in display order
+var ScheduleNames = []ScheduleName{ScheduleSync, ScheduleImageUpdate, SchedulePrune}
+
+// IsValid checks if the name is a known scheduled job
+func (n ScheduleName) IsValid() bool {
+	for _, name := range ScheduleNames {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// IsDisruptive checks if the job restarts containers, disruptive jobs are deferred
+// during maintenance windows
+func (n ScheduleName) IsDisruptive() bool {
+	return n == ScheduleSync || n == ScheduleImageUpdate
+}
+
+// ScheduleSettings configures when a job runs
+type ScheduleSettings struct {
+	// Cron is a cron expression, 0 or empty disables the job
+	Cron string `mapstructure:"cron"`
+	// Jitter is a duration (ex: 5m), each run is delayed by a random time up to it
+	Jitter string `mapstructure:"jitter,omitempty"`
+}
+
+// IsEnabled checks if the job has a cron expression
+func (s ScheduleSettings) IsEnabled() bool {
+	return s.Cron != "" && s.Cron != "0"
+}
+
+// GetJitter returns the parsed jitter, zero when not set
+func (s ScheduleSettings) GetJitter() (time.Duration, error) {
+	if s.Jitter == "" {
+		return 0, nil
+	}
+	jitter, err := time.ParseDuration(s.Jitter)
+	if err != nil {
+		return 0, err
+	}
+	if jitter < 0 {
+		return 0, fmt.Errorf("negative jitter %s", s.Jitter)
+	}
+	return jitter, nil
+}
+
+// MaintenanceWindow is a recurring period during which disruptive jobs are deferred
+// until the end of the window
+type MaintenanceWindow struct {
+	// Cron is the cron expression of the start of the window
+	Cron string `mapstructure:"cron"`
+	// Duration is the length of the window (ex: 2h)
+	Duration string `mapstructure:"duration"`
+}
+
+// GetDuration returns the parsed duration of the window
+func (w MaintenanceWindow) GetDuration() (time.Duration, error) {
+	duration, err := time.ParseDuration(w.Duration)
+	if err != nil {
+		return 0, err
+	}
+	if duration <= 0 {
+		return 0, fmt.Errorf("duration must be positive, got %s", w.Duration)
+	}
+	return duration, nil
+}
+
+// GetSchedule returns the schedule of a job, the sync job falls back to the cron setting
+func (settings Settings) GetSchedule(name ScheduleName) ScheduleSettings {
+	if schedule, ok := settings.Schedules[name]; ok {
+		return schedule
+	}
+	if name == ScheduleSync {
+		return ScheduleSettings{Cron: settings.Cron}
+	}
+	return ScheduleSettings{}
+}
+
+// GetLocation returns the timezone used by the schedules, local time when not set
+func (settings Settings) GetLocation() (*time.Location, error) {
+	if settings.Timezone == "" {
+		return time.Local, nil
+	}
+	return time.LoadLocation(settings.Timezone)
+}
+
+// SameSchedules checks if both settings schedule the jobs identically
+func (settings Settings) SameSchedules(other Settings) bool {
+	if settings.Timezone != other.Timezone || len(settings.MaintenanceWindows) != len(other.MaintenanceWindows) {
+		return false
+	}
+	for i, window := range settings.MaintenanceWindows {
+		if window != other.MaintenanceWindows[i] {
+			return false
+		}
+	}
+	for _, name := range ScheduleNames {
+		if settings.GetSchedule(name) != other.GetSchedule(name) {
+			return false
+		}
+	}
+	return true
+}
+
+// Schedule describes a scheduled job and its next run
+type Schedule struct {
+	Name       ScheduleName
+	Cron       string
+	Jitter     string
+	Disruptive bool
+	// Next is the next time the cron fires, zero when the job is disabled
+	Next time.Time
+	// DeferredUntil is set when a run is waiting for the end of a maintenance window
+	DeferredUntil time.Time
+}
+
+// MaintenanceWindowState describes a maintenance window and its next occurrence
+type MaintenanceWindowState struct {
+	Cron     string
+	Duration string
+	Active   bool
+	// Start and End are the bounds of the current window when active, else of the next one
+	Start time.Time
+	End   time.Time
+}
+
+// Schedules describes all the scheduled jobs and maintenance windows
+type Schedules struct {
+	Timezone           string
+	Jobs               []Schedule
+	MaintenanceWindows []MaintenanceWindowState
+}
@@ -0,0 +1,39 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetSchedule(t *testing.T) {
+	settings := Settings{Cron: "@hourly"}
+	assert.Equal(t, ScheduleSettings{Cron: "@hourly"}, settings.GetSchedule(ScheduleSync))
+	assert.False(t, settings.GetSchedule(SchedulePrune).IsEnabled())
+
+	settings.Schedules = map[ScheduleName]ScheduleSettings{ScheduleSync: {Cron: "0"}}
+	assert.False(t, settings.GetSchedule(ScheduleSync).IsEnabled(), "named schedule replaces cron")
+}
+
+func TestSameSchedules(t *testing.T) {
+	settings := Settings{Cron: "@hourly", Repo: "a"}
+
+	assert.True(t, settings.SameSchedules(Settings{Repo: "b", Schedules: map[ScheduleName]ScheduleSettings{ScheduleSync: {Cron: "@hourly"}}}))
+	assert.False(t, settings.SameSchedules(Settings{Cron: "@hourly", Timezone: "UTC"}))
+	assert.False(t, settings.SameSchedules(Settings{Cron: "@hourly", MaintenanceWindows: []MaintenanceWindow{{Cron: "@daily", Duration: "1h"}}}))
+	assert.False(t, settings.SameSchedules(Settings{Cron: "@hourly", Schedules: map[ScheduleName]ScheduleSettings{SchedulePrune: {Cron: "@daily"}}}))
+}
+
+func TestGetJitter(t *testing.T) {
+	jitter, err := ScheduleSettings{}.GetJitter()
+	assert.NoError(t, err)
+	assert.Zero(t, jitter)
+
+	jitter, err = ScheduleSettings{Jitter: "90s"}.GetJitter()
+	assert.NoError(t, err)
+	assert.Equal(t, 90*time.Second, jitter)
+
+	_, err = ScheduleSettings{Jitter: "-1m"}.GetJitter()
+	assert.Error(t, err)
+}
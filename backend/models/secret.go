@@ -0,0 +1,75 @@
+package models
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	// SecretPrefix marks a configuration value as a reference to a stored secret
+	SecretPrefix = "secret://"
+
+	// SecretFilePrefix marks a configuration value as a reference to a stored secret
+	// that is written to a file, the variable then holds the path of that file
+	SecretFilePrefix = "secret-file://"
+)
+
+// ObfuscatedValue replaces the values of the sensitive variables when they are displayed
+const ObfuscatedValue = "******"
+
+// ErrSecretNotFound is returned when a referenced secret doesn't exist
+var ErrSecretNotFound = errors.New("secret not found")
+
+// sensitiveKeyParts are the parts of the variable names holding sensitive values
+var sensitiveKeyParts = []string{"PASSWORD", "SECRET", "TOKEN", "KEY"}
+
+// Secret is a named value stored encrypted in the database
+type Secret struct {
+	Name       string `gorm:"primaryKey"`
+	Ciphertext []byte `gorm:"not null"`
+	UpdatedAt  time.Time
+}
+
+// ParseSecretReference returns the name of the secret referenced by value,
+// and whether it should be written to a file
+func ParseSecretReference(value string) (name string, asFile bool, ok bool) {
+	if name, ok := strings.CutPrefix(value, SecretPrefix); ok {
+		return name, false, true
+	}
+	if name, ok := strings.CutPrefix(value, SecretFilePrefix); ok {
+		return name, true, true
+	}
+	return "", false, false
+}
+
+// IsSensitiveKey returns true when the name of the variable suggests a sensitive value,
+// like DB_PASSWORD or API_KEY
+func IsSensitiveKey(key string) bool {
+	key = strings.ToUpper(key)
+	return slices.ContainsFunc(sensitiveKeyParts, func(part string) bool {
+		return strings.Contains(key, part)
+	})
+}
+
+// ObfuscateVariable returns the value of the variable to display, the values of the sensitive
+// variables are replaced by ObfuscatedValue, except the secret references that don't hold the value
+func ObfuscateVariable(key, value string) string {
+	if _, _, isReference := ParseSecretReference(value); isReference || value == "" || !IsSensitiveKey(key) {
+		return value
+	}
+	return ObfuscatedValue
+}
+
+// ObfuscateVariables returns a copy of vars with the sensitive values obfuscated
+func ObfuscateVariables(vars map[string]string) map[string]string {
+	if vars == nil {
+		return nil
+	}
+	obfuscated := make(map[string]string, len(vars))
+	for key, value := range vars {
+		obfuscated[key] = ObfuscateVariable(key, value)
+	}
+	return obfuscated
+}
@@ -0,0 +1,31 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsSensitiveKey(t *testing.T) {
+	for _, key := range []string{"DB_PASSWORD", "jwt_secret", "ApiToken", "API_KEY"} {
+		assert.True(t, IsSensitiveKey(key), key)
+	}
+	for _, key := range []string{"DATA_PATH", "PORT", "USER"} {
+		assert.False(t, IsSensitiveKey(key), key)
+	}
+}
+
+func TestObfuscateVariables(t *testing.T) {
+	assert.Equal(t, map[string]string{
+		"DB_PASSWORD": ObfuscatedValue,
+		"API_KEY":     "secret://api-key",
+		"JWT_SECRET":  "",
+		"PORT":        "80",
+	}, ObfuscateVariables(map[string]string{
+		"DB_PASSWORD": "plain",
+		"API_KEY":     "secret://api-key",
+		"JWT_SECRET":  "",
+		"PORT":        "80",
+	}))
+	assert.Nil(t, ObfuscateVariables(nil))
+}
@@ -0,0 +1,29 @@
+package models
+
+import "slices"
+
+// StackAction is an action on the containers of a stack
+type StackAction string
+
+const (
+	// StackActionRestart restarts the containers
+	StackActionRestart StackAction = "restart"
+	// StackActionStop stops the containers
+	StackActionStop StackAction = "stop"
+	// StackActionStart starts the stopped containers
+	StackActionStart StackAction = "start"
+	// StackActionPullAndRecreate pulls the images then recreates all the containers
+	StackActionPullAndRecreate StackAction = "pull-and-recreate"
+	// StackActionDown stops and removes the containers
+	StackActionDown StackAction = "down"
+)
+
+// StackActions are all the supported actions
+var StackActions = []StackAction{
+	StackActionRestart, StackActionStop, StackActionStart, StackActionPullAndRecreate, StackActionDown,
+}
+
+// IsValid checks that the action is supported
+func (a StackAction) IsValid() bool {
+	return slices.Contains(StackActions, a)
+}
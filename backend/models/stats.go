@@ -0,0 +1,55 @@
+package models
+
+import (
+	"strings"
+	"time"
+)
+
+// DeploymentStats aggregates the deployments started in a time window
+type DeploymentStats struct {
+	Success int32
+	Error   int32
+	// MeanDuration and P95Duration are computed on the finished deployments
+	MeanDuration time.Duration
+	P95Duration  time.Duration
+	// MeanTimeToRecovery is the mean time between the end of a failed deployment
+	// and the end of the next successful one
+	MeanTimeToRecovery time.Duration
+	Services           []ServiceStats
+}
+
+// ServiceStats counts the finished deployments changing the files of a service
+type ServiceStats struct {
+	Service     string
+	Deployments int32
+	Errors      int32
+}
+
+// FailureRate returns the ratio of failed deployments, between 0 and 1
+func (s ServiceStats) FailureRate() float64 {
+	if s.Deployments == 0 {
+		return 0
+	}
+	return float64(s.Errors) / float64(s.Deployments)
+}
+
+// DailyStats counts the deployments finished with each status on a day (UTC)
+type DailyStats struct {
+	Day     time.Time
+	Success int32
+	Error   int32
+}
+
+// ServiceOfFile returns the service of a file of the config repo (services/<name>/...),
+// or an empty string when the file doesn't belong to a service
+func ServiceOfFile(path string) string {
+	rest, ok := strings.CutPrefix(path, "services/")
+	if !ok {
+		return ""
+	}
+	name, _, ok := strings.Cut(rest, "/")
+	if !ok {
+		return ""
+	}
+	return name
+}
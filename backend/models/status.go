@@ -1,6 +1,8 @@
 package models
 
 import (
+	"maps"
+	"slices"
 	"time"
 
 	"github.com/moby/moby/api/types/container"
@@ -19,12 +21,11 @@ const (
 
 // Stats defines model for Stats.
 type Stats struct {
+	DeploymentStats
 	Author     string
-	Error      int32
 	LastDeploy time.Time
 	LastStatus DeploymentStatus
 	NextDeploy time.Time
-	Success    int32
 	Health     StackStatus
 }
 
@@ -51,6 +52,11 @@ func (ss *StacksState) ForService(serviceName string) StackStatus {
 	return StackStatusUnknown
 }
 
+// Services returns the names of the services with a status, sorted
+func (ss *StacksState) Services() []string {
+	return slices.Sorted(maps.Keys(ss.services))
+}
+
 // ProgressiveUpdateServiceStatus updates the status of a service and propagates the change to the global status.
 func (ss *StacksState) ProgressiveUpdateServiceStatus(serviceName string, newStatus StackStatus) {
 	ss.services[serviceName] = getCombinedStatus(ss.ForService(serviceName), newStatus)
@@ -0,0 +1,39 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidConfig is returned when the configuration is invalid
+var ErrInvalidConfig = errors.New("invalid configuration")
+
+// FieldError is the error of an invalid configuration field,
+// fields are designated by their path (ex : settings.cron, services.db.PORT)
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+func (e FieldError) Error() string {
+	return fmt.Sprintf("%s : %s", e.Field, e.Message)
+}
+
+// ValidationError lists the invalid fields of a configuration
+type ValidationError struct {
+	Fields []FieldError
+}
+
+func (e *ValidationError) Error() string {
+	messages := make([]string, len(e.Fields))
+	for i, field := range e.Fields {
+		messages[i] = field.Error()
+	}
+	return fmt.Sprintf("%s : %s", ErrInvalidConfig, strings.Join(messages, ", "))
+}
+
+// Unwrap makes errors.Is(err, ErrInvalidConfig) true for validation errors
+func (e *ValidationError) Unwrap() error {
+	return ErrInvalidConfig
+}
@@ -0,0 +1,101 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// Defaults of the watchdog policies
+const (
+	DefaultUnhealthyChecks   = 3
+	DefaultRestartBackoff    = time.Minute
+	DefaultRestartMaxBackoff = 30 * time.Minute
+)
+
+// WatchdogPolicy configures how the watchdog handles an unhealthy stack,
+// the yaml tags keep the keys of the policies in Services when the config is written
+type WatchdogPolicy struct {
+	// Disabled stops watching the stack, no event is dispatched for it
+	Disabled bool `mapstructure:"disabled,omitempty" yaml:"disabled,omitempty"`
+	// Restart restarts the stack once it's unhealthy, otherwise it's only reported
+	Restart bool `mapstructure:"restart,omitempty" yaml:"restart,omitempty"`
+	// UnhealthyChecks is the number of consecutive unhealthy checks before the stack is
+	// reported and restarted, 3 by default
+	UnhealthyChecks int `mapstructure:"unhealthyChecks,omitempty" yaml:"unhealthyChecks,omitempty"`
+	// Backoff is the delay after a restart before the next one (ex: 1m), it doubles after
+	// each restart until the stack stays healthy during the backoff. 1 minute by default
+	Backoff string `mapstructure:"backoff,omitempty" yaml:"backoff,omitempty"`
+	// MaxBackoff caps the delay between two restarts, 30 minutes by default
+	MaxBackoff string `mapstructure:"maxBackoff,omitempty" yaml:"maxBackoff,omitempty"`
+}
+
+// Watchdog configures the health watchdog of the stacks, the policy applies to the stacks
+// without their own policy in Services
+type Watchdog struct {
+	WatchdogPolicy `mapstructure:",squash"`
+	Services       map[string]WatchdogPolicy `mapstructure:"services,omitempty"`
+}
+
+// GetWatchdog returns the watchdog settings, stacks are only reported when not set
+func (settings Settings) GetWatchdog() Watchdog {
+	if settings.Watchdog == nil {
+		return Watchdog{}
+	}
+	return *settings.Watchdog
+}
+
+// PolicyFor returns the policy of the stack, a policy in Services replaces the default one
+func (w Watchdog) PolicyFor(stack string) WatchdogPolicy {
+	if policy, ok := w.Services[stack]; ok {
+		return policy
+	}
+	return w.WatchdogPolicy
+}
+
+// GetUnhealthyChecks returns the number of unhealthy checks before acting
+func (p WatchdogPolicy) GetUnhealthyChecks() int {
+	if p.UnhealthyChecks <= 0 {
+		return DefaultUnhealthyChecks
+	}
+	return p.UnhealthyChecks
+}
+
+// GetBackoff returns the delay after the nth restart (starting at 1) before the next one
+func (p WatchdogPolicy) GetBackoff(restarts int) (time.Duration, error) {
+	backoff, err := p.GetFirstBackoff()
+	if err != nil {
+		return 0, err
+	}
+	maxBackoff, err := p.GetMaxBackoff()
+	if err != nil {
+		return 0, err
+	}
+	for i := 1; i < restarts && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, maxBackoff), nil
+}
+
+// GetFirstBackoff returns the parsed backoff, the delay after the first restart
+func (p WatchdogPolicy) GetFirstBackoff() (time.Duration, error) {
+	return parsePositiveDuration(p.Backoff, DefaultRestartBackoff)
+}
+
+// GetMaxBackoff returns the parsed max backoff
+func (p WatchdogPolicy) GetMaxBackoff() (time.Duration, error) {
+	return parsePositiveDuration(p.MaxBackoff, DefaultRestartMaxBackoff)
+}
+
+func parsePositiveDuration(value string, defaultValue time.Duration) (time.Duration, error) {
+	if value == "" {
+		return defaultValue, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, err
+	}
+	if d <= 0 {
+		return 0, fmt.Errorf("must be positive, got %s", value)
+	}
+	return d, nil
+}
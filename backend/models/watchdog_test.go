@@ -0,0 +1,42 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWatchdogPolicyFor(t *testing.T) {
+	watchdog := Watchdog{
+		WatchdogPolicy: WatchdogPolicy{Restart: true, UnhealthyChecks: 5},
+		Services:       map[string]WatchdogPolicy{"db": {Disabled: true}},
+	}
+	assert.Equal(t, WatchdogPolicy{Restart: true, UnhealthyChecks: 5}, watchdog.PolicyFor("web"))
+	assert.Equal(t, WatchdogPolicy{Disabled: true}, watchdog.PolicyFor("db"))
+
+	assert.Equal(t, DefaultUnhealthyChecks, Settings{}.GetWatchdog().PolicyFor("web").GetUnhealthyChecks())
+	assert.Equal(t, 5, watchdog.PolicyFor("web").GetUnhealthyChecks())
+}
+
+func TestWatchdogGetBackoff(t *testing.T) {
+	policy := WatchdogPolicy{}
+	for restarts, expected := range map[int]time.Duration{1: time.Minute, 2: 2 * time.Minute, 6: 30 * time.Minute} {
+		backoff, err := policy.GetBackoff(restarts)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, backoff, restarts)
+	}
+
+	policy = WatchdogPolicy{Backoff: "10s", MaxBackoff: "25s"}
+	backoff, err := policy.GetBackoff(2)
+	assert.NoError(t, err)
+	assert.Equal(t, 20*time.Second, backoff)
+	backoff, err = policy.GetBackoff(100)
+	assert.NoError(t, err)
+	assert.Equal(t, 25*time.Second, backoff)
+
+	for _, invalid := range []WatchdogPolicy{{Backoff: "soon"}, {Backoff: "0s"}, {MaxBackoff: "-1m"}} {
+		_, err = invalid.GetBackoff(1)
+		assert.Error(t, err, invalid)
+	}
+}
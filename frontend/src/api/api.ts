@@ -38,9 +38,28 @@ export type ConfigGlobalVariables = {[key: string]: string};
 
 export type ConfigServices = {[key: string]: {[key: string]: string}};
 
+export type ConfigOrigins = {[key: string]: string};
+
 export interface Config {
   globalVariables: ConfigGlobalVariables;
   services: ConfigServices;
+  /** File each value comes from, by path (ex : services.db.PORT), only set on resolved configurations */
+  origins?: ConfigOrigins;
+}
+
+export interface ConfigRevision {
+  id: string;
+  author: string;
+  message: string;
+  time: string;
+}
+
+export interface ConfigRevisionWithContent {
+  id: string;
+  author: string;
+  message: string;
+  time: string;
+  content: string;
 }
 
 export type ContainerHealth = typeof ContainerHealth[keyof typeof ContainerHealth];
@@ -74,6 +93,8 @@ export interface ContainerStatus {
   name: string;
   health: ContainerHealth;
   startedAt: string;
+  /** Last sample of the resources used by the container, while it's running */
+  usage?: unknown;
 }
 
 export interface Credentials {
@@ -81,6 +102,12 @@ export interface Credentials {
   password: string;
 }
 
+export interface DailyStats {
+  day: string;
+  success: number;
+  error: number;
+}
+
 export interface Deployment {
   id: string;
   title: string;
@@ -91,6 +118,14 @@ export interface Deployment {
   status: DeploymentStatus;
 }
 
+export interface DeploymentLog {
+  ID: number;
+  time: string;
+  service: string;
+  stream: LogStream;
+  line: string;
+}
+
 export type DeploymentStatus = typeof DeploymentStatus[keyof typeof DeploymentStatus];
 
 
@@ -117,6 +152,8 @@ export interface DeploymentWithDetails {
 export interface Error {
   code: ErrorCode;
   message: string;
+  /** Invalid fields of the request, by path (ex : settings.cron) */
+  fields?: FieldError[];
 }
 
 export type ErrorCode = typeof ErrorCode[keyof typeof ErrorCode];
@@ -130,6 +167,7 @@ export const ErrorCode = {
   NOT_ALLOWED: 'NOT_ALLOWED',
   DISABLED: 'DISABLED',
   NOT_FOUND: 'NOT_FOUND',
+  CONFLICT: 'CONFLICT',
   SERVER_ERROR: 'SERVER_ERROR',
 } as const;
 
@@ -140,6 +178,9 @@ export interface Event {
   type: EventType;
   objectId: number;
   objectName: string;
+  /** Service and stream of the LOG events */
+  service?: string;
+  stream?: LogStream;
 }
 
 export type EventType = typeof EventType[keyof typeof EventType];
@@ -155,6 +196,10 @@ export const EventType = {
   CONFIGURATION_UPDATED: 'CONFIGURATION_UPDATED',
   PASSWORD_UPDATED: 'PASSWORD_UPDATED',
   SESSION_REUSED: 'SESSION_REUSED',
+  STACK_STATUS_CHANGED: 'STACK_STATUS_CHANGED',
+  STACK_UNHEALTHY: 'STACK_UNHEALTHY',
+  STACK_RECOVERED: 'STACK_RECOVERED',
+  LOG: 'LOG',
 } as const;
 
 export interface Features {
@@ -164,17 +209,100 @@ export interface Features {
   editSettings: boolean;
 }
 
+export interface FieldError {
+  field: string;
+  message: string;
+}
+
 export interface FileDiff {
   oldFile: string;
   newFile: string;
   diff: string;
 }
 
+export type LogStream = typeof LogStream[keyof typeof LogStream];
+
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const LogStream = {
+  stdout: 'stdout',
+  stderr: 'stderr',
+} as const;
+
+export interface MaintenanceWindow {
+  cron: string;
+  duration: string;
+  active: boolean;
+  start: string;
+  end: string;
+}
+
 export interface PageInfo {
   hasNextPage: boolean;
   endCursor: string;
 }
 
+export interface ResourceUsage {
+  time: string;
+  /** Percentage of one CPU, 200 for two full CPUs */
+  cpuPercent: number;
+  memoryUsage: number;
+  /** Memory limit of the containers, the memory of the host when unlimited */
+  memoryLimit: number;
+  networkRx: number;
+  networkTx: number;
+  blockRead: number;
+  blockWrite: number;
+}
+
+export interface Schedule {
+  name: ScheduleName;
+  cron: string;
+  jitter?: string;
+  disruptive: boolean;
+  next?: string;
+  deferredUntil?: string;
+}
+
+export type ScheduleName = typeof ScheduleName[keyof typeof ScheduleName];
+
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const ScheduleName = {
+  sync: 'sync',
+  imageUpdate: 'imageUpdate',
+  prune: 'prune',
+} as const;
+
+export interface Schedules {
+  timezone: string;
+  jobs: Schedule[];
+  maintenanceWindows: MaintenanceWindow[];
+}
+
+export interface Secret {
+  name: string;
+  updatedAt: string;
+}
+
+export interface SecretValue {
+  name: string;
+  value: string;
+}
+
+export interface ServiceRequirements {
+  service: string;
+  missing: VariableRequirement[];
+}
+
+export interface ServiceStats {
+  service: string;
+  deployments: number;
+  errors: number;
+  /** Ratio of failed deployments, between 0 and 1 */
+  failureRate: number;
+}
+
 export interface Settings {
   repo: string;
   branch?: string;
@@ -183,12 +311,30 @@ export interface Settings {
   token?: string;
   notificationURL?: string;
   notificationTypes: EventType[];
+  /** Commit the config edits to the repo, or to a new branch, disabled when omitted */
+  writeBack?: unknown;
 }
 
+export type StackAction = typeof StackAction[keyof typeof StackAction];
+
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const StackAction = {
+  restart: 'restart',
+  stop: 'stop',
+  start: 'start',
+  pullandrecreate: 'pull-and-recreate',
+  down: 'down',
+} as const;
+
 export interface StackStatus {
   stackId: string;
   name: string;
   services: ContainerStatus[];
+  /** Resources used by the running containers of the stack */
+  usage?: unknown;
+  /** Last samples of the resources used by the stack, the oldest first */
+  usageHistory?: ResourceUsage[];
 }
 
 export interface Stats {
@@ -199,12 +345,25 @@ export interface Stats {
   author: string;
   status: DeploymentStatus;
   health: ContainerHealth;
+  /** Mean duration of the finished deployments, in seconds */
+  meanDuration: number;
+  /** 95th percentile of the duration of the finished deployments, in seconds */
+  p95Duration: number;
+  /** Mean time between a failed deployment and the next successful one, in seconds */
+  meanTimeToRecovery: number;
+  services: ServiceStats[];
 }
 
 export interface User {
   username: string;
 }
 
+export interface VariableRequirement {
+  name: string;
+  required: boolean;
+  message?: string;
+}
+
 export type Versions = typeof Versions[keyof typeof Versions];
 
 
@@ -213,10 +372,38 @@ export const Versions = {
   '10': '1.0',
 } as const;
 
+export type WriteBackMode = typeof WriteBackMode[keyof typeof WriteBackMode];
+
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const WriteBackMode = {
+  commit: 'commit',
+  branch: 'branch',
+} as const;
+
 export type AuthAPIRegistered200 = {
   registered: boolean;
 };
 
+export type ConfigAPIGetParams = {
+resolved?: boolean;
+};
+
+export type ConfigHistoryAPIListParams = {
+limit: number;
+offset?: string;
+};
+
+export type ConfigHistoryAPIList200 = {
+  items: ConfigRevision[];
+  pageInfo: PageInfo;
+};
+
+export type ConfigHistoryAPIDiffParams = {
+from: string;
+to: string;
+};
+
 export type DeployementAPIListParams = {
 limit: number;
 offset?: string;
@@ -227,6 +414,16 @@ export type DeployementAPIList200 = {
   pageInfo: PageInfo;
 };
 
+export type DeployementAPILogsParams = {
+limit: number;
+offset?: string;
+};
+
+export type DeployementAPILogs200 = {
+  items: DeploymentLog[];
+  pageInfo: PageInfo;
+};
+
 export type NotificationsAPIListParams = {
 limit: number;
 offset?: string;
@@ -242,6 +439,96 @@ export type UserAPIChangePasswordBody = {
   newPass: string;
 };
 
+/**
+ * tar.gz archive with a consistent copy of the database and the config file
+ */
+export const adminAPIBackup = (
+     options?: AxiosRequestConfig
+ ): Promise<AxiosResponse<Blob>> => {
+    
+    
+    return axios.default.get(
+      `/api/admin/backup`,{
+        responseType: 'blob',
+    ...options,}
+    );
+  }
+
+
+
+
+export const getAdminAPIBackupQueryKey = () => {
+    return [
+    `/api/admin/backup`
+    ] as const;
+    }
+
+    
+export const getAdminAPIBackupQueryOptions = <TData = Awaited<ReturnType<typeof adminAPIBackup>>, TError = AxiosError<Error>>( options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof adminAPIBackup>>, TError, TData>>, axios?: AxiosRequestConfig}
+) => {
+
+const {query: queryOptions, axios: axiosOptions} = options ?? {};
+
+  const queryKey =  queryOptions?.queryKey ?? getAdminAPIBackupQueryKey();
+
+  
+
+    const queryFn: QueryFunction<Awaited<ReturnType<typeof adminAPIBackup>>> = ({ signal }) => adminAPIBackup({ signal, ...axiosOptions });
+
+      
+
+      
+
+   return  { queryKey, queryFn, ...queryOptions} as UseQueryOptions<Awaited<ReturnType<typeof adminAPIBackup>>, TError, TData> & { queryKey: DataTag<QueryKey, TData, TError> }
+}
+
+export type AdminAPIBackupQueryResult = NonNullable<Awaited<ReturnType<typeof adminAPIBackup>>>
+export type AdminAPIBackupQueryError = AxiosError<Error>
+
+
+export function useAdminAPIBackup<TData = Awaited<ReturnType<typeof adminAPIBackup>>, TError = AxiosError<Error>>(
+  options: { query:Partial<UseQueryOptions<Awaited<ReturnType<typeof adminAPIBackup>>, TError, TData>> & Pick<
+        DefinedInitialDataOptions<
+          Awaited<ReturnType<typeof adminAPIBackup>>,
+          TError,
+          Awaited<ReturnType<typeof adminAPIBackup>>
+        > , 'initialData'
+      >, axios?: AxiosRequestConfig}
+ , queryClient?: QueryClient
+  ):  DefinedUseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+export function useAdminAPIBackup<TData = Awaited<ReturnType<typeof adminAPIBackup>>, TError = AxiosError<Error>>(
+  options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof adminAPIBackup>>, TError, TData>> & Pick<
+        UndefinedInitialDataOptions<
+          Awaited<ReturnType<typeof adminAPIBackup>>,
+          TError,
+          Awaited<ReturnType<typeof adminAPIBackup>>
+        > , 'initialData'
+      >, axios?: AxiosRequestConfig}
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+export function useAdminAPIBackup<TData = Awaited<ReturnType<typeof adminAPIBackup>>, TError = AxiosError<Error>>(
+  options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof adminAPIBackup>>, TError, TData>>, axios?: AxiosRequestConfig}
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+
+export function useAdminAPIBackup<TData = Awaited<ReturnType<typeof adminAPIBackup>>, TError = AxiosError<Error>>(
+  options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof adminAPIBackup>>, TError, TData>>, axios?: AxiosRequestConfig}
+ , queryClient?: QueryClient 
+ ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> } {
+
+  const queryOptions = getAdminAPIBackupQueryOptions(options)
+
+  const query = useQuery(queryOptions, queryClient) as  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> };
+
+  query.queryKey = queryOptions.queryKey ;
+
+  return query;
+}
+
+
+
+
+
 export const authAPILogin = (
     credentials: Credentials, options?: AxiosRequestConfig
  ): Promise<AxiosResponse<BooleanResponse>> => {
@@ -549,36 +836,41 @@ const {mutation: mutationOptions, axios: axiosOptions} = options ?
       return useMutation(mutationOptions, queryClient);
     }
     
+/**
+ * Get the configuration file, or the effective configuration merged with host overlays when resolved is true
+ */
 export const configAPIGet = (
-     options?: AxiosRequestConfig
+    params?: ConfigAPIGetParams, options?: AxiosRequestConfig
  ): Promise<AxiosResponse<Config>> => {
     
     
     return axios.default.get(
-      `/api/config`,options
+      `/api/config`,{
+    ...options,
+        params: {...params, ...options?.params},}
     );
   }
 
 
 
 
-export const getConfigAPIGetQueryKey = () => {
+export const getConfigAPIGetQueryKey = (params?: ConfigAPIGetParams,) => {
     return [
-    `/api/config`
+    `/api/config`, ...(params ? [params]: [])
     ] as const;
     }
 
     
-export const getConfigAPIGetQueryOptions = <TData = Awaited<ReturnType<typeof configAPIGet>>, TError = AxiosError<Error>>( options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof configAPIGet>>, TError, TData>>, axios?: AxiosRequestConfig}
+export const getConfigAPIGetQueryOptions = <TData = Awaited<ReturnType<typeof configAPIGet>>, TError = AxiosError<Error>>(params?: ConfigAPIGetParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof configAPIGet>>, TError, TData>>, axios?: AxiosRequestConfig}
 ) => {
 
 const {query: queryOptions, axios: axiosOptions} = options ?? {};
 
-  const queryKey =  queryOptions?.queryKey ?? getConfigAPIGetQueryKey();
+  const queryKey =  queryOptions?.queryKey ?? getConfigAPIGetQueryKey(params);
 
   
 
-    const queryFn: QueryFunction<Awaited<ReturnType<typeof configAPIGet>>> = ({ signal }) => configAPIGet({ signal, ...axiosOptions });
+    const queryFn: QueryFunction<Awaited<ReturnType<typeof configAPIGet>>> = ({ signal }) => configAPIGet(params, { signal, ...axiosOptions });
 
       
 
@@ -592,7 +884,7 @@ export type ConfigAPIGetQueryError = AxiosError<Error>
 
 
 export function useConfigAPIGet<TData = Awaited<ReturnType<typeof configAPIGet>>, TError = AxiosError<Error>>(
-  options: { query:Partial<UseQueryOptions<Awaited<ReturnType<typeof configAPIGet>>, TError, TData>> & Pick<
+ params?: ConfigAPIGetParams, options: { query:Partial<UseQueryOptions<Awaited<ReturnType<typeof configAPIGet>>, TError, TData>> & Pick<
         DefinedInitialDataOptions<
           Awaited<ReturnType<typeof configAPIGet>>,
           TError,
@@ -602,7 +894,7 @@ export function useConfigAPIGet<TData = Awaited<ReturnType<typeof configAPIGet>>
  , queryClient?: QueryClient
   ):  DefinedUseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
 export function useConfigAPIGet<TData = Awaited<ReturnType<typeof configAPIGet>>, TError = AxiosError<Error>>(
-  options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof configAPIGet>>, TError, TData>> & Pick<
+ params?: ConfigAPIGetParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof configAPIGet>>, TError, TData>> & Pick<
         UndefinedInitialDataOptions<
           Awaited<ReturnType<typeof configAPIGet>>,
           TError,
@@ -612,16 +904,16 @@ export function useConfigAPIGet<TData = Awaited<ReturnType<typeof configAPIGet>>
  , queryClient?: QueryClient
   ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
 export function useConfigAPIGet<TData = Awaited<ReturnType<typeof configAPIGet>>, TError = AxiosError<Error>>(
-  options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof configAPIGet>>, TError, TData>>, axios?: AxiosRequestConfig}
+ params?: ConfigAPIGetParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof configAPIGet>>, TError, TData>>, axios?: AxiosRequestConfig}
  , queryClient?: QueryClient
   ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
 
 export function useConfigAPIGet<TData = Awaited<ReturnType<typeof configAPIGet>>, TError = AxiosError<Error>>(
-  options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof configAPIGet>>, TError, TData>>, axios?: AxiosRequestConfig}
+ params?: ConfigAPIGetParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof configAPIGet>>, TError, TData>>, axios?: AxiosRequestConfig}
  , queryClient?: QueryClient 
  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> } {
 
-  const queryOptions = getConfigAPIGetQueryOptions(options)
+  const queryOptions = getConfigAPIGetQueryOptions(params,options)
 
   const query = useQuery(queryOptions, queryClient) as  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> };
 
@@ -634,6 +926,9 @@ export function useConfigAPIGet<TData = Awaited<ReturnType<typeof configAPIGet>>
 
 
 
+/**
+ * Update the configuration, fails with 412 when If-Match doesn't match the current version of the config file
+ */
 export const configAPISet = (
     config: Config, options?: AxiosRequestConfig
  ): Promise<AxiosResponse<Config>> => {
@@ -691,15 +986,15 @@ const {mutation: mutationOptions, axios: axiosOptions} = options ?
     }
     
 /**
- * List deployments
+ * List revisions of the config file, from the most recent one
  */
-export const deployementAPIList = (
-    params: DeployementAPIListParams, options?: AxiosRequestConfig
- ): Promise<AxiosResponse<DeployementAPIList200>> => {
+export const configHistoryAPIList = (
+    params: ConfigHistoryAPIListParams, options?: AxiosRequestConfig
+ ): Promise<AxiosResponse<ConfigHistoryAPIList200>> => {
     
     
     return axios.default.get(
-      `/api/deployment`,{
+      `/api/config/history`,{
     ...options,
         params: {...params, ...options?.params},}
     );
@@ -708,66 +1003,66 @@ export const deployementAPIList = (
 
 
 
-export const getDeployementAPIListQueryKey = (params?: DeployementAPIListParams,) => {
+export const getConfigHistoryAPIListQueryKey = (params?: ConfigHistoryAPIListParams,) => {
     return [
-    `/api/deployment`, ...(params ? [params]: [])
+    `/api/config/history`, ...(params ? [params]: [])
     ] as const;
     }
 
     
-export const getDeployementAPIListQueryOptions = <TData = Awaited<ReturnType<typeof deployementAPIList>>, TError = AxiosError<Error>>(params: DeployementAPIListParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof deployementAPIList>>, TError, TData>>, axios?: AxiosRequestConfig}
+export const getConfigHistoryAPIListQueryOptions = <TData = Awaited<ReturnType<typeof configHistoryAPIList>>, TError = AxiosError<Error>>(params: ConfigHistoryAPIListParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof configHistoryAPIList>>, TError, TData>>, axios?: AxiosRequestConfig}
 ) => {
 
 const {query: queryOptions, axios: axiosOptions} = options ?? {};
 
-  const queryKey =  queryOptions?.queryKey ?? getDeployementAPIListQueryKey(params);
+  const queryKey =  queryOptions?.queryKey ?? getConfigHistoryAPIListQueryKey(params);
 
   
 
-    const queryFn: QueryFunction<Awaited<ReturnType<typeof deployementAPIList>>> = ({ signal }) => deployementAPIList(params, { signal, ...axiosOptions });
+    const queryFn: QueryFunction<Awaited<ReturnType<typeof configHistoryAPIList>>> = ({ signal }) => configHistoryAPIList(params, { signal, ...axiosOptions });
 
       
 
       
 
-   return  { queryKey, queryFn, ...queryOptions} as UseQueryOptions<Awaited<ReturnType<typeof deployementAPIList>>, TError, TData> & { queryKey: DataTag<QueryKey, TData, TError> }
+   return  { queryKey, queryFn, ...queryOptions} as UseQueryOptions<Awaited<ReturnType<typeof configHistoryAPIList>>, TError, TData> & { queryKey: DataTag<QueryKey, TData, TError> }
 }
 
-export type DeployementAPIListQueryResult = NonNullable<Awaited<ReturnType<typeof deployementAPIList>>>
-export type DeployementAPIListQueryError = AxiosError<Error>
+export type ConfigHistoryAPIListQueryResult = NonNullable<Awaited<ReturnType<typeof configHistoryAPIList>>>
+export type ConfigHistoryAPIListQueryError = AxiosError<Error>
 
 
-export function useDeployementAPIList<TData = Awaited<ReturnType<typeof deployementAPIList>>, TError = AxiosError<Error>>(
- params: DeployementAPIListParams, options: { query:Partial<UseQueryOptions<Awaited<ReturnType<typeof deployementAPIList>>, TError, TData>> & Pick<
+export function useConfigHistoryAPIList<TData = Awaited<ReturnType<typeof configHistoryAPIList>>, TError = AxiosError<Error>>(
+ params: ConfigHistoryAPIListParams, options: { query:Partial<UseQueryOptions<Awaited<ReturnType<typeof configHistoryAPIList>>, TError, TData>> & Pick<
         DefinedInitialDataOptions<
-          Awaited<ReturnType<typeof deployementAPIList>>,
+          Awaited<ReturnType<typeof configHistoryAPIList>>,
           TError,
-          Awaited<ReturnType<typeof deployementAPIList>>
+          Awaited<ReturnType<typeof configHistoryAPIList>>
         > , 'initialData'
       >, axios?: AxiosRequestConfig}
  , queryClient?: QueryClient
   ):  DefinedUseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
-export function useDeployementAPIList<TData = Awaited<ReturnType<typeof deployementAPIList>>, TError = AxiosError<Error>>(
- params: DeployementAPIListParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof deployementAPIList>>, TError, TData>> & Pick<
+export function useConfigHistoryAPIList<TData = Awaited<ReturnType<typeof configHistoryAPIList>>, TError = AxiosError<Error>>(
+ params: ConfigHistoryAPIListParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof configHistoryAPIList>>, TError, TData>> & Pick<
         UndefinedInitialDataOptions<
-          Awaited<ReturnType<typeof deployementAPIList>>,
+          Awaited<ReturnType<typeof configHistoryAPIList>>,
           TError,
-          Awaited<ReturnType<typeof deployementAPIList>>
+          Awaited<ReturnType<typeof configHistoryAPIList>>
         > , 'initialData'
       >, axios?: AxiosRequestConfig}
  , queryClient?: QueryClient
   ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
-export function useDeployementAPIList<TData = Awaited<ReturnType<typeof deployementAPIList>>, TError = AxiosError<Error>>(
- params: DeployementAPIListParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof deployementAPIList>>, TError, TData>>, axios?: AxiosRequestConfig}
+export function useConfigHistoryAPIList<TData = Awaited<ReturnType<typeof configHistoryAPIList>>, TError = AxiosError<Error>>(
+ params: ConfigHistoryAPIListParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof configHistoryAPIList>>, TError, TData>>, axios?: AxiosRequestConfig}
  , queryClient?: QueryClient
   ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
 
-export function useDeployementAPIList<TData = Awaited<ReturnType<typeof deployementAPIList>>, TError = AxiosError<Error>>(
- params: DeployementAPIListParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof deployementAPIList>>, TError, TData>>, axios?: AxiosRequestConfig}
+export function useConfigHistoryAPIList<TData = Awaited<ReturnType<typeof configHistoryAPIList>>, TError = AxiosError<Error>>(
+ params: ConfigHistoryAPIListParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof configHistoryAPIList>>, TError, TData>>, axios?: AxiosRequestConfig}
  , queryClient?: QueryClient 
  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> } {
 
-  const queryOptions = getDeployementAPIListQueryOptions(params,options)
+  const queryOptions = getConfigHistoryAPIListQueryOptions(params,options)
 
   const query = useQuery(queryOptions, queryClient) as  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> };
 
@@ -781,139 +1076,83 @@ export function useDeployementAPIList<TData = Awaited<ReturnType<typeof deployem
 
 
 /**
- * Sync and deploy if changes
- */
-export const deployementAPISync = (
-     options?: AxiosRequestConfig
- ): Promise<AxiosResponse<DeploymentWithDetails | void>> => {
-    
-    
-    return axios.default.post(
-      `/api/deployment`,undefined,options
-    );
-  }
-
-
-
-export const getDeployementAPISyncMutationOptions = <TError = AxiosError<Error>,
-    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof deployementAPISync>>, TError,void, TContext>, axios?: AxiosRequestConfig}
-): UseMutationOptions<Awaited<ReturnType<typeof deployementAPISync>>, TError,void, TContext> => {
-
-const mutationKey = ['deployementAPISync'];
-const {mutation: mutationOptions, axios: axiosOptions} = options ?
-      options.mutation && 'mutationKey' in options.mutation && options.mutation.mutationKey ?
-      options
-      : {...options, mutation: {...options.mutation, mutationKey}}
-      : {mutation: { mutationKey, }, axios: undefined};
-
-      
-
-
-      const mutationFn: MutationFunction<Awaited<ReturnType<typeof deployementAPISync>>, void> = () => {
-          
-
-          return  deployementAPISync(axiosOptions)
-        }
-
-        
-
-
-  return  { mutationFn, ...mutationOptions }}
-
-    export type DeployementAPISyncMutationResult = NonNullable<Awaited<ReturnType<typeof deployementAPISync>>>
-    
-    export type DeployementAPISyncMutationError = AxiosError<Error>
-
-    export const useDeployementAPISync = <TError = AxiosError<Error>,
-    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof deployementAPISync>>, TError,void, TContext>, axios?: AxiosRequestConfig}
- , queryClient?: QueryClient): UseMutationResult<
-        Awaited<ReturnType<typeof deployementAPISync>>,
-        TError,
-        void,
-        TContext
-      > => {
-
-      const mutationOptions = getDeployementAPISyncMutationOptions(options);
-
-      return useMutation(mutationOptions, queryClient);
-    }
-    
-/**
- * Read deployment
+ * Changes between two revisions of the config file
  */
-export const deployementAPIRead = (
-    id: string, options?: AxiosRequestConfig
- ): Promise<AxiosResponse<DeploymentWithDetails>> => {
+export const configHistoryAPIDiff = (
+    params: ConfigHistoryAPIDiffParams, options?: AxiosRequestConfig
+ ): Promise<AxiosResponse<FileDiff>> => {
     
     
     return axios.default.get(
-      `/api/deployment/${id}`,options
+      `/api/config/history/diff`,{
+    ...options,
+        params: {...params, ...options?.params},}
     );
   }
 
 
 
 
-export const getDeployementAPIReadQueryKey = (id?: string,) => {
+export const getConfigHistoryAPIDiffQueryKey = (params?: ConfigHistoryAPIDiffParams,) => {
     return [
-    `/api/deployment/${id}`
+    `/api/config/history/diff`, ...(params ? [params]: [])
     ] as const;
     }
 
     
-export const getDeployementAPIReadQueryOptions = <TData = Awaited<ReturnType<typeof deployementAPIRead>>, TError = AxiosError<Error>>(id: string, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof deployementAPIRead>>, TError, TData>>, axios?: AxiosRequestConfig}
+export const getConfigHistoryAPIDiffQueryOptions = <TData = Awaited<ReturnType<typeof configHistoryAPIDiff>>, TError = AxiosError<Error>>(params: ConfigHistoryAPIDiffParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof configHistoryAPIDiff>>, TError, TData>>, axios?: AxiosRequestConfig}
 ) => {
 
 const {query: queryOptions, axios: axiosOptions} = options ?? {};
 
-  const queryKey =  queryOptions?.queryKey ?? getDeployementAPIReadQueryKey(id);
+  const queryKey =  queryOptions?.queryKey ?? getConfigHistoryAPIDiffQueryKey(params);
 
   
 
-    const queryFn: QueryFunction<Awaited<ReturnType<typeof deployementAPIRead>>> = ({ signal }) => deployementAPIRead(id, { signal, ...axiosOptions });
+    const queryFn: QueryFunction<Awaited<ReturnType<typeof configHistoryAPIDiff>>> = ({ signal }) => configHistoryAPIDiff(params, { signal, ...axiosOptions });
 
       
 
       
 
-   return  { queryKey, queryFn, enabled: !!(id), ...queryOptions} as UseQueryOptions<Awaited<ReturnType<typeof deployementAPIRead>>, TError, TData> & { queryKey: DataTag<QueryKey, TData, TError> }
+   return  { queryKey, queryFn, ...queryOptions} as UseQueryOptions<Awaited<ReturnType<typeof configHistoryAPIDiff>>, TError, TData> & { queryKey: DataTag<QueryKey, TData, TError> }
 }
 
-export type DeployementAPIReadQueryResult = NonNullable<Awaited<ReturnType<typeof deployementAPIRead>>>
-export type DeployementAPIReadQueryError = AxiosError<Error>
+export type ConfigHistoryAPIDiffQueryResult = NonNullable<Awaited<ReturnType<typeof configHistoryAPIDiff>>>
+export type ConfigHistoryAPIDiffQueryError = AxiosError<Error>
 
 
-export function useDeployementAPIRead<TData = Awaited<ReturnType<typeof deployementAPIRead>>, TError = AxiosError<Error>>(
- id: string, options: { query:Partial<UseQueryOptions<Awaited<ReturnType<typeof deployementAPIRead>>, TError, TData>> & Pick<
+export function useConfigHistoryAPIDiff<TData = Awaited<ReturnType<typeof configHistoryAPIDiff>>, TError = AxiosError<Error>>(
+ params: ConfigHistoryAPIDiffParams, options: { query:Partial<UseQueryOptions<Awaited<ReturnType<typeof configHistoryAPIDiff>>, TError, TData>> & Pick<
         DefinedInitialDataOptions<
-          Awaited<ReturnType<typeof deployementAPIRead>>,
+          Awaited<ReturnType<typeof configHistoryAPIDiff>>,
           TError,
-          Awaited<ReturnType<typeof deployementAPIRead>>
+          Awaited<ReturnType<typeof configHistoryAPIDiff>>
         > , 'initialData'
       >, axios?: AxiosRequestConfig}
  , queryClient?: QueryClient
   ):  DefinedUseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
-export function useDeployementAPIRead<TData = Awaited<ReturnType<typeof deployementAPIRead>>, TError = AxiosError<Error>>(
- id: string, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof deployementAPIRead>>, TError, TData>> & Pick<
+export function useConfigHistoryAPIDiff<TData = Awaited<ReturnType<typeof configHistoryAPIDiff>>, TError = AxiosError<Error>>(
+ params: ConfigHistoryAPIDiffParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof configHistoryAPIDiff>>, TError, TData>> & Pick<
         UndefinedInitialDataOptions<
-          Awaited<ReturnType<typeof deployementAPIRead>>,
+          Awaited<ReturnType<typeof configHistoryAPIDiff>>,
           TError,
-          Awaited<ReturnType<typeof deployementAPIRead>>
+          Awaited<ReturnType<typeof configHistoryAPIDiff>>
         > , 'initialData'
       >, axios?: AxiosRequestConfig}
  , queryClient?: QueryClient
   ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
-export function useDeployementAPIRead<TData = Awaited<ReturnType<typeof deployementAPIRead>>, TError = AxiosError<Error>>(
- id: string, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof deployementAPIRead>>, TError, TData>>, axios?: AxiosRequestConfig}
+export function useConfigHistoryAPIDiff<TData = Awaited<ReturnType<typeof configHistoryAPIDiff>>, TError = AxiosError<Error>>(
+ params: ConfigHistoryAPIDiffParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof configHistoryAPIDiff>>, TError, TData>>, axios?: AxiosRequestConfig}
  , queryClient?: QueryClient
   ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
 
-export function useDeployementAPIRead<TData = Awaited<ReturnType<typeof deployementAPIRead>>, TError = AxiosError<Error>>(
- id: string, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof deployementAPIRead>>, TError, TData>>, axios?: AxiosRequestConfig}
+export function useConfigHistoryAPIDiff<TData = Awaited<ReturnType<typeof configHistoryAPIDiff>>, TError = AxiosError<Error>>(
+ params: ConfigHistoryAPIDiffParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof configHistoryAPIDiff>>, TError, TData>>, axios?: AxiosRequestConfig}
  , queryClient?: QueryClient 
  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> } {
 
-  const queryOptions = getDeployementAPIReadQueryOptions(id,options)
+  const queryOptions = getConfigHistoryAPIDiffQueryOptions(params,options)
 
   const query = useQuery(queryOptions, queryClient) as  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> };
 
@@ -926,79 +1165,82 @@ export function useDeployementAPIRead<TData = Awaited<ReturnType<typeof deployem
 
 
 
-export const diffAPIGet = (
-     options?: AxiosRequestConfig
- ): Promise<AxiosResponse<FileDiff[]>> => {
+/**
+ * Read a revision of the config file, secrets are obfuscated
+ */
+export const configHistoryAPIRead = (
+    id: string, options?: AxiosRequestConfig
+ ): Promise<AxiosResponse<ConfigRevisionWithContent>> => {
     
     
     return axios.default.get(
-      `/api/diff`,options
+      `/api/config/history/${id}`,options
     );
   }
 
 
 
 
-export const getDiffAPIGetQueryKey = () => {
+export const getConfigHistoryAPIReadQueryKey = (id?: string,) => {
     return [
-    `/api/diff`
+    `/api/config/history/${id}`
     ] as const;
     }
 
     
-export const getDiffAPIGetQueryOptions = <TData = Awaited<ReturnType<typeof diffAPIGet>>, TError = AxiosError<Error>>( options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof diffAPIGet>>, TError, TData>>, axios?: AxiosRequestConfig}
+export const getConfigHistoryAPIReadQueryOptions = <TData = Awaited<ReturnType<typeof configHistoryAPIRead>>, TError = AxiosError<Error>>(id: string, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof configHistoryAPIRead>>, TError, TData>>, axios?: AxiosRequestConfig}
 ) => {
 
 const {query: queryOptions, axios: axiosOptions} = options ?? {};
 
-  const queryKey =  queryOptions?.queryKey ?? getDiffAPIGetQueryKey();
+  const queryKey =  queryOptions?.queryKey ?? getConfigHistoryAPIReadQueryKey(id);
 
   
 
-    const queryFn: QueryFunction<Awaited<ReturnType<typeof diffAPIGet>>> = ({ signal }) => diffAPIGet({ signal, ...axiosOptions });
+    const queryFn: QueryFunction<Awaited<ReturnType<typeof configHistoryAPIRead>>> = ({ signal }) => configHistoryAPIRead(id, { signal, ...axiosOptions });
 
       
 
       
 
-   return  { queryKey, queryFn, ...queryOptions} as UseQueryOptions<Awaited<ReturnType<typeof diffAPIGet>>, TError, TData> & { queryKey: DataTag<QueryKey, TData, TError> }
+   return  { queryKey, queryFn, enabled: !!(id), ...queryOptions} as UseQueryOptions<Awaited<ReturnType<typeof configHistoryAPIRead>>, TError, TData> & { queryKey: DataTag<QueryKey, TData, TError> }
 }
 
-export type DiffAPIGetQueryResult = NonNullable<Awaited<ReturnType<typeof diffAPIGet>>>
-export type DiffAPIGetQueryError = AxiosError<Error>
+export type ConfigHistoryAPIReadQueryResult = NonNullable<Awaited<ReturnType<typeof configHistoryAPIRead>>>
+export type ConfigHistoryAPIReadQueryError = AxiosError<Error>
 
 
-export function useDiffAPIGet<TData = Awaited<ReturnType<typeof diffAPIGet>>, TError = AxiosError<Error>>(
-  options: { query:Partial<UseQueryOptions<Awaited<ReturnType<typeof diffAPIGet>>, TError, TData>> & Pick<
+export function useConfigHistoryAPIRead<TData = Awaited<ReturnType<typeof configHistoryAPIRead>>, TError = AxiosError<Error>>(
+ id: string, options: { query:Partial<UseQueryOptions<Awaited<ReturnType<typeof configHistoryAPIRead>>, TError, TData>> & Pick<
         DefinedInitialDataOptions<
-          Awaited<ReturnType<typeof diffAPIGet>>,
+          Awaited<ReturnType<typeof configHistoryAPIRead>>,
           TError,
-          Awaited<ReturnType<typeof diffAPIGet>>
+          Awaited<ReturnType<typeof configHistoryAPIRead>>
         > , 'initialData'
       >, axios?: AxiosRequestConfig}
  , queryClient?: QueryClient
   ):  DefinedUseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
-export function useDiffAPIGet<TData = Awaited<ReturnType<typeof diffAPIGet>>, TError = AxiosError<Error>>(
-  options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof diffAPIGet>>, TError, TData>> & Pick<
+export function useConfigHistoryAPIRead<TData = Awaited<ReturnType<typeof configHistoryAPIRead>>, TError = AxiosError<Error>>(
+ id: string, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof configHistoryAPIRead>>, TError, TData>> & Pick<
         UndefinedInitialDataOptions<
-          Awaited<ReturnType<typeof diffAPIGet>>,
+          Awaited<ReturnType<typeof configHistoryAPIRead>>,
           TError,
-          Awaited<ReturnType<typeof diffAPIGet>>
+          Awaited<ReturnType<typeof configHistoryAPIRead>>
         > , 'initialData'
       >, axios?: AxiosRequestConfig}
  , queryClient?: QueryClient
   ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
-export function useDiffAPIGet<TData = Awaited<ReturnType<typeof diffAPIGet>>, TError = AxiosError<Error>>(
-  options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof diffAPIGet>>, TError, TData>>, axios?: AxiosRequestConfig}
+export function useConfigHistoryAPIRead<TData = Awaited<ReturnType<typeof configHistoryAPIRead>>, TError = AxiosError<Error>>(
+ id: string, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof configHistoryAPIRead>>, TError, TData>>, axios?: AxiosRequestConfig}
  , queryClient?: QueryClient
   ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
 
-export function useDiffAPIGet<TData = Awaited<ReturnType<typeof diffAPIGet>>, TError = AxiosError<Error>>(
-  options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof diffAPIGet>>, TError, TData>>, axios?: AxiosRequestConfig}
+export function useConfigHistoryAPIRead<TData = Awaited<ReturnType<typeof configHistoryAPIRead>>, TError = AxiosError<Error>>(
+ id: string, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof configHistoryAPIRead>>, TError, TData>>, axios?: AxiosRequestConfig}
  , queryClient?: QueryClient 
  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> } {
 
-  const queryOptions = getDiffAPIGetQueryOptions(options)
+  const queryOptions = getConfigHistoryAPIReadQueryOptions(id,options)
 
   const query = useQuery(queryOptions, queryClient) as  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> };
 
@@ -1011,166 +1253,140 @@ export function useDiffAPIGet<TData = Awaited<ReturnType<typeof diffAPIGet>>, TE
 
 
 
-export const featuresAPIGet = (
-     options?: AxiosRequestConfig
- ): Promise<AxiosResponse<Features>> => {
+/**
+ * Restore a revision of the config file, the restore is recorded as a new revision
+ */
+export const configHistoryAPIRestore = (
+    id: string, options?: AxiosRequestConfig
+ ): Promise<AxiosResponse<ConfigRevision>> => {
     
     
-    return axios.default.get(
-      `/api/features`,options
+    return axios.default.post(
+      `/api/config/history/${id}/restore`,undefined,options
     );
   }
 
 
 
+export const getConfigHistoryAPIRestoreMutationOptions = <TError = AxiosError<Error>,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof configHistoryAPIRestore>>, TError,{id: string}, TContext>, axios?: AxiosRequestConfig}
+): UseMutationOptions<Awaited<ReturnType<typeof configHistoryAPIRestore>>, TError,{id: string}, TContext> => {
 
-export const getFeaturesAPIGetQueryKey = () => {
-    return [
-    `/api/features`
-    ] as const;
-    }
-
-    
-export const getFeaturesAPIGetQueryOptions = <TData = Awaited<ReturnType<typeof featuresAPIGet>>, TError = AxiosError<Error>>( options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof featuresAPIGet>>, TError, TData>>, axios?: AxiosRequestConfig}
-) => {
-
-const {query: queryOptions, axios: axiosOptions} = options ?? {};
-
-  const queryKey =  queryOptions?.queryKey ?? getFeaturesAPIGetQueryKey();
-
-  
-
-    const queryFn: QueryFunction<Awaited<ReturnType<typeof featuresAPIGet>>> = ({ signal }) => featuresAPIGet({ signal, ...axiosOptions });
-
-      
+const mutationKey = ['configHistoryAPIRestore'];
+const {mutation: mutationOptions, axios: axiosOptions} = options ?
+      options.mutation && 'mutationKey' in options.mutation && options.mutation.mutationKey ?
+      options
+      : {...options, mutation: {...options.mutation, mutationKey}}
+      : {mutation: { mutationKey, }, axios: undefined};
 
       
 
-   return  { queryKey, queryFn, ...queryOptions} as UseQueryOptions<Awaited<ReturnType<typeof featuresAPIGet>>, TError, TData> & { queryKey: DataTag<QueryKey, TData, TError> }
-}
-
-export type FeaturesAPIGetQueryResult = NonNullable<Awaited<ReturnType<typeof featuresAPIGet>>>
-export type FeaturesAPIGetQueryError = AxiosError<Error>
-
-
-export function useFeaturesAPIGet<TData = Awaited<ReturnType<typeof featuresAPIGet>>, TError = AxiosError<Error>>(
-  options: { query:Partial<UseQueryOptions<Awaited<ReturnType<typeof featuresAPIGet>>, TError, TData>> & Pick<
-        DefinedInitialDataOptions<
-          Awaited<ReturnType<typeof featuresAPIGet>>,
-          TError,
-          Awaited<ReturnType<typeof featuresAPIGet>>
-        > , 'initialData'
-      >, axios?: AxiosRequestConfig}
- , queryClient?: QueryClient
-  ):  DefinedUseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
-export function useFeaturesAPIGet<TData = Awaited<ReturnType<typeof featuresAPIGet>>, TError = AxiosError<Error>>(
-  options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof featuresAPIGet>>, TError, TData>> & Pick<
-        UndefinedInitialDataOptions<
-          Awaited<ReturnType<typeof featuresAPIGet>>,
-          TError,
-          Awaited<ReturnType<typeof featuresAPIGet>>
-        > , 'initialData'
-      >, axios?: AxiosRequestConfig}
- , queryClient?: QueryClient
-  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
-export function useFeaturesAPIGet<TData = Awaited<ReturnType<typeof featuresAPIGet>>, TError = AxiosError<Error>>(
-  options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof featuresAPIGet>>, TError, TData>>, axios?: AxiosRequestConfig}
- , queryClient?: QueryClient
-  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
-
-export function useFeaturesAPIGet<TData = Awaited<ReturnType<typeof featuresAPIGet>>, TError = AxiosError<Error>>(
-  options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof featuresAPIGet>>, TError, TData>>, axios?: AxiosRequestConfig}
- , queryClient?: QueryClient 
- ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> } {
 
-  const queryOptions = getFeaturesAPIGetQueryOptions(options)
+      const mutationFn: MutationFunction<Awaited<ReturnType<typeof configHistoryAPIRestore>>, {id: string}> = (props) => {
+          const {id} = props ?? {};
 
-  const query = useQuery(queryOptions, queryClient) as  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> };
+          return  configHistoryAPIRestore(id,axiosOptions)
+        }
 
-  query.queryKey = queryOptions.queryKey ;
+        
 
-  return query;
-}
 
+  return  { mutationFn, ...mutationOptions }}
 
+    export type ConfigHistoryAPIRestoreMutationResult = NonNullable<Awaited<ReturnType<typeof configHistoryAPIRestore>>>
+    
+    export type ConfigHistoryAPIRestoreMutationError = AxiosError<Error>
 
+    export const useConfigHistoryAPIRestore = <TError = AxiosError<Error>,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof configHistoryAPIRestore>>, TError,{id: string}, TContext>, axios?: AxiosRequestConfig}
+ , queryClient?: QueryClient): UseMutationResult<
+        Awaited<ReturnType<typeof configHistoryAPIRestore>>,
+        TError,
+        {id: string},
+        TContext
+      > => {
 
+      const mutationOptions = getConfigHistoryAPIRestoreMutationOptions(options);
 
-export const notificationsAPIList = (
-    params: NotificationsAPIListParams, options?: AxiosRequestConfig
- ): Promise<AxiosResponse<NotificationsAPIList200>> => {
+      return useMutation(mutationOptions, queryClient);
+    }
+    
+/**
+ * List variables referenced by stacks' compose files that have no value
+ */
+export const configAPIRequirements = (
+     options?: AxiosRequestConfig
+ ): Promise<AxiosResponse<ServiceRequirements[]>> => {
     
     
     return axios.default.get(
-      `/api/notifications`,{
-    ...options,
-        params: {...params, ...options?.params},}
+      `/api/config/requirements`,options
     );
   }
 
 
 
 
-export const getNotificationsAPIListQueryKey = (params?: NotificationsAPIListParams,) => {
+export const getConfigAPIRequirementsQueryKey = () => {
     return [
-    `/api/notifications`, ...(params ? [params]: [])
+    `/api/config/requirements`
     ] as const;
     }
 
     
-export const getNotificationsAPIListQueryOptions = <TData = Awaited<ReturnType<typeof notificationsAPIList>>, TError = AxiosError<Error>>(params: NotificationsAPIListParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof notificationsAPIList>>, TError, TData>>, axios?: AxiosRequestConfig}
+export const getConfigAPIRequirementsQueryOptions = <TData = Awaited<ReturnType<typeof configAPIRequirements>>, TError = AxiosError<Error>>( options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof configAPIRequirements>>, TError, TData>>, axios?: AxiosRequestConfig}
 ) => {
 
 const {query: queryOptions, axios: axiosOptions} = options ?? {};
 
-  const queryKey =  queryOptions?.queryKey ?? getNotificationsAPIListQueryKey(params);
+  const queryKey =  queryOptions?.queryKey ?? getConfigAPIRequirementsQueryKey();
 
   
 
-    const queryFn: QueryFunction<Awaited<ReturnType<typeof notificationsAPIList>>> = ({ signal }) => notificationsAPIList(params, { signal, ...axiosOptions });
+    const queryFn: QueryFunction<Awaited<ReturnType<typeof configAPIRequirements>>> = ({ signal }) => configAPIRequirements({ signal, ...axiosOptions });
 
       
 
       
 
-   return  { queryKey, queryFn, ...queryOptions} as UseQueryOptions<Awaited<ReturnType<typeof notificationsAPIList>>, TError, TData> & { queryKey: DataTag<QueryKey, TData, TError> }
+   return  { queryKey, queryFn, ...queryOptions} as UseQueryOptions<Awaited<ReturnType<typeof configAPIRequirements>>, TError, TData> & { queryKey: DataTag<QueryKey, TData, TError> }
 }
 
-export type NotificationsAPIListQueryResult = NonNullable<Awaited<ReturnType<typeof notificationsAPIList>>>
-export type NotificationsAPIListQueryError = AxiosError<Error>
+export type ConfigAPIRequirementsQueryResult = NonNullable<Awaited<ReturnType<typeof configAPIRequirements>>>
+export type ConfigAPIRequirementsQueryError = AxiosError<Error>
 
 
-export function useNotificationsAPIList<TData = Awaited<ReturnType<typeof notificationsAPIList>>, TError = AxiosError<Error>>(
- params: NotificationsAPIListParams, options: { query:Partial<UseQueryOptions<Awaited<ReturnType<typeof notificationsAPIList>>, TError, TData>> & Pick<
+export function useConfigAPIRequirements<TData = Awaited<ReturnType<typeof configAPIRequirements>>, TError = AxiosError<Error>>(
+  options: { query:Partial<UseQueryOptions<Awaited<ReturnType<typeof configAPIRequirements>>, TError, TData>> & Pick<
         DefinedInitialDataOptions<
-          Awaited<ReturnType<typeof notificationsAPIList>>,
+          Awaited<ReturnType<typeof configAPIRequirements>>,
           TError,
-          Awaited<ReturnType<typeof notificationsAPIList>>
+          Awaited<ReturnType<typeof configAPIRequirements>>
         > , 'initialData'
       >, axios?: AxiosRequestConfig}
  , queryClient?: QueryClient
   ):  DefinedUseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
-export function useNotificationsAPIList<TData = Awaited<ReturnType<typeof notificationsAPIList>>, TError = AxiosError<Error>>(
- params: NotificationsAPIListParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof notificationsAPIList>>, TError, TData>> & Pick<
+export function useConfigAPIRequirements<TData = Awaited<ReturnType<typeof configAPIRequirements>>, TError = AxiosError<Error>>(
+  options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof configAPIRequirements>>, TError, TData>> & Pick<
         UndefinedInitialDataOptions<
-          Awaited<ReturnType<typeof notificationsAPIList>>,
+          Awaited<ReturnType<typeof configAPIRequirements>>,
           TError,
-          Awaited<ReturnType<typeof notificationsAPIList>>
+          Awaited<ReturnType<typeof configAPIRequirements>>
         > , 'initialData'
       >, axios?: AxiosRequestConfig}
  , queryClient?: QueryClient
   ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
-export function useNotificationsAPIList<TData = Awaited<ReturnType<typeof notificationsAPIList>>, TError = AxiosError<Error>>(
- params: NotificationsAPIListParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof notificationsAPIList>>, TError, TData>>, axios?: AxiosRequestConfig}
+export function useConfigAPIRequirements<TData = Awaited<ReturnType<typeof configAPIRequirements>>, TError = AxiosError<Error>>(
+  options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof configAPIRequirements>>, TError, TData>>, axios?: AxiosRequestConfig}
  , queryClient?: QueryClient
   ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
 
-export function useNotificationsAPIList<TData = Awaited<ReturnType<typeof notificationsAPIList>>, TError = AxiosError<Error>>(
- params: NotificationsAPIListParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof notificationsAPIList>>, TError, TData>>, axios?: AxiosRequestConfig}
+export function useConfigAPIRequirements<TData = Awaited<ReturnType<typeof configAPIRequirements>>, TError = AxiosError<Error>>(
+  options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof configAPIRequirements>>, TError, TData>>, axios?: AxiosRequestConfig}
  , queryClient?: QueryClient 
  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> } {
 
-  const queryOptions = getNotificationsAPIListQueryOptions(params,options)
+  const queryOptions = getConfigAPIRequirementsQueryOptions(options)
 
   const query = useQuery(queryOptions, queryClient) as  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> };
 
@@ -1183,79 +1399,84 @@ export function useNotificationsAPIList<TData = Awaited<ReturnType<typeof notifi
 
 
 
-export const settingsAPIGet = (
-     options?: AxiosRequestConfig
- ): Promise<AxiosResponse<Settings>> => {
+/**
+ * List deployments
+ */
+export const deployementAPIList = (
+    params: DeployementAPIListParams, options?: AxiosRequestConfig
+ ): Promise<AxiosResponse<DeployementAPIList200>> => {
     
     
     return axios.default.get(
-      `/api/settings`,options
+      `/api/deployment`,{
+    ...options,
+        params: {...params, ...options?.params},}
     );
   }
 
 
 
 
-export const getSettingsAPIGetQueryKey = () => {
+export const getDeployementAPIListQueryKey = (params?: DeployementAPIListParams,) => {
     return [
-    `/api/settings`
+    `/api/deployment`, ...(params ? [params]: [])
     ] as const;
     }
 
     
-export const getSettingsAPIGetQueryOptions = <TData = Awaited<ReturnType<typeof settingsAPIGet>>, TError = AxiosError<Error>>( options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof settingsAPIGet>>, TError, TData>>, axios?: AxiosRequestConfig}
+export const getDeployementAPIListQueryOptions = <TData = Awaited<ReturnType<typeof deployementAPIList>>, TError = AxiosError<Error>>(params: DeployementAPIListParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof deployementAPIList>>, TError, TData>>, axios?: AxiosRequestConfig}
 ) => {
 
 const {query: queryOptions, axios: axiosOptions} = options ?? {};
 
-  const queryKey =  queryOptions?.queryKey ?? getSettingsAPIGetQueryKey();
+  const queryKey =  queryOptions?.queryKey ?? getDeployementAPIListQueryKey(params);
 
   
 
-    const queryFn: QueryFunction<Awaited<ReturnType<typeof settingsAPIGet>>> = ({ signal }) => settingsAPIGet({ signal, ...axiosOptions });
+    const queryFn: QueryFunction<Awaited<ReturnType<typeof deployementAPIList>>> = ({ signal }) => deployementAPIList(params, { signal, ...axiosOptions });
 
       
 
       
 
-   return  { queryKey, queryFn, ...queryOptions} as UseQueryOptions<Awaited<ReturnType<typeof settingsAPIGet>>, TError, TData> & { queryKey: DataTag<QueryKey, TData, TError> }
+   return  { queryKey, queryFn, ...queryOptions} as UseQueryOptions<Awaited<ReturnType<typeof deployementAPIList>>, TError, TData> & { queryKey: DataTag<QueryKey, TData, TError> }
 }
 
-export type SettingsAPIGetQueryResult = NonNullable<Awaited<ReturnType<typeof settingsAPIGet>>>
-export type SettingsAPIGetQueryError = AxiosError<Error>
+export type DeployementAPIListQueryResult = NonNullable<Awaited<ReturnType<typeof deployementAPIList>>>
+export type DeployementAPIListQueryError = AxiosError<Error>
 
 
-export function useSettingsAPIGet<TData = Awaited<ReturnType<typeof settingsAPIGet>>, TError = AxiosError<Error>>(
-  options: { query:Partial<UseQueryOptions<Awaited<ReturnType<typeof settingsAPIGet>>, TError, TData>> & Pick<
+export function useDeployementAPIList<TData = Awaited<ReturnType<typeof deployementAPIList>>, TError = AxiosError<Error>>(
+ params: DeployementAPIListParams, options: { query:Partial<UseQueryOptions<Awaited<ReturnType<typeof deployementAPIList>>, TError, TData>> & Pick<
         DefinedInitialDataOptions<
-          Awaited<ReturnType<typeof settingsAPIGet>>,
+          Awaited<ReturnType<typeof deployementAPIList>>,
           TError,
-          Awaited<ReturnType<typeof settingsAPIGet>>
+          Awaited<ReturnType<typeof deployementAPIList>>
         > , 'initialData'
       >, axios?: AxiosRequestConfig}
  , queryClient?: QueryClient
   ):  DefinedUseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
-export function useSettingsAPIGet<TData = Awaited<ReturnType<typeof settingsAPIGet>>, TError = AxiosError<Error>>(
-  options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof settingsAPIGet>>, TError, TData>> & Pick<
+export function useDeployementAPIList<TData = Awaited<ReturnType<typeof deployementAPIList>>, TError = AxiosError<Error>>(
+ params: DeployementAPIListParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof deployementAPIList>>, TError, TData>> & Pick<
         UndefinedInitialDataOptions<
-          Awaited<ReturnType<typeof settingsAPIGet>>,
+          Awaited<ReturnType<typeof deployementAPIList>>,
           TError,
-          Awaited<ReturnType<typeof settingsAPIGet>>
+          Awaited<ReturnType<typeof deployementAPIList>>
         > , 'initialData'
       >, axios?: AxiosRequestConfig}
  , queryClient?: QueryClient
   ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
-export function useSettingsAPIGet<TData = Awaited<ReturnType<typeof settingsAPIGet>>, TError = AxiosError<Error>>(
-  options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof settingsAPIGet>>, TError, TData>>, axios?: AxiosRequestConfig}
+export function useDeployementAPIList<TData = Awaited<ReturnType<typeof deployementAPIList>>, TError = AxiosError<Error>>(
+ params: DeployementAPIListParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof deployementAPIList>>, TError, TData>>, axios?: AxiosRequestConfig}
  , queryClient?: QueryClient
   ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
 
-export function useSettingsAPIGet<TData = Awaited<ReturnType<typeof settingsAPIGet>>, TError = AxiosError<Error>>(
-  options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof settingsAPIGet>>, TError, TData>>, axios?: AxiosRequestConfig}
+export function useDeployementAPIList<TData = Awaited<ReturnType<typeof deployementAPIList>>, TError = AxiosError<Error>>(
+ params: DeployementAPIListParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof deployementAPIList>>, TError, TData>>, axios?: AxiosRequestConfig}
  , queryClient?: QueryClient 
  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> } {
 
-  const queryOptions = getSettingsAPIGetQueryOptions(options)
+  const queryOptions = getDeployementAPIListQueryOptions(params,options)
 
   const query = useQuery(queryOptions, queryClient) as  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> };
 
@@ -1268,24 +1489,26 @@ export function useSettingsAPIGet<TData = Awaited<ReturnType<typeof settingsAPIG
 
 
 
-export const settingsAPISet = (
-    settings: Settings, options?: AxiosRequestConfig
- ): Promise<AxiosResponse<Settings>> => {
+/**
+ * Sync and deploy if changes
+ */
+export const deployementAPISync = (
+     options?: AxiosRequestConfig
+ ): Promise<AxiosResponse<DeploymentWithDetails | void>> => {
     
     
     return axios.default.post(
-      `/api/settings`,
-      settings,options
+      `/api/deployment`,undefined,options
     );
   }
 
 
 
-export const getSettingsAPISetMutationOptions = <TError = AxiosError<Error>,
-    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof settingsAPISet>>, TError,{data: Settings}, TContext>, axios?: AxiosRequestConfig}
-): UseMutationOptions<Awaited<ReturnType<typeof settingsAPISet>>, TError,{data: Settings}, TContext> => {
+export const getDeployementAPISyncMutationOptions = <TError = AxiosError<Error>,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof deployementAPISync>>, TError,void, TContext>, axios?: AxiosRequestConfig}
+): UseMutationOptions<Awaited<ReturnType<typeof deployementAPISync>>, TError,void, TContext> => {
 
-const mutationKey = ['settingsAPISet'];
+const mutationKey = ['deployementAPISync'];
 const {mutation: mutationOptions, axios: axiosOptions} = options ?
       options.mutation && 'mutationKey' in options.mutation && options.mutation.mutationKey ?
       options
@@ -1295,10 +1518,10 @@ const {mutation: mutationOptions, axios: axiosOptions} = options ?
       
 
 
-      const mutationFn: MutationFunction<Awaited<ReturnType<typeof settingsAPISet>>, {data: Settings}> = (props) => {
-          const {data} = props ?? {};
+      const mutationFn: MutationFunction<Awaited<ReturnType<typeof deployementAPISync>>, void> = () => {
+          
 
-          return  settingsAPISet(data,axiosOptions)
+          return  deployementAPISync(axiosOptions)
         }
 
         
@@ -1306,24 +1529,1109 @@ const {mutation: mutationOptions, axios: axiosOptions} = options ?
 
   return  { mutationFn, ...mutationOptions }}
 
-    export type SettingsAPISetMutationResult = NonNullable<Awaited<ReturnType<typeof settingsAPISet>>>
-    export type SettingsAPISetMutationBody = Settings
-    export type SettingsAPISetMutationError = AxiosError<Error>
+    export type DeployementAPISyncMutationResult = NonNullable<Awaited<ReturnType<typeof deployementAPISync>>>
+    
+    export type DeployementAPISyncMutationError = AxiosError<Error>
 
-    export const useSettingsAPISet = <TError = AxiosError<Error>,
-    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof settingsAPISet>>, TError,{data: Settings}, TContext>, axios?: AxiosRequestConfig}
+    export const useDeployementAPISync = <TError = AxiosError<Error>,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof deployementAPISync>>, TError,void, TContext>, axios?: AxiosRequestConfig}
  , queryClient?: QueryClient): UseMutationResult<
-        Awaited<ReturnType<typeof settingsAPISet>>,
+        Awaited<ReturnType<typeof deployementAPISync>>,
         TError,
-        {data: Settings},
+        void,
         TContext
       > => {
 
-      const mutationOptions = getSettingsAPISetMutationOptions(options);
+      const mutationOptions = getDeployementAPISyncMutationOptions(options);
 
       return useMutation(mutationOptions, queryClient);
     }
     
+/**
+ * Read deployment
+ */
+export const deployementAPIRead = (
+    id: string, options?: AxiosRequestConfig
+ ): Promise<AxiosResponse<DeploymentWithDetails>> => {
+    
+    
+    return axios.default.get(
+      `/api/deployment/${id}`,options
+    );
+  }
+
+
+
+
+export const getDeployementAPIReadQueryKey = (id?: string,) => {
+    return [
+    `/api/deployment/${id}`
+    ] as const;
+    }
+
+    
+export const getDeployementAPIReadQueryOptions = <TData = Awaited<ReturnType<typeof deployementAPIRead>>, TError = AxiosError<Error>>(id: string, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof deployementAPIRead>>, TError, TData>>, axios?: AxiosRequestConfig}
+) => {
+
+const {query: queryOptions, axios: axiosOptions} = options ?? {};
+
+  const queryKey =  queryOptions?.queryKey ?? getDeployementAPIReadQueryKey(id);
+
+  
+
+    const queryFn: QueryFunction<Awaited<ReturnType<typeof deployementAPIRead>>> = ({ signal }) => deployementAPIRead(id, { signal, ...axiosOptions });
+
+      
+
+      
+
+   return  { queryKey, queryFn, enabled: !!(id), ...queryOptions} as UseQueryOptions<Awaited<ReturnType<typeof deployementAPIRead>>, TError, TData> & { queryKey: DataTag<QueryKey, TData, TError> }
+}
+
+export type DeployementAPIReadQueryResult = NonNullable<Awaited<ReturnType<typeof deployementAPIRead>>>
+export type DeployementAPIReadQueryError = AxiosError<Error>
+
+
+export function useDeployementAPIRead<TData = Awaited<ReturnType<typeof deployementAPIRead>>, TError = AxiosError<Error>>(
+ id: string, options: { query:Partial<UseQueryOptions<Awaited<ReturnType<typeof deployementAPIRead>>, TError, TData>> & Pick<
+        DefinedInitialDataOptions<
+          Awaited<ReturnType<typeof deployementAPIRead>>,
+          TError,
+          Awaited<ReturnType<typeof deployementAPIRead>>
+        > , 'initialData'
+      >, axios?: AxiosRequestConfig}
+ , queryClient?: QueryClient
+  ):  DefinedUseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+export function useDeployementAPIRead<TData = Awaited<ReturnType<typeof deployementAPIRead>>, TError = AxiosError<Error>>(
+ id: string, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof deployementAPIRead>>, TError, TData>> & Pick<
+        UndefinedInitialDataOptions<
+          Awaited<ReturnType<typeof deployementAPIRead>>,
+          TError,
+          Awaited<ReturnType<typeof deployementAPIRead>>
+        > , 'initialData'
+      >, axios?: AxiosRequestConfig}
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+export function useDeployementAPIRead<TData = Awaited<ReturnType<typeof deployementAPIRead>>, TError = AxiosError<Error>>(
+ id: string, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof deployementAPIRead>>, TError, TData>>, axios?: AxiosRequestConfig}
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+
+export function useDeployementAPIRead<TData = Awaited<ReturnType<typeof deployementAPIRead>>, TError = AxiosError<Error>>(
+ id: string, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof deployementAPIRead>>, TError, TData>>, axios?: AxiosRequestConfig}
+ , queryClient?: QueryClient 
+ ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> } {
+
+  const queryOptions = getDeployementAPIReadQueryOptions(id,options)
+
+  const query = useQuery(queryOptions, queryClient) as  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> };
+
+  query.queryKey = queryOptions.queryKey ;
+
+  return query;
+}
+
+
+
+
+
+/**
+ * Output of the commands run by the deployment, the latest lines first
+ */
+export const deployementAPILogs = (
+    id: string,
+    params: DeployementAPILogsParams, options?: AxiosRequestConfig
+ ): Promise<AxiosResponse<DeployementAPILogs200>> => {
+    
+    
+    return axios.default.get(
+      `/api/deployment/${id}/logs`,{
+    ...options,
+        params: {...params, ...options?.params},}
+    );
+  }
+
+
+
+
+export const getDeployementAPILogsQueryKey = (id?: string,
+    params?: DeployementAPILogsParams,) => {
+    return [
+    `/api/deployment/${id}/logs`, ...(params ? [params]: [])
+    ] as const;
+    }
+
+    
+export const getDeployementAPILogsQueryOptions = <TData = Awaited<ReturnType<typeof deployementAPILogs>>, TError = AxiosError<Error>>(id: string,
+    params: DeployementAPILogsParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof deployementAPILogs>>, TError, TData>>, axios?: AxiosRequestConfig}
+) => {
+
+const {query: queryOptions, axios: axiosOptions} = options ?? {};
+
+  const queryKey =  queryOptions?.queryKey ?? getDeployementAPILogsQueryKey(id,params);
+
+  
+
+    const queryFn: QueryFunction<Awaited<ReturnType<typeof deployementAPILogs>>> = ({ signal }) => deployementAPILogs(id, params, { signal, ...axiosOptions });
+
+      
+
+      
+
+   return  { queryKey, queryFn, enabled: !!(id), ...queryOptions} as UseQueryOptions<Awaited<ReturnType<typeof deployementAPILogs>>, TError, TData> & { queryKey: DataTag<QueryKey, TData, TError> }
+}
+
+export type DeployementAPILogsQueryResult = NonNullable<Awaited<ReturnType<typeof deployementAPILogs>>>
+export type DeployementAPILogsQueryError = AxiosError<Error>
+
+
+export function useDeployementAPILogs<TData = Awaited<ReturnType<typeof deployementAPILogs>>, TError = AxiosError<Error>>(
+ id: string,
+    params: DeployementAPILogsParams, options: { query:Partial<UseQueryOptions<Awaited<ReturnType<typeof deployementAPILogs>>, TError, TData>> & Pick<
+        DefinedInitialDataOptions<
+          Awaited<ReturnType<typeof deployementAPILogs>>,
+          TError,
+          Awaited<ReturnType<typeof deployementAPILogs>>
+        > , 'initialData'
+      >, axios?: AxiosRequestConfig}
+ , queryClient?: QueryClient
+  ):  DefinedUseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+export function useDeployementAPILogs<TData = Awaited<ReturnType<typeof deployementAPILogs>>, TError = AxiosError<Error>>(
+ id: string,
+    params: DeployementAPILogsParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof deployementAPILogs>>, TError, TData>> & Pick<
+        UndefinedInitialDataOptions<
+          Awaited<ReturnType<typeof deployementAPILogs>>,
+          TError,
+          Awaited<ReturnType<typeof deployementAPILogs>>
+        > , 'initialData'
+      >, axios?: AxiosRequestConfig}
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+export function useDeployementAPILogs<TData = Awaited<ReturnType<typeof deployementAPILogs>>, TError = AxiosError<Error>>(
+ id: string,
+    params: DeployementAPILogsParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof deployementAPILogs>>, TError, TData>>, axios?: AxiosRequestConfig}
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+
+export function useDeployementAPILogs<TData = Awaited<ReturnType<typeof deployementAPILogs>>, TError = AxiosError<Error>>(
+ id: string,
+    params: DeployementAPILogsParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof deployementAPILogs>>, TError, TData>>, axios?: AxiosRequestConfig}
+ , queryClient?: QueryClient 
+ ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> } {
+
+  const queryOptions = getDeployementAPILogsQueryOptions(id,params,options)
+
+  const query = useQuery(queryOptions, queryClient) as  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> };
+
+  query.queryKey = queryOptions.queryKey ;
+
+  return query;
+}
+
+
+
+
+
+export const diffAPIGet = (
+     options?: AxiosRequestConfig
+ ): Promise<AxiosResponse<FileDiff[]>> => {
+    
+    
+    return axios.default.get(
+      `/api/diff`,options
+    );
+  }
+
+
+
+
+export const getDiffAPIGetQueryKey = () => {
+    return [
+    `/api/diff`
+    ] as const;
+    }
+
+    
+export const getDiffAPIGetQueryOptions = <TData = Awaited<ReturnType<typeof diffAPIGet>>, TError = AxiosError<Error>>( options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof diffAPIGet>>, TError, TData>>, axios?: AxiosRequestConfig}
+) => {
+
+const {query: queryOptions, axios: axiosOptions} = options ?? {};
+
+  const queryKey =  queryOptions?.queryKey ?? getDiffAPIGetQueryKey();
+
+  
+
+    const queryFn: QueryFunction<Awaited<ReturnType<typeof diffAPIGet>>> = ({ signal }) => diffAPIGet({ signal, ...axiosOptions });
+
+      
+
+      
+
+   return  { queryKey, queryFn, ...queryOptions} as UseQueryOptions<Awaited<ReturnType<typeof diffAPIGet>>, TError, TData> & { queryKey: DataTag<QueryKey, TData, TError> }
+}
+
+export type DiffAPIGetQueryResult = NonNullable<Awaited<ReturnType<typeof diffAPIGet>>>
+export type DiffAPIGetQueryError = AxiosError<Error>
+
+
+export function useDiffAPIGet<TData = Awaited<ReturnType<typeof diffAPIGet>>, TError = AxiosError<Error>>(
+  options: { query:Partial<UseQueryOptions<Awaited<ReturnType<typeof diffAPIGet>>, TError, TData>> & Pick<
+        DefinedInitialDataOptions<
+          Awaited<ReturnType<typeof diffAPIGet>>,
+          TError,
+          Awaited<ReturnType<typeof diffAPIGet>>
+        > , 'initialData'
+      >, axios?: AxiosRequestConfig}
+ , queryClient?: QueryClient
+  ):  DefinedUseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+export function useDiffAPIGet<TData = Awaited<ReturnType<typeof diffAPIGet>>, TError = AxiosError<Error>>(
+  options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof diffAPIGet>>, TError, TData>> & Pick<
+        UndefinedInitialDataOptions<
+          Awaited<ReturnType<typeof diffAPIGet>>,
+          TError,
+          Awaited<ReturnType<typeof diffAPIGet>>
+        > , 'initialData'
+      >, axios?: AxiosRequestConfig}
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+export function useDiffAPIGet<TData = Awaited<ReturnType<typeof diffAPIGet>>, TError = AxiosError<Error>>(
+  options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof diffAPIGet>>, TError, TData>>, axios?: AxiosRequestConfig}
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+
+export function useDiffAPIGet<TData = Awaited<ReturnType<typeof diffAPIGet>>, TError = AxiosError<Error>>(
+  options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof diffAPIGet>>, TError, TData>>, axios?: AxiosRequestConfig}
+ , queryClient?: QueryClient 
+ ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> } {
+
+  const queryOptions = getDiffAPIGetQueryOptions(options)
+
+  const query = useQuery(queryOptions, queryClient) as  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> };
+
+  query.queryKey = queryOptions.queryKey ;
+
+  return query;
+}
+
+
+
+
+
+export const featuresAPIGet = (
+     options?: AxiosRequestConfig
+ ): Promise<AxiosResponse<Features>> => {
+    
+    
+    return axios.default.get(
+      `/api/features`,options
+    );
+  }
+
+
+
+
+export const getFeaturesAPIGetQueryKey = () => {
+    return [
+    `/api/features`
+    ] as const;
+    }
+
+    
+export const getFeaturesAPIGetQueryOptions = <TData = Awaited<ReturnType<typeof featuresAPIGet>>, TError = AxiosError<Error>>( options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof featuresAPIGet>>, TError, TData>>, axios?: AxiosRequestConfig}
+) => {
+
+const {query: queryOptions, axios: axiosOptions} = options ?? {};
+
+  const queryKey =  queryOptions?.queryKey ?? getFeaturesAPIGetQueryKey();
+
+  
+
+    const queryFn: QueryFunction<Awaited<ReturnType<typeof featuresAPIGet>>> = ({ signal }) => featuresAPIGet({ signal, ...axiosOptions });
+
+      
+
+      
+
+   return  { queryKey, queryFn, ...queryOptions} as UseQueryOptions<Awaited<ReturnType<typeof featuresAPIGet>>, TError, TData> & { queryKey: DataTag<QueryKey, TData, TError> }
+}
+
+export type FeaturesAPIGetQueryResult = NonNullable<Awaited<ReturnType<typeof featuresAPIGet>>>
+export type FeaturesAPIGetQueryError = AxiosError<Error>
+
+
+export function useFeaturesAPIGet<TData = Awaited<ReturnType<typeof featuresAPIGet>>, TError = AxiosError<Error>>(
+  options: { query:Partial<UseQueryOptions<Awaited<ReturnType<typeof featuresAPIGet>>, TError, TData>> & Pick<
+        DefinedInitialDataOptions<
+          Awaited<ReturnType<typeof featuresAPIGet>>,
+          TError,
+          Awaited<ReturnType<typeof featuresAPIGet>>
+        > , 'initialData'
+      >, axios?: AxiosRequestConfig}
+ , queryClient?: QueryClient
+  ):  DefinedUseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+export function useFeaturesAPIGet<TData = Awaited<ReturnType<typeof featuresAPIGet>>, TError = AxiosError<Error>>(
+  options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof featuresAPIGet>>, TError, TData>> & Pick<
+        UndefinedInitialDataOptions<
+          Awaited<ReturnType<typeof featuresAPIGet>>,
+          TError,
+          Awaited<ReturnType<typeof featuresAPIGet>>
+        > , 'initialData'
+      >, axios?: AxiosRequestConfig}
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+export function useFeaturesAPIGet<TData = Awaited<ReturnType<typeof featuresAPIGet>>, TError = AxiosError<Error>>(
+  options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof featuresAPIGet>>, TError, TData>>, axios?: AxiosRequestConfig}
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+
+export function useFeaturesAPIGet<TData = Awaited<ReturnType<typeof featuresAPIGet>>, TError = AxiosError<Error>>(
+  options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof featuresAPIGet>>, TError, TData>>, axios?: AxiosRequestConfig}
+ , queryClient?: QueryClient 
+ ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> } {
+
+  const queryOptions = getFeaturesAPIGetQueryOptions(options)
+
+  const query = useQuery(queryOptions, queryClient) as  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> };
+
+  query.queryKey = queryOptions.queryKey ;
+
+  return query;
+}
+
+
+
+
+
+export const notificationsAPIList = (
+    params: NotificationsAPIListParams, options?: AxiosRequestConfig
+ ): Promise<AxiosResponse<NotificationsAPIList200>> => {
+    
+    
+    return axios.default.get(
+      `/api/notifications`,{
+    ...options,
+        params: {...params, ...options?.params},}
+    );
+  }
+
+
+
+
+export const getNotificationsAPIListQueryKey = (params?: NotificationsAPIListParams,) => {
+    return [
+    `/api/notifications`, ...(params ? [params]: [])
+    ] as const;
+    }
+
+    
+export const getNotificationsAPIListQueryOptions = <TData = Awaited<ReturnType<typeof notificationsAPIList>>, TError = AxiosError<Error>>(params: NotificationsAPIListParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof notificationsAPIList>>, TError, TData>>, axios?: AxiosRequestConfig}
+) => {
+
+const {query: queryOptions, axios: axiosOptions} = options ?? {};
+
+  const queryKey =  queryOptions?.queryKey ?? getNotificationsAPIListQueryKey(params);
+
+  
+
+    const queryFn: QueryFunction<Awaited<ReturnType<typeof notificationsAPIList>>> = ({ signal }) => notificationsAPIList(params, { signal, ...axiosOptions });
+
+      
+
+      
+
+   return  { queryKey, queryFn, ...queryOptions} as UseQueryOptions<Awaited<ReturnType<typeof notificationsAPIList>>, TError, TData> & { queryKey: DataTag<QueryKey, TData, TError> }
+}
+
+export type NotificationsAPIListQueryResult = NonNullable<Awaited<ReturnType<typeof notificationsAPIList>>>
+export type NotificationsAPIListQueryError = AxiosError<Error>
+
+
+export function useNotificationsAPIList<TData = Awaited<ReturnType<typeof notificationsAPIList>>, TError = AxiosError<Error>>(
+ params: NotificationsAPIListParams, options: { query:Partial<UseQueryOptions<Awaited<ReturnType<typeof notificationsAPIList>>, TError, TData>> & Pick<
+        DefinedInitialDataOptions<
+          Awaited<ReturnType<typeof notificationsAPIList>>,
+          TError,
+          Awaited<ReturnType<typeof notificationsAPIList>>
+        > , 'initialData'
+      >, axios?: AxiosRequestConfig}
+ , queryClient?: QueryClient
+  ):  DefinedUseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+export function useNotificationsAPIList<TData = Awaited<ReturnType<typeof notificationsAPIList>>, TError = AxiosError<Error>>(
+ params: NotificationsAPIListParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof notificationsAPIList>>, TError, TData>> & Pick<
+        UndefinedInitialDataOptions<
+          Awaited<ReturnType<typeof notificationsAPIList>>,
+          TError,
+          Awaited<ReturnType<typeof notificationsAPIList>>
+        > , 'initialData'
+      >, axios?: AxiosRequestConfig}
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+export function useNotificationsAPIList<TData = Awaited<ReturnType<typeof notificationsAPIList>>, TError = AxiosError<Error>>(
+ params: NotificationsAPIListParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof notificationsAPIList>>, TError, TData>>, axios?: AxiosRequestConfig}
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+
+export function useNotificationsAPIList<TData = Awaited<ReturnType<typeof notificationsAPIList>>, TError = AxiosError<Error>>(
+ params: NotificationsAPIListParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof notificationsAPIList>>, TError, TData>>, axios?: AxiosRequestConfig}
+ , queryClient?: QueryClient 
+ ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> } {
+
+  const queryOptions = getNotificationsAPIListQueryOptions(params,options)
+
+  const query = useQuery(queryOptions, queryClient) as  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> };
+
+  query.queryKey = queryOptions.queryKey ;
+
+  return query;
+}
+
+
+
+
+
+/**
+ * Scheduled jobs with their next run, and the maintenance windows
+ */
+export const schedulesAPIGet = (
+     options?: AxiosRequestConfig
+ ): Promise<AxiosResponse<Schedules>> => {
+    
+    
+    return axios.default.get(
+      `/api/schedules`,options
+    );
+  }
+
+
+
+
+export const getSchedulesAPIGetQueryKey = () => {
+    return [
+    `/api/schedules`
+    ] as const;
+    }
+
+    
+export const getSchedulesAPIGetQueryOptions = <TData = Awaited<ReturnType<typeof schedulesAPIGet>>, TError = AxiosError<Error>>( options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof schedulesAPIGet>>, TError, TData>>, axios?: AxiosRequestConfig}
+) => {
+
+const {query: queryOptions, axios: axiosOptions} = options ?? {};
+
+  const queryKey =  queryOptions?.queryKey ?? getSchedulesAPIGetQueryKey();
+
+  
+
+    const queryFn: QueryFunction<Awaited<ReturnType<typeof schedulesAPIGet>>> = ({ signal }) => schedulesAPIGet({ signal, ...axiosOptions });
+
+      
+
+      
+
+   return  { queryKey, queryFn, ...queryOptions} as UseQueryOptions<Awaited<ReturnType<typeof schedulesAPIGet>>, TError, TData> & { queryKey: DataTag<QueryKey, TData, TError> }
+}
+
+export type SchedulesAPIGetQueryResult = NonNullable<Awaited<ReturnType<typeof schedulesAPIGet>>>
+export type SchedulesAPIGetQueryError = AxiosError<Error>
+
+
+export function useSchedulesAPIGet<TData = Awaited<ReturnType<typeof schedulesAPIGet>>, TError = AxiosError<Error>>(
+  options: { query:Partial<UseQueryOptions<Awaited<ReturnType<typeof schedulesAPIGet>>, TError, TData>> & Pick<
+        DefinedInitialDataOptions<
+          Awaited<ReturnType<typeof schedulesAPIGet>>,
+          TError,
+          Awaited<ReturnType<typeof schedulesAPIGet>>
+        > , 'initialData'
+      >, axios?: AxiosRequestConfig}
+ , queryClient?: QueryClient
+  ):  DefinedUseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+export function useSchedulesAPIGet<TData = Awaited<ReturnType<typeof schedulesAPIGet>>, TError = AxiosError<Error>>(
+  options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof schedulesAPIGet>>, TError, TData>> & Pick<
+        UndefinedInitialDataOptions<
+          Awaited<ReturnType<typeof schedulesAPIGet>>,
+          TError,
+          Awaited<ReturnType<typeof schedulesAPIGet>>
+        > , 'initialData'
+      >, axios?: AxiosRequestConfig}
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+export function useSchedulesAPIGet<TData = Awaited<ReturnType<typeof schedulesAPIGet>>, TError = AxiosError<Error>>(
+  options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof schedulesAPIGet>>, TError, TData>>, axios?: AxiosRequestConfig}
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+
+export function useSchedulesAPIGet<TData = Awaited<ReturnType<typeof schedulesAPIGet>>, TError = AxiosError<Error>>(
+  options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof schedulesAPIGet>>, TError, TData>>, axios?: AxiosRequestConfig}
+ , queryClient?: QueryClient 
+ ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> } {
+
+  const queryOptions = getSchedulesAPIGetQueryOptions(options)
+
+  const query = useQuery(queryOptions, queryClient) as  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> };
+
+  query.queryKey = queryOptions.queryKey ;
+
+  return query;
+}
+
+
+
+
+
+/**
+ * List secrets, their values are never returned
+ */
+export const secretsAPIList = (
+     options?: AxiosRequestConfig
+ ): Promise<AxiosResponse<Secret[]>> => {
+    
+    
+    return axios.default.get(
+      `/api/secrets`,options
+    );
+  }
+
+
+
+
+export const getSecretsAPIListQueryKey = () => {
+    return [
+    `/api/secrets`
+    ] as const;
+    }
+
+    
+export const getSecretsAPIListQueryOptions = <TData = Awaited<ReturnType<typeof secretsAPIList>>, TError = AxiosError<Error>>( options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof secretsAPIList>>, TError, TData>>, axios?: AxiosRequestConfig}
+) => {
+
+const {query: queryOptions, axios: axiosOptions} = options ?? {};
+
+  const queryKey =  queryOptions?.queryKey ?? getSecretsAPIListQueryKey();
+
+  
+
+    const queryFn: QueryFunction<Awaited<ReturnType<typeof secretsAPIList>>> = ({ signal }) => secretsAPIList({ signal, ...axiosOptions });
+
+      
+
+      
+
+   return  { queryKey, queryFn, ...queryOptions} as UseQueryOptions<Awaited<ReturnType<typeof secretsAPIList>>, TError, TData> & { queryKey: DataTag<QueryKey, TData, TError> }
+}
+
+export type SecretsAPIListQueryResult = NonNullable<Awaited<ReturnType<typeof secretsAPIList>>>
+export type SecretsAPIListQueryError = AxiosError<Error>
+
+
+export function useSecretsAPIList<TData = Awaited<ReturnType<typeof secretsAPIList>>, TError = AxiosError<Error>>(
+  options: { query:Partial<UseQueryOptions<Awaited<ReturnType<typeof secretsAPIList>>, TError, TData>> & Pick<
+        DefinedInitialDataOptions<
+          Awaited<ReturnType<typeof secretsAPIList>>,
+          TError,
+          Awaited<ReturnType<typeof secretsAPIList>>
+        > , 'initialData'
+      >, axios?: AxiosRequestConfig}
+ , queryClient?: QueryClient
+  ):  DefinedUseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+export function useSecretsAPIList<TData = Awaited<ReturnType<typeof secretsAPIList>>, TError = AxiosError<Error>>(
+  options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof secretsAPIList>>, TError, TData>> & Pick<
+        UndefinedInitialDataOptions<
+          Awaited<ReturnType<typeof secretsAPIList>>,
+          TError,
+          Awaited<ReturnType<typeof secretsAPIList>>
+        > , 'initialData'
+      >, axios?: AxiosRequestConfig}
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+export function useSecretsAPIList<TData = Awaited<ReturnType<typeof secretsAPIList>>, TError = AxiosError<Error>>(
+  options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof secretsAPIList>>, TError, TData>>, axios?: AxiosRequestConfig}
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+
+export function useSecretsAPIList<TData = Awaited<ReturnType<typeof secretsAPIList>>, TError = AxiosError<Error>>(
+  options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof secretsAPIList>>, TError, TData>>, axios?: AxiosRequestConfig}
+ , queryClient?: QueryClient 
+ ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> } {
+
+  const queryOptions = getSecretsAPIListQueryOptions(options)
+
+  const query = useQuery(queryOptions, queryClient) as  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> };
+
+  query.queryKey = queryOptions.queryKey ;
+
+  return query;
+}
+
+
+
+
+
+/**
+ * Create or update a secret
+ */
+export const secretsAPISet = (
+    secretValue: SecretValue, options?: AxiosRequestConfig
+ ): Promise<AxiosResponse<Secret>> => {
+    
+    
+    return axios.default.post(
+      `/api/secrets`,
+      secretValue,options
+    );
+  }
+
+
+
+export const getSecretsAPISetMutationOptions = <TError = AxiosError<Error>,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof secretsAPISet>>, TError,{data: SecretValue}, TContext>, axios?: AxiosRequestConfig}
+): UseMutationOptions<Awaited<ReturnType<typeof secretsAPISet>>, TError,{data: SecretValue}, TContext> => {
+
+const mutationKey = ['secretsAPISet'];
+const {mutation: mutationOptions, axios: axiosOptions} = options ?
+      options.mutation && 'mutationKey' in options.mutation && options.mutation.mutationKey ?
+      options
+      : {...options, mutation: {...options.mutation, mutationKey}}
+      : {mutation: { mutationKey, }, axios: undefined};
+
+      
+
+
+      const mutationFn: MutationFunction<Awaited<ReturnType<typeof secretsAPISet>>, {data: SecretValue}> = (props) => {
+          const {data} = props ?? {};
+
+          return  secretsAPISet(data,axiosOptions)
+        }
+
+        
+
+
+  return  { mutationFn, ...mutationOptions }}
+
+    export type SecretsAPISetMutationResult = NonNullable<Awaited<ReturnType<typeof secretsAPISet>>>
+    export type SecretsAPISetMutationBody = SecretValue
+    export type SecretsAPISetMutationError = AxiosError<Error>
+
+    export const useSecretsAPISet = <TError = AxiosError<Error>,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof secretsAPISet>>, TError,{data: SecretValue}, TContext>, axios?: AxiosRequestConfig}
+ , queryClient?: QueryClient): UseMutationResult<
+        Awaited<ReturnType<typeof secretsAPISet>>,
+        TError,
+        {data: SecretValue},
+        TContext
+      > => {
+
+      const mutationOptions = getSecretsAPISetMutationOptions(options);
+
+      return useMutation(mutationOptions, queryClient);
+    }
+    
+/**
+ * Delete a secret
+ */
+export const secretsAPIDelete = (
+    name: string, options?: AxiosRequestConfig
+ ): Promise<AxiosResponse<BooleanResponse>> => {
+    
+    
+    return axios.default.delete(
+      `/api/secrets/${name}`,options
+    );
+  }
+
+
+
+export const getSecretsAPIDeleteMutationOptions = <TError = AxiosError<Error>,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof secretsAPIDelete>>, TError,{name: string}, TContext>, axios?: AxiosRequestConfig}
+): UseMutationOptions<Awaited<ReturnType<typeof secretsAPIDelete>>, TError,{name: string}, TContext> => {
+
+const mutationKey = ['secretsAPIDelete'];
+const {mutation: mutationOptions, axios: axiosOptions} = options ?
+      options.mutation && 'mutationKey' in options.mutation && options.mutation.mutationKey ?
+      options
+      : {...options, mutation: {...options.mutation, mutationKey}}
+      : {mutation: { mutationKey, }, axios: undefined};
+
+      
+
+
+      const mutationFn: MutationFunction<Awaited<ReturnType<typeof secretsAPIDelete>>, {name: string}> = (props) => {
+          const {name} = props ?? {};
+
+          return  secretsAPIDelete(name,axiosOptions)
+        }
+
+        
+
+
+  return  { mutationFn, ...mutationOptions }}
+
+    export type SecretsAPIDeleteMutationResult = NonNullable<Awaited<ReturnType<typeof secretsAPIDelete>>>
+    
+    export type SecretsAPIDeleteMutationError = AxiosError<Error>
+
+    export const useSecretsAPIDelete = <TError = AxiosError<Error>,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof secretsAPIDelete>>, TError,{name: string}, TContext>, axios?: AxiosRequestConfig}
+ , queryClient?: QueryClient): UseMutationResult<
+        Awaited<ReturnType<typeof secretsAPIDelete>>,
+        TError,
+        {name: string},
+        TContext
+      > => {
+
+      const mutationOptions = getSecretsAPIDeleteMutationOptions(options);
+
+      return useMutation(mutationOptions, queryClient);
+    }
+    
+export const settingsAPIGet = (
+     options?: AxiosRequestConfig
+ ): Promise<AxiosResponse<Settings>> => {
+    
+    
+    return axios.default.get(
+      `/api/settings`,options
+    );
+  }
+
+
+
+
+export const getSettingsAPIGetQueryKey = () => {
+    return [
+    `/api/settings`
+    ] as const;
+    }
+
+    
+export const getSettingsAPIGetQueryOptions = <TData = Awaited<ReturnType<typeof settingsAPIGet>>, TError = AxiosError<Error>>( options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof settingsAPIGet>>, TError, TData>>, axios?: AxiosRequestConfig}
+) => {
+
+const {query: queryOptions, axios: axiosOptions} = options ?? {};
+
+  const queryKey =  queryOptions?.queryKey ?? getSettingsAPIGetQueryKey();
+
+  
+
+    const queryFn: QueryFunction<Awaited<ReturnType<typeof settingsAPIGet>>> = ({ signal }) => settingsAPIGet({ signal, ...axiosOptions });
+
+      
+
+      
+
+   return  { queryKey, queryFn, ...queryOptions} as UseQueryOptions<Awaited<ReturnType<typeof settingsAPIGet>>, TError, TData> & { queryKey: DataTag<QueryKey, TData, TError> }
+}
+
+export type SettingsAPIGetQueryResult = NonNullable<Awaited<ReturnType<typeof settingsAPIGet>>>
+export type SettingsAPIGetQueryError = AxiosError<Error>
+
+
+export function useSettingsAPIGet<TData = Awaited<ReturnType<typeof settingsAPIGet>>, TError = AxiosError<Error>>(
+  options: { query:Partial<UseQueryOptions<Awaited<ReturnType<typeof settingsAPIGet>>, TError, TData>> & Pick<
+        DefinedInitialDataOptions<
+          Awaited<ReturnType<typeof settingsAPIGet>>,
+          TError,
+          Awaited<ReturnType<typeof settingsAPIGet>>
+        > , 'initialData'
+      >, axios?: AxiosRequestConfig}
+ , queryClient?: QueryClient
+  ):  DefinedUseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+export function useSettingsAPIGet<TData = Awaited<ReturnType<typeof settingsAPIGet>>, TError = AxiosError<Error>>(
+  options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof settingsAPIGet>>, TError, TData>> & Pick<
+        UndefinedInitialDataOptions<
+          Awaited<ReturnType<typeof settingsAPIGet>>,
+          TError,
+          Awaited<ReturnType<typeof settingsAPIGet>>
+        > , 'initialData'
+      >, axios?: AxiosRequestConfig}
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+export function useSettingsAPIGet<TData = Awaited<ReturnType<typeof settingsAPIGet>>, TError = AxiosError<Error>>(
+  options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof settingsAPIGet>>, TError, TData>>, axios?: AxiosRequestConfig}
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+
+export function useSettingsAPIGet<TData = Awaited<ReturnType<typeof settingsAPIGet>>, TError = AxiosError<Error>>(
+  options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof settingsAPIGet>>, TError, TData>>, axios?: AxiosRequestConfig}
+ , queryClient?: QueryClient 
+ ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> } {
+
+  const queryOptions = getSettingsAPIGetQueryOptions(options)
+
+  const query = useQuery(queryOptions, queryClient) as  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> };
+
+  query.queryKey = queryOptions.queryKey ;
+
+  return query;
+}
+
+
+
+
+
+/**
+ * Update the settings, fails with 412 when If-Match doesn't match the current version of the config file
+ */
+export const settingsAPISet = (
+    settings: Settings, options?: AxiosRequestConfig
+ ): Promise<AxiosResponse<Settings>> => {
+    
+    
+    return axios.default.post(
+      `/api/settings`,
+      settings,options
+    );
+  }
+
+
+
+export const getSettingsAPISetMutationOptions = <TError = AxiosError<Error>,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof settingsAPISet>>, TError,{data: Settings}, TContext>, axios?: AxiosRequestConfig}
+): UseMutationOptions<Awaited<ReturnType<typeof settingsAPISet>>, TError,{data: Settings}, TContext> => {
+
+const mutationKey = ['settingsAPISet'];
+const {mutation: mutationOptions, axios: axiosOptions} = options ?
+      options.mutation && 'mutationKey' in options.mutation && options.mutation.mutationKey ?
+      options
+      : {...options, mutation: {...options.mutation, mutationKey}}
+      : {mutation: { mutationKey, }, axios: undefined};
+
+      
+
+
+      const mutationFn: MutationFunction<Awaited<ReturnType<typeof settingsAPISet>>, {data: Settings}> = (props) => {
+          const {data} = props ?? {};
+
+          return  settingsAPISet(data,axiosOptions)
+        }
+
+        
+
+
+  return  { mutationFn, ...mutationOptions }}
+
+    export type SettingsAPISetMutationResult = NonNullable<Awaited<ReturnType<typeof settingsAPISet>>>
+    export type SettingsAPISetMutationBody = Settings
+    export type SettingsAPISetMutationError = AxiosError<Error>
+
+    export const useSettingsAPISet = <TError = AxiosError<Error>,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof settingsAPISet>>, TError,{data: Settings}, TContext>, axios?: AxiosRequestConfig}
+ , queryClient?: QueryClient): UseMutationResult<
+        Awaited<ReturnType<typeof settingsAPISet>>,
+        TError,
+        {data: Settings},
+        TContext
+      > => {
+
+      const mutationOptions = getSettingsAPISetMutationOptions(options);
+
+      return useMutation(mutationOptions, queryClient);
+    }
+    
+/**
+ * Restart a container of a stack, recorded as a deployment
+ */
+export const stacksAPIRestartContainer = (
+    name: string,
+    id: string, options?: AxiosRequestConfig
+ ): Promise<AxiosResponse<DeploymentWithDetails>> => {
+    
+    
+    return axios.default.post(
+      `/api/stacks/${name}/containers/${id}/restart`,undefined,options
+    );
+  }
+
+
+
+export const getStacksAPIRestartContainerMutationOptions = <TError = AxiosError<Error>,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof stacksAPIRestartContainer>>, TError,{name: string;id: string}, TContext>, axios?: AxiosRequestConfig}
+): UseMutationOptions<Awaited<ReturnType<typeof stacksAPIRestartContainer>>, TError,{name: string;id: string}, TContext> => {
+
+const mutationKey = ['stacksAPIRestartContainer'];
+const {mutation: mutationOptions, axios: axiosOptions} = options ?
+      options.mutation && 'mutationKey' in options.mutation && options.mutation.mutationKey ?
+      options
+      : {...options, mutation: {...options.mutation, mutationKey}}
+      : {mutation: { mutationKey, }, axios: undefined};
+
+      
+
+
+      const mutationFn: MutationFunction<Awaited<ReturnType<typeof stacksAPIRestartContainer>>, {name: string;id: string}> = (props) => {
+          const {name,id} = props ?? {};
+
+          return  stacksAPIRestartContainer(name,id,axiosOptions)
+        }
+
+        
+
+
+  return  { mutationFn, ...mutationOptions }}
+
+    export type StacksAPIRestartContainerMutationResult = NonNullable<Awaited<ReturnType<typeof stacksAPIRestartContainer>>>
+    
+    export type StacksAPIRestartContainerMutationError = AxiosError<Error>
+
+    export const useStacksAPIRestartContainer = <TError = AxiosError<Error>,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof stacksAPIRestartContainer>>, TError,{name: string;id: string}, TContext>, axios?: AxiosRequestConfig}
+ , queryClient?: QueryClient): UseMutationResult<
+        Awaited<ReturnType<typeof stacksAPIRestartContainer>>,
+        TError,
+        {name: string;id: string},
+        TContext
+      > => {
+
+      const mutationOptions = getStacksAPIRestartContainerMutationOptions(options);
+
+      return useMutation(mutationOptions, queryClient);
+    }
+    
+/**
+ * Start an action on a stack, recorded as a deployment that runs in the background
+ */
+export const stacksAPIAction = (
+    name: string,
+    action: StackAction, options?: AxiosRequestConfig
+ ): Promise<AxiosResponse<DeploymentWithDetails>> => {
+    
+    
+    return axios.default.post(
+      `/api/stacks/${name}/${action}`,undefined,options
+    );
+  }
+
+
+
+export const getStacksAPIActionMutationOptions = <TError = AxiosError<Error>,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof stacksAPIAction>>, TError,{name: string;action: StackAction}, TContext>, axios?: AxiosRequestConfig}
+): UseMutationOptions<Awaited<ReturnType<typeof stacksAPIAction>>, TError,{name: string;action: StackAction}, TContext> => {
+
+const mutationKey = ['stacksAPIAction'];
+const {mutation: mutationOptions, axios: axiosOptions} = options ?
+      options.mutation && 'mutationKey' in options.mutation && options.mutation.mutationKey ?
+      options
+      : {...options, mutation: {...options.mutation, mutationKey}}
+      : {mutation: { mutationKey, }, axios: undefined};
+
+      
+
+
+      const mutationFn: MutationFunction<Awaited<ReturnType<typeof stacksAPIAction>>, {name: string;action: StackAction}> = (props) => {
+          const {name,action} = props ?? {};
+
+          return  stacksAPIAction(name,action,axiosOptions)
+        }
+
+        
+
+
+  return  { mutationFn, ...mutationOptions }}
+
+    export type StacksAPIActionMutationResult = NonNullable<Awaited<ReturnType<typeof stacksAPIAction>>>
+    
+    export type StacksAPIActionMutationError = AxiosError<Error>
+
+    export const useStacksAPIAction = <TError = AxiosError<Error>,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof stacksAPIAction>>, TError,{name: string;action: StackAction}, TContext>, axios?: AxiosRequestConfig}
+ , queryClient?: QueryClient): UseMutationResult<
+        Awaited<ReturnType<typeof stacksAPIAction>>,
+        TError,
+        {name: string;action: StackAction},
+        TContext
+      > => {
+
+      const mutationOptions = getStacksAPIActionMutationOptions(options);
+
+      return useMutation(mutationOptions, queryClient);
+    }
+    
+/**
+ * Number of finished deployments per day (UTC) for the last N days
+ */
+export const statsAPIDaily = (
+    days: number, options?: AxiosRequestConfig
+ ): Promise<AxiosResponse<DailyStats[]>> => {
+    
+    
+    return axios.default.get(
+      `/api/stats/daily/${days}`,options
+    );
+  }
+
+
+
+
+export const getStatsAPIDailyQueryKey = (days?: number,) => {
+    return [
+    `/api/stats/daily/${days}`
+    ] as const;
+    }
+
+    
+export const getStatsAPIDailyQueryOptions = <TData = Awaited<ReturnType<typeof statsAPIDaily>>, TError = AxiosError<Error>>(days: number, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof statsAPIDaily>>, TError, TData>>, axios?: AxiosRequestConfig}
+) => {
+
+const {query: queryOptions, axios: axiosOptions} = options ?? {};
+
+  const queryKey =  queryOptions?.queryKey ?? getStatsAPIDailyQueryKey(days);
+
+  
+
+    const queryFn: QueryFunction<Awaited<ReturnType<typeof statsAPIDaily>>> = ({ signal }) => statsAPIDaily(days, { signal, ...axiosOptions });
+
+      
+
+      
+
+   return  { queryKey, queryFn, enabled: !!(days), ...queryOptions} as UseQueryOptions<Awaited<ReturnType<typeof statsAPIDaily>>, TError, TData> & { queryKey: DataTag<QueryKey, TData, TError> }
+}
+
+export type StatsAPIDailyQueryResult = NonNullable<Awaited<ReturnType<typeof statsAPIDaily>>>
+export type StatsAPIDailyQueryError = AxiosError<Error>
+
+
+export function useStatsAPIDaily<TData = Awaited<ReturnType<typeof statsAPIDaily>>, TError = AxiosError<Error>>(
+ days: number, options: { query:Partial<UseQueryOptions<Awaited<ReturnType<typeof statsAPIDaily>>, TError, TData>> & Pick<
+        DefinedInitialDataOptions<
+          Awaited<ReturnType<typeof statsAPIDaily>>,
+          TError,
+          Awaited<ReturnType<typeof statsAPIDaily>>
+        > , 'initialData'
+      >, axios?: AxiosRequestConfig}
+ , queryClient?: QueryClient
+  ):  DefinedUseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+export function useStatsAPIDaily<TData = Awaited<ReturnType<typeof statsAPIDaily>>, TError = AxiosError<Error>>(
+ days: number, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof statsAPIDaily>>, TError, TData>> & Pick<
+        UndefinedInitialDataOptions<
+          Awaited<ReturnType<typeof statsAPIDaily>>,
+          TError,
+          Awaited<ReturnType<typeof statsAPIDaily>>
+        > , 'initialData'
+      >, axios?: AxiosRequestConfig}
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+export function useStatsAPIDaily<TData = Awaited<ReturnType<typeof statsAPIDaily>>, TError = AxiosError<Error>>(
+ days: number, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof statsAPIDaily>>, TError, TData>>, axios?: AxiosRequestConfig}
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> }
+
+export function useStatsAPIDaily<TData = Awaited<ReturnType<typeof statsAPIDaily>>, TError = AxiosError<Error>>(
+ days: number, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof statsAPIDaily>>, TError, TData>>, axios?: AxiosRequestConfig}
+ , queryClient?: QueryClient 
+ ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> } {
+
+  const queryOptions = getStatsAPIDailyQueryOptions(days,options)
+
+  const query = useQuery(queryOptions, queryClient) as  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData, TError> };
+
+  query.queryKey = queryOptions.queryKey ;
+
+  return query;
+}
+
+
+
+
+
 export const statsAPIGet = (
     days: number, options?: AxiosRequestConfig
  ): Promise<AxiosResponse<Stats>> => {
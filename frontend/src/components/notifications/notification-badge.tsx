@@ -16,11 +16,13 @@ function getNotificationColor(type: EventType): string {
   switch (type) {
     case EventType.ERROR:
     case EventType.DEPLOYMENT_ERROR:
+    case EventType.STACK_UNHEALTHY:
       return 'bg-destructive';
     case EventType.MISC:
     case EventType.DEPLOYMENT_STARTED:
       return 'bg-blue-500';
     case EventType.DEPLOYMENT_SUCCESS:
+    case EventType.STACK_RECOVERED:
       return 'bg-green-500';
     case EventType.PASSWORD_UPDATED:
     case EventType.CONFIGURATION_UPDATED:
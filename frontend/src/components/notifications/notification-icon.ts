@@ -6,6 +6,8 @@ import {
   Clock,
   Cog,
   FileTextIcon,
+  HeartCrack,
+  HeartPulse,
   Info,
   KeyRoundIcon,
   LogInIcon,
@@ -38,6 +40,10 @@ export function getNotificaitonIcon(type: EventType | EventGroup): LucideIcon {
       return FileTextIcon;
     case EventType.SESSION_REUSED:
       return LogInIcon;
+    case EventType.STACK_UNHEALTHY:
+      return HeartCrack;
+    case EventType.STACK_RECOVERED:
+      return HeartPulse;
     case 'SETTINGS':
       return Cog;
     default:
@@ -8,7 +8,7 @@ import { getNotificaitonIcon } from './notification-icon';
 type EventFilter = 'ERROR' | 'DEPLOYMENT' | 'SETTINGS';
 
 const filterMap: Map<EventFilter, Array<EventType>> = new Map([
-  ['ERROR', [EventType.DEPLOYMENT_ERROR, EventType.ERROR]],
+  ['ERROR', [EventType.DEPLOYMENT_ERROR, EventType.ERROR, EventType.STACK_UNHEALTHY]],
   [
     'DEPLOYMENT',
     [EventType.DEPLOYMENT_ERROR, EventType.DEPLOYMENT_STARTED, EventType.DEPLOYMENT_SUCCESS],
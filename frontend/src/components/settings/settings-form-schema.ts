@@ -1,4 +1,4 @@
-import { EventType, type Settings } from '@/api/api';
+import { EventType, WriteBackMode, type Settings } from '@/api/api';
 import z from 'zod/v3';
 
 export const formSchema = z.object({
@@ -9,6 +9,7 @@ export const formSchema = z.object({
   token: z.string().optional(),
   notificationURL: z.string().optional(),
   notificationTypes: z.array(z.nativeEnum(EventType)),
+  writeBack: z.nativeEnum(WriteBackMode).optional(),
 });
 export type FormValues = z.infer<typeof formSchema>;
 
@@ -36,6 +36,9 @@ const notificaitonOptions = [
       { value: EventType.PASSWORD_UPDATED, label: 'EVENT_TYPE.PASSWORD_UPDATED' },
       { value: EventType.CONFIGURATION_UPDATED, label: 'EVENT_TYPE.CONFIGURATION_UPDATED' },
       { value: EventType.SESSION_REUSED, label: 'EVENT_TYPE.SESSION_REUSED' },
+      { value: EventType.STACK_STATUS_CHANGED, label: 'EVENT_TYPE.STACK_STATUS_CHANGED' },
+      { value: EventType.STACK_UNHEALTHY, label: 'EVENT_TYPE.STACK_UNHEALTHY' },
+      { value: EventType.STACK_RECOVERED, label: 'EVENT_TYPE.STACK_RECOVERED' },
     ],
   },
 ];
@@ -23,6 +23,8 @@ export function StatusPage() {
               <ServiceStatus
                 serviceName={stackStatus.name}
                 serviceContainers={stackStatus.services}
+                usage={stackStatus.usage}
+                usageHistory={stackStatus.usageHistory}
               />
             </div>
           ))
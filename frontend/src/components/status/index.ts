@@ -1,3 +1,4 @@
 export * from './container-status-badge';
 export * from './environement-health';
 export * from './service-status';
+export * from './usage-sparkline';
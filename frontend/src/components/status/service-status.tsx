@@ -1,17 +1,36 @@
-import type { ContainerStatus } from '@/api/api';
-import { ServiceLogo } from '@/lib';
+import { StackAction, type ContainerStatus, type ResourceUsage } from '@/api/api';
+import { useStackAction } from '@/hooks';
+import { formatBytes, ServiceLogo } from '@/lib';
+import { EllipsisVertical } from 'lucide-react';
+import { useTranslation } from 'react-i18next';
+import { Button } from '../ui/button';
+import {
+  DropdownMenu,
+  DropdownMenuContent,
+  DropdownMenuGroup,
+  DropdownMenuItem,
+  DropdownMenuLabel,
+  DropdownMenuSeparator,
+  DropdownMenuTrigger,
+} from '../ui/dropdown-menu';
 import { Item, ItemActions, ItemContent, ItemDescription, ItemMedia, ItemTitle } from '../ui/item';
 import { Skeleton } from '../ui/skeleton';
 import { HumanTime } from '../view/human-time';
 import { ContainerStatusBadge } from './container-status-badge';
+import { UsageSparkline } from './usage-sparkline';
 
 export function ServiceStatus({
   serviceName,
   serviceContainers,
+  usage,
+  usageHistory,
 }: {
   serviceName: string;
   serviceContainers: Array<ContainerStatus>;
+  usage?: ResourceUsage;
+  usageHistory?: Array<ResourceUsage>;
 }) {
+  const { t } = useTranslation();
   const time = serviceContainers[0]?.startedAt;
   return (
     <Item variant="outline">
@@ -23,6 +42,15 @@ export function ServiceStatus({
         <ItemDescription className="line-clamp-none">
           <HumanTime time={time} />
         </ItemDescription>
+        {usage && (
+          <ItemDescription className="flex items-center gap-2">
+            {t('STATUS.USAGE', {
+              cpu: usage.cpuPercent.toFixed(1),
+              memory: formatBytes(usage.memoryUsage),
+            })}
+            {usageHistory && <UsageSparkline history={usageHistory} />}
+          </ItemDescription>
+        )}
       </ItemContent>
       <ItemActions className="flex-wrap">
         {serviceContainers.map((item) => (
@@ -33,11 +61,58 @@ export function ServiceStatus({
             key={`${serviceName}-${item.name}`}
           />
         ))}
+        <StackActionsMenu serviceName={serviceName} serviceContainers={serviceContainers} />
       </ItemActions>
     </Item>
   );
 }
 
+function StackActionsMenu({
+  serviceName,
+  serviceContainers,
+}: {
+  serviceName: string;
+  serviceContainers: Array<ContainerStatus>;
+}) {
+  const { t } = useTranslation();
+  const { runAction, restartContainer, isPending } = useStackAction();
+
+  return (
+    <DropdownMenu>
+      <DropdownMenuTrigger asChild>
+        <Button variant="ghost" size="icon" disabled={isPending} aria-label={t('STACK_ACTION.MENU')}>
+          <EllipsisVertical />
+        </Button>
+      </DropdownMenuTrigger>
+      <DropdownMenuContent align="end">
+        <DropdownMenuGroup>
+          {Object.values(StackAction).map((action) => (
+            <DropdownMenuItem key={action} onSelect={() => runAction(serviceName, action)}>
+              {t(`STACK_ACTION.${action.toUpperCase().replace(/-/g, '_')}`)}
+            </DropdownMenuItem>
+          ))}
+        </DropdownMenuGroup>
+        {serviceContainers.length > 0 && (
+          <>
+            <DropdownMenuSeparator />
+            <DropdownMenuLabel>{t('STACK_ACTION.RESTART_CONTAINER')}</DropdownMenuLabel>
+            <DropdownMenuGroup>
+              {serviceContainers.map((item) => (
+                <DropdownMenuItem
+                  key={item.containerId}
+                  onSelect={() => restartContainer(serviceName, item.containerId)}
+                >
+                  {item.name}
+                </DropdownMenuItem>
+              ))}
+            </DropdownMenuGroup>
+          </>
+        )}
+      </DropdownMenuContent>
+    </DropdownMenu>
+  );
+}
+
 export function ServiceStatusSkeleton() {
   return (
     <div className="flex flex-wrap items-center gap-4 border rounded-lg w-full p-4">
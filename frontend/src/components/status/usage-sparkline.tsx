@@ -0,0 +1,39 @@
+import type { ResourceUsage } from '@/api/api';
+import { cn } from '@/lib';
+
+/** Line of the memory used by a stack over its last samples */
+export function UsageSparkline({
+  history,
+  className,
+}: {
+  history: Array<ResourceUsage>;
+  className?: string;
+}) {
+  if (history.length < 2) {
+    return null;
+  }
+  const width = 80;
+  const height = 20;
+  const values = history.map((sample) => sample.memoryUsage);
+  const min = Math.min(...values);
+  const range = Math.max(...values) - min || 1;
+  const points = values
+    .map((value, index) => {
+      const x = (index / (values.length - 1)) * width;
+      const y = height - ((value - min) / range) * (height - 2) - 1;
+      return `${x.toFixed(1)},${y.toFixed(1)}`;
+    })
+    .join(' ');
+
+  return (
+    <svg
+      viewBox={`0 0 ${width} ${height}`}
+      width={width}
+      height={height}
+      className={cn('text-muted-foreground', className)}
+      aria-hidden="true"
+    >
+      <polyline points={points} fill="none" stroke="currentColor" strokeWidth="1.5" />
+    </svg>
+  );
+}
@@ -0,0 +1,12 @@
+import type { QueryClient, QueryKey } from '@tanstack/react-query';
+import type { AxiosError, AxiosRequestConfig, AxiosResponse } from 'axios';
+
+// sends the version of the cached response, the update fails if the config file changed since
+export function ifMatchOptions(client: QueryClient, queryKey: QueryKey): AxiosRequestConfig {
+  const etag = client.getQueryData<AxiosResponse>(queryKey)?.headers?.etag;
+  return etag ? { headers: { 'If-Match': etag } } : {};
+}
+
+export function isVersionConflict(error: unknown): boolean {
+  return (error as AxiosError)?.response?.status === 412;
+}
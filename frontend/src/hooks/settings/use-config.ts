@@ -2,7 +2,7 @@ import { getConfigAPIGetQueryOptions, type Config, type Error } from '@/api/api'
 import type { AxiosError } from 'axios';
 
 export const getConfigQueryOptions = ({ enabled }: { enabled: boolean }) => {
-  return getConfigAPIGetQueryOptions<Config, AxiosError<Error>>({
+  return getConfigAPIGetQueryOptions<Config, AxiosError<Error>>(undefined, {
     query: {
       select: (data) => data?.data,
       gcTime: 10 * 60 * 1000,
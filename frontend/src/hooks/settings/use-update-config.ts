@@ -1,17 +1,27 @@
-import { getConfigAPIGetQueryKey, getConfigAPISetMutationOptions, type Config } from '@/api/api';
-import { useMutation } from '@tanstack/react-query';
+import {
+  configAPISet,
+  getConfigAPIGetQueryKey,
+  getConfigAPISetMutationOptions,
+  type Config,
+} from '@/api/api';
+import { useMutation, type QueryClient } from '@tanstack/react-query';
 import { useCallback } from 'react';
 import { useTranslation } from 'react-i18next';
 import { toast } from 'sonner';
+import { ifMatchOptions, isVersionConflict } from './if-match';
 
 export const getUpdateConfigOptions = () => {
-  return getConfigAPISetMutationOptions({
-    mutation: {
-      onSuccess: (data, _, __, context) => {
-        context.client.setQueryData(getConfigAPIGetQueryKey(), data);
+  return {
+    ...getConfigAPISetMutationOptions({
+      mutation: {
+        onSuccess: (data, _, __, context) => {
+          context.client.setQueryData(getConfigAPIGetQueryKey(), data);
+        },
       },
-    },
-  });
+    }),
+    mutationFn: ({ data }: { data: Config }, context: { client: QueryClient }) =>
+      configAPISet(data, ifMatchOptions(context.client, getConfigAPIGetQueryKey())),
+  };
 };
 
 export const useUpdateConfig = () => {
@@ -23,7 +33,7 @@ export const useUpdateConfig = () => {
     (config: Config) => {
       toast.promise(() => updateMutation.mutateAsync({ data: config }), {
         success: t('ALERT.SUCCESS'),
-        error: t('ALERT.ERROR'),
+        error: (error) => (isVersionConflict(error) ? t('ALERT.CONFLICT') : t('ALERT.ERROR')),
       });
     },
     [updateMutation.mutateAsync, t],
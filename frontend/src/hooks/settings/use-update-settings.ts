@@ -1,21 +1,27 @@
 import {
   getSettingsAPIGetQueryKey,
   getSettingsAPISetMutationOptions,
+  settingsAPISet,
   type Settings,
 } from '@/api/api';
-import { useMutation } from '@tanstack/react-query';
+import { useMutation, type QueryClient } from '@tanstack/react-query';
 import { useCallback } from 'react';
 import { useTranslation } from 'react-i18next';
 import { toast } from 'sonner';
+import { ifMatchOptions, isVersionConflict } from './if-match';
 
 export const getUpdateSettingsOptions = () => {
-  return getSettingsAPISetMutationOptions({
-    mutation: {
-      onSuccess: (data, _, __, context) => {
-        context.client.setQueryData(getSettingsAPIGetQueryKey(), data);
+  return {
+    ...getSettingsAPISetMutationOptions({
+      mutation: {
+        onSuccess: (data, _, __, context) => {
+          context.client.setQueryData(getSettingsAPIGetQueryKey(), data);
+        },
       },
-    },
-  });
+    }),
+    mutationFn: ({ data }: { data: Settings }, context: { client: QueryClient }) =>
+      settingsAPISet(data, ifMatchOptions(context.client, getSettingsAPIGetQueryKey())),
+  };
 };
 
 export const useUpdateSettings = () => {
@@ -27,7 +33,7 @@ export const useUpdateSettings = () => {
     (Settings: Settings) => {
       return toast.promise(() => updateMutation.mutateAsync({ data: Settings }), {
         success: t('ALERT.SUCCESS'),
-        error: t('ALERT.ERROR'),
+        error: (error) => (isVersionConflict(error) ? t('ALERT.CONFLICT') : t('ALERT.ERROR')),
       });
     },
     [updateMutation.mutateAsync, t],
@@ -2,6 +2,7 @@ export * from './use-deployment';
 export * from './use-deployments';
 export * from './use-diff';
 export * from './use-notifications';
+export * from './use-stack-action';
 export * from './use-stats';
 export * from './use-status';
 export * from './use-sync';
@@ -0,0 +1,71 @@
+import {
+  getStacksAPIActionMutationOptions,
+  getStacksAPIRestartContainerMutationOptions,
+  type DeploymentWithDetails,
+  type StackAction,
+} from '@/api/api';
+import { useDeploymentNavigate } from '@/lib';
+import { useMutation } from '@tanstack/react-query';
+import { useCallback } from 'react';
+import { useTranslation } from 'react-i18next';
+import { toast } from 'sonner';
+import { getDeploymentsQueryOptions } from './use-deployments';
+import { getStatusQueryOptions } from './use-status';
+
+export const useStackAction = () => {
+  const depNavigate = useDeploymentNavigate();
+  const { t } = useTranslation();
+
+  const actionMutation = useMutation(
+    getStacksAPIActionMutationOptions({
+      mutation: {
+        onSuccess: (_, __, ___, context) => {
+          context.client.refetchQueries(getDeploymentsQueryOptions());
+          context.client.refetchQueries(getStatusQueryOptions());
+        },
+      },
+    }),
+  );
+  const restartMutation = useMutation(
+    getStacksAPIRestartContainerMutationOptions({
+      mutation: {
+        onSuccess: (_, __, ___, context) => {
+          context.client.refetchQueries(getDeploymentsQueryOptions());
+          context.client.refetchQueries(getStatusQueryOptions());
+        },
+      },
+    }),
+  );
+
+  const notify = useCallback(
+    (promise: () => Promise<{ data: DeploymentWithDetails }>) => {
+      toast.promise(
+        () =>
+          promise().then((res) => {
+            depNavigate(res.data.id);
+          }),
+        {
+          loading: t('ALERT.STACK_ACTION_RUNNING'),
+          success: t('ALERT.STACK_ACTION_SUCCESS'),
+          error: t('ALERT.STACK_ACTION_ERROR'),
+        },
+      );
+    },
+    [t, depNavigate],
+  );
+
+  const runAction = useCallback(
+    (name: string, action: StackAction) => notify(() => actionMutation.mutateAsync({ name, action })),
+    [notify, actionMutation.mutateAsync],
+  );
+  const restartContainer = useCallback(
+    (name: string, id: string) => notify(() => restartMutation.mutateAsync({ name, id })),
+    [notify, restartMutation.mutateAsync],
+  );
+
+  return {
+    isPending: actionMutation.isPending || restartMutation.isPending,
+    runAction,
+    restartContainer,
+  };
+};
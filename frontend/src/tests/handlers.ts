@@ -23,6 +23,10 @@ const mockStats: Stats = {
   status: DeploymentStatus.success,
   health: ContainerHealth.healthy,
   author: 'Test',
+  meanDuration: 12.5,
+  p95Duration: 30,
+  meanTimeToRecovery: 600,
+  services: [{ service: 'homepage', deployments: 20, errors: 5, failureRate: 0.25 }],
 };
 
 export const handlers = [